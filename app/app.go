@@ -9,11 +9,14 @@ import (
 	"os"
 	"path/filepath"
 
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
 	"github.com/gorilla/mux"
 	"github.com/rakyll/statik/fs"
 	"github.com/spf13/cast"
 
+	errorsmod "cosmossdk.io/errors"
 	appmempool "github.com/classic-terra/core/v3/app/mempool"
+	appstreaming "github.com/classic-terra/core/v3/app/streaming"
 	dbm "github.com/cometbft/cometbft-db"
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmjson "github.com/cometbft/cometbft/libs/json"
@@ -33,6 +36,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/server/config"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
@@ -44,10 +48,12 @@ import (
 	"github.com/classic-terra/core/v3/app/keepers"
 	terraappparams "github.com/classic-terra/core/v3/app/params"
 	customserver "github.com/classic-terra/core/v3/server"
+	"github.com/classic-terra/core/v3/types/fork"
 
 	// upgrades
 	"github.com/classic-terra/core/v3/app/upgrades"
 	v11_2 "github.com/classic-terra/core/v3/app/upgrades/v11_2"
+	v12 "github.com/classic-terra/core/v3/app/upgrades/v12"
 	v2 "github.com/classic-terra/core/v3/app/upgrades/v2"
 	v3 "github.com/classic-terra/core/v3/app/upgrades/v3"
 	v4 "github.com/classic-terra/core/v3/app/upgrades/v4"
@@ -102,6 +108,7 @@ var (
 		v11.Upgrade,
 		v11_1.Upgrade,
 		v11_2.Upgrade,
+		v12.Upgrade,
 	}
 
 	// Forks defines forks to be applied to the network
@@ -136,6 +143,10 @@ type TerraApp struct {
 
 	// the configurator
 	configurator module.Configurator
+
+	// nodeQueryRouter routes the queries of node-local services, it is never
+	// reachable from state transitions like the GRPCQueryRouter
+	nodeQueryRouter *baseapp.GRPCQueryRouter
 }
 
 func init() {
@@ -166,14 +177,42 @@ func NewTerraApp(
 	baseAppOptions = append(baseAppOptions, baseapp.SetIAVLCacheSize(iavlCacheSize))
 	baseAppOptions = append(baseAppOptions, baseapp.SetIAVLDisableFastNode(iavlDisableFastNode))
 
+	laneMempoolConfig, err := appmempool.ReadLaneMempoolConfig(appOpts)
+	if err != nil {
+		panic("error while reading lane mempool config: " + err.Error())
+	}
+
+	fifoMempoolConfig, err := appmempool.ReadFifoMempoolConfig(appOpts)
+	if err != nil {
+		panic("error while reading fifo mempool config: " + err.Error())
+	}
+
 	// option for mempool
 	baseAppOptions = append(baseAppOptions, func(app *baseapp.BaseApp) {
-		var mempool *appmempool.FifoMempool
+		if laneMempoolConfig.Enable {
+			lanes, err := laneMempoolConfig.Lanes()
+			if err != nil {
+				panic("invalid lane mempool config: " + err.Error())
+			}
+
+			laneOpts := append(laneMempoolConfig.Options(), appmempool.LanesOpt(lanes...))
+			if maxTxs := cast.ToInt(appOpts.Get(server.FlagMempoolMaxTxs)); maxTxs > 0 {
+				laneOpts = append(laneOpts, appmempool.LaneMaxTxOpt(maxTxs))
+			}
+			mempool := appmempool.NewLaneMempool(laneOpts...)
+			handler := appmempool.NewLaneProposalHandler(mempool, app)
+			app.SetMempool(mempool)
+			app.SetTxEncoder(txConfig.TxEncoder())
+			app.SetPrepareProposal(handler.PrepareProposalHandler())
+			app.SetProcessProposal(handler.ProcessProposalHandler())
+			return
+		}
+
+		fifoOpts := fifoMempoolConfig.Options()
 		if maxTxs := cast.ToInt(appOpts.Get(server.FlagMempoolMaxTxs)); maxTxs > 0 {
-			mempool = appmempool.NewFifoMempool(appmempool.FifoMaxTxOpt(maxTxs))
-		} else {
-			mempool = appmempool.NewFifoMempool()
+			fifoOpts = append(fifoOpts, appmempool.FifoMaxTxOpt(maxTxs))
 		}
+		mempool := appmempool.NewFifoMempool(fifoOpts...)
 		handler := baseapp.NewDefaultProposalHandler(mempool, app)
 		app.SetMempool(mempool)
 		app.SetTxEncoder(txConfig.TxEncoder())
@@ -191,7 +230,9 @@ func NewTerraApp(
 		interfaceRegistry: interfaceRegistry,
 		txConfig:          txConfig,
 		invCheckPeriod:    invCheckPeriod,
+		nodeQueryRouter:   baseapp.NewGRPCQueryRouter(),
 	}
+	app.nodeQueryRouter.SetInterfaceRegistry(interfaceRegistry)
 
 	// Setup keepers
 	app.AppKeepers = keepers.NewAppKeepers(
@@ -232,6 +273,7 @@ func NewTerraApp(
 	app.mm.RegisterInvariants(app.CrisisKeeper)
 	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
 	app.mm.RegisterServices(app.configurator)
+	fork.RegisterQueryService(app.GRPCQueryRouter())
 	app.setupUpgradeHandlers()
 	app.setupUpgradeStoreLoaders()
 
@@ -248,6 +290,20 @@ func NewTerraApp(
 	app.MountTransientStores(app.GetTransientStoreKey())
 	app.MountMemoryStores(app.GetMemoryStoreKey())
 
+	// stream the decoded state changes of the custom modules
+	streamingConfig, err := appstreaming.ReadConfig(appOpts)
+	if err != nil {
+		panic("error while reading state streaming config: " + err.Error())
+	}
+
+	if streamingConfig.Enable {
+		streamingService, err := appstreaming.NewService(streamingConfig, homePath, app.GetKVStoreKey(), appCodec, logger)
+		if err != nil {
+			panic("error while creating state streaming service: " + err.Error())
+		}
+		bApp.SetStreamingService(streamingService)
+	}
+
 	// initialize BaseApp
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
@@ -259,23 +315,24 @@ func NewTerraApp(
 
 	anteHandler, err := customante.NewAnteHandler(
 		customante.HandlerOptions{
-			AccountKeeper:      app.AccountKeeper,
-			BankKeeper:         app.BankKeeper,
-			FeegrantKeeper:     app.FeeGrantKeeper,
-			OracleKeeper:       app.OracleKeeper,
-			TreasuryKeeper:     app.TreasuryKeeper,
-			SigGasConsumer:     ante.DefaultSigVerificationGasConsumer,
-			SignModeHandler:    encodingConfig.TxConfig.SignModeHandler(),
-			IBCKeeper:          *app.IBCKeeper,
-			WasmKeeper:         &app.WasmKeeper,
-			DistributionKeeper: app.DistrKeeper,
-			GovKeeper:          app.GovKeeper,
-			WasmConfig:         &wasmConfig,
-			TXCounterStoreKey:  app.GetKey(wasmtypes.StoreKey),
-			DyncommKeeper:      app.DyncommKeeper,
-			StakingKeeper:      app.StakingKeeper,
-			TaxKeeper:          &app.TaxKeeper,
-			Cdc:                app.appCodec,
+			AccountKeeper:        app.AccountKeeper,
+			BankKeeper:           app.BankKeeper,
+			FeegrantKeeper:       app.FeeGrantKeeper,
+			OracleKeeper:         app.OracleKeeper,
+			TreasuryKeeper:       app.TreasuryKeeper,
+			SigGasConsumer:       ante.DefaultSigVerificationGasConsumer,
+			SignModeHandler:      encodingConfig.TxConfig.SignModeHandler(),
+			IBCKeeper:            *app.IBCKeeper,
+			WasmKeeper:           &app.WasmKeeper,
+			DistributionKeeper:   app.DistrKeeper,
+			GovKeeper:            app.GovKeeper,
+			WasmConfig:           &wasmConfig,
+			TXCounterStoreKey:    app.GetKey(wasmtypes.StoreKey),
+			DyncommKeeper:        app.DyncommKeeper,
+			StakingKeeper:        app.StakingKeeper,
+			TaxKeeper:            &app.TaxKeeper,
+			CircuitBreakerKeeper: app.CircuitBreakerKeeper,
+			Cdc:                  app.appCodec,
 		},
 	)
 	if err != nil {
@@ -409,6 +466,16 @@ func (app *TerraApp) GetSubspace(moduleName string) paramstypes.Subspace {
 	return subspace
 }
 
+// ModuleManager returns the module manager of the app
+func (app *TerraApp) ModuleManager() *module.Manager {
+	return app.mm
+}
+
+// Configurator returns the configurator of the app
+func (app *TerraApp) Configurator() module.Configurator {
+	return app.configurator
+}
+
 // SimulationManager implements the SimulationApp interface
 func (app *TerraApp) SimulationManager() *module.SimulationManager {
 	return app.sm
@@ -423,6 +490,10 @@ func (app *TerraApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APIC
 	authtx.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 	// Register custom tx routes from grpc-gateway.
 	customauthtx.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+	// Register node-local mempool routes from grpc-gateway.
+	appmempool.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+	// Register soft-fork feature routes from grpc-gateway.
+	fork.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 	// Register new tendermint queries routes from grpc-gateway.
 	tmservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 	// Register legacy and grpc-gateway routes for all modules.
@@ -455,6 +526,39 @@ func (app *TerraApp) RegisterTendermintService(clientCtx client.Context) {
 
 func (app *TerraApp) RegisterNodeService(clientCtx client.Context) {
 	nodeservice.RegisterNodeService(clientCtx, app.GRPCQueryRouter())
+	// the mempool is node-local state, contracts must not be able to query it
+	appmempool.RegisterQueryService(app.nodeQueryRouter, app.Mempool(), app.txConfig.TxEncoder())
+}
+
+// RegisterGRPCServer registers the services of the GRPCQueryRouter and the
+// node-local mempool service with the gRPC server.
+func (app *TerraApp) RegisterGRPCServer(server gogogrpc.Server) {
+	app.BaseApp.RegisterGRPCServer(server)
+	appmempool.RegisterQueryService(server, app.Mempool(), app.txConfig.TxEncoder())
+}
+
+// Query implements the ABCI Query method, queries which are not routed by the
+// BaseApp are routed to the node-local services.
+func (app *TerraApp) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
+	handler := app.nodeQueryRouter.Route(req.Path)
+	if handler == nil || app.GRPCQueryRouter().Route(req.Path) != nil {
+		return app.BaseApp.Query(req)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			res = sdkerrors.QueryResult(errorsmod.Wrapf(sdkerrors.ErrPanic, "%v", r), false)
+		}
+	}()
+
+	ctx := sdk.NewContext(nil, tmproto.Header{Height: app.LastBlockHeight()}, true, app.Logger())
+	res, err := handler(ctx, req)
+	if err != nil {
+		return sdkerrors.QueryResult(errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error()), false)
+	}
+
+	res.Height = app.LastBlockHeight()
+	return res
 }
 
 // RegisterSwaggerAPI registers swagger route with API Server
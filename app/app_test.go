@@ -0,0 +1,32 @@
+package app_test
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+
+	appmempool "github.com/classic-terra/core/v3/app/mempool"
+	apptesting "github.com/classic-terra/core/v3/app/testing"
+)
+
+func TestMempoolQueryIsNodeLocal(t *testing.T) {
+	terraApp := apptesting.SetupApp(t, "node-local-test")
+	terraApp.RegisterNodeService(client.Context{})
+
+	path := "/terra.mempool.v1beta1.Query/PendingTxs"
+	// contracts and stargate queries use the GRPCQueryRouter
+	require.Nil(t, terraApp.GRPCQueryRouter().Route(path))
+
+	req, err := (&appmempool.QueryPendingTxsRequest{}).Marshal()
+	require.NoError(t, err)
+
+	res := terraApp.Query(abci.RequestQuery{Path: path, Data: req})
+	require.True(t, res.IsOK(), res.Log)
+
+	var pending appmempool.QueryPendingTxsResponse
+	require.NoError(t, pending.Unmarshal(res.Value))
+	require.Empty(t, pending.Txs)
+}
@@ -13,12 +13,27 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	oracletypes "github.com/classic-terra/core/v3/x/oracle/types"
+	"github.com/classic-terra/core/v3/x/treasury"
+	treasurytypes "github.com/classic-terra/core/v3/x/treasury/types"
 )
 
+// FlagPreserveCustomState is the export flag keeping the state of the custom
+// modules on a zero height export
+const FlagPreserveCustomState = "preserve-custom-state"
+
 // ExportAppStateAndValidators exports the state of the application for a genesis
-// file.
+// file. A zero height export resets the oracle and market state.
 func (app *TerraApp) ExportAppStateAndValidators(
 	forZeroHeight bool, jailAllowedAddrs, modulesToExport []string,
+) (servertypes.ExportedApp, error) {
+	return app.ExportAppStateAndValidatorsWithOptions(forZeroHeight, false, jailAllowedAddrs, modulesToExport)
+}
+
+// ExportAppStateAndValidatorsWithOptions exports the state of the application for
+// a genesis file. A zero height export with preserveCustomState keeps the state
+// of the custom modules, see prepCustomModulesForZeroHeightGenesis.
+func (app *TerraApp) ExportAppStateAndValidatorsWithOptions(
+	forZeroHeight, preserveCustomState bool, jailAllowedAddrs, modulesToExport []string,
 ) (servertypes.ExportedApp, error) {
 	// as if they could withdraw from the start of the next block
 	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
@@ -28,10 +43,15 @@ func (app *TerraApp) ExportAppStateAndValidators(
 	height := app.LastBlockHeight() + 1
 	if forZeroHeight {
 		height = 0
-		app.prepForZeroHeightGenesis(ctx, jailAllowedAddrs)
+		app.prepForZeroHeightGenesis(ctx, jailAllowedAddrs, preserveCustomState)
 	}
 
 	genState := app.mm.ExportGenesisForModules(ctx, app.appCodec, modulesToExport)
+	if forZeroHeight && preserveCustomState && genState[treasurytypes.ModuleName] != nil {
+		// the treasury exports the states of the epochs up to the export height,
+		// the epochs of the restarted chain begin at height zero
+		genState[treasurytypes.ModuleName] = app.appCodec.MustMarshalJSON(treasury.ExportGenesis(ctx.WithBlockHeight(0), app.TreasuryKeeper))
+	}
 	appState, err := json.MarshalIndent(genState, "", "  ")
 	if err != nil {
 		return servertypes.ExportedApp{}, err
@@ -50,7 +70,7 @@ func (app *TerraApp) ExportAppStateAndValidators(
 // NOTE zero height genesis is a temporary feature which will be deprecated
 //
 //	in favour of export at a block height
-func (app *TerraApp) prepForZeroHeightGenesis(ctx sdk.Context, jailAllowedAddrs []string) {
+func (app *TerraApp) prepForZeroHeightGenesis(ctx sdk.Context, jailAllowedAddrs []string, preserveCustomState bool) {
 	applyAllowedAddrs := false
 
 	// check if there is a allowed address list
@@ -194,6 +214,11 @@ func (app *TerraApp) prepForZeroHeightGenesis(ctx sdk.Context, jailAllowedAddrs
 		},
 	)
 
+	if preserveCustomState {
+		app.prepCustomModulesForZeroHeightGenesis(ctx)
+		return
+	}
+
 	/* Handle oracle state. */
 
 	// Clear all prices
@@ -202,6 +227,42 @@ func (app *TerraApp) prepForZeroHeightGenesis(ctx sdk.Context, jailAllowedAddrs
 		return false
 	})
 
+	app.resetOracleVotes(ctx)
+
+	/* Handle market state. */
+
+	// clear all market pools
+	app.MarketKeeper.SetTerraPoolDelta(ctx, sdk.ZeroDec())
+}
+
+// prepCustomModulesForZeroHeightGenesis keeps the state of the custom modules
+// for a fresh start at zero height, only state bound to the heights of the
+// exported chain is reinitialised:
+//
+//   - oracle: exchange rates, feeder delegations and tobin taxes are kept.
+//     Prevotes and votes belong to vote periods of the exported chain and are
+//     dropped, miss counters are reset as the slash window restarts.
+//   - market: params and the terra pool delta are kept.
+//   - treasury: params, tax rate, reward weight, tax caps, tax proceeds and
+//     initial issuance of the current epoch are kept. The epoch states are
+//     dropped as the epochs restart at zero, the tax rate and reward weight
+//     are not updated before the probation window has passed again.
+//   - tax: params and contract tax policies are kept. Gas discount usages and
+//     contract tax usages count within block windows and are never exported.
+//   - dyncomm: params and the rates of all validators are kept. The commission
+//     history is dropped as the epochs restart at zero.
+func (app *TerraApp) prepCustomModulesForZeroHeightGenesis(ctx sdk.Context) {
+	app.resetOracleVotes(ctx)
+
+	app.TreasuryKeeper.ClearTRs(ctx)
+	app.TreasuryKeeper.ClearSRs(ctx)
+	app.TreasuryKeeper.ClearTSLs(ctx)
+
+	app.DyncommKeeper.ClearCommissionHistory(ctx)
+}
+
+// resetOracleVotes resets the miss counters and drops all prevotes and votes
+func (app *TerraApp) resetOracleVotes(ctx sdk.Context) {
 	app.OracleKeeper.IterateMissCounters(ctx, func(operator sdk.ValAddress, _ uint64) bool {
 		app.OracleKeeper.SetMissCounter(ctx, operator, 0)
 		return false
@@ -216,9 +277,4 @@ func (app *TerraApp) prepForZeroHeightGenesis(ctx sdk.Context, jailAllowedAddrs
 		app.OracleKeeper.DeleteAggregateExchangeRateVote(ctx, voterAddr)
 		return false
 	})
-
-	/* Handle market state. */
-
-	// clear all market pools
-	app.MarketKeeper.SetTerraPoolDelta(ctx, sdk.ZeroDec())
 }
@@ -0,0 +1,185 @@
+package app_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/classic-terra/core/v3/app"
+	apptesting "github.com/classic-terra/core/v3/app/testing"
+	core "github.com/classic-terra/core/v3/types"
+	dyncommtypes "github.com/classic-terra/core/v3/x/dyncomm/types"
+	markettypes "github.com/classic-terra/core/v3/x/market/types"
+	oracletypes "github.com/classic-terra/core/v3/x/oracle/types"
+	taxtypes "github.com/classic-terra/core/v3/x/tax/types"
+	treasurytypes "github.com/classic-terra/core/v3/x/treasury/types"
+)
+
+const exportChainID = "export-test"
+
+var customModules = []string{
+	oracletypes.ModuleName,
+	markettypes.ModuleName,
+	treasurytypes.ModuleName,
+	taxtypes.ModuleName,
+	dyncommtypes.ModuleName,
+}
+
+func TestExportImportRoundTrip(t *testing.T) {
+	for _, tc := range []struct {
+		name                string
+		forZeroHeight       bool
+		preserveCustomState bool
+	}{
+		{"height", false, false},
+		{"zero height", true, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			terraApp := apptesting.SetupAppWithGenesis(t, exportChainID, app.NewDefaultGenesisState())
+			populateCustomModules(t, terraApp)
+
+			exported, err := terraApp.ExportAppStateAndValidatorsWithOptions(tc.forZeroHeight, tc.preserveCustomState, nil, nil)
+			require.NoError(t, err)
+
+			restarted := importApp(t, exported)
+			reexported, err := restarted.ExportAppStateAndValidatorsWithOptions(tc.forZeroHeight, tc.preserveCustomState, nil, nil)
+			require.NoError(t, err)
+
+			genesis, regenesis := customModuleGenesis(t, exported), customModuleGenesis(t, reexported)
+			for _, module := range customModules {
+				require.JSONEq(t, string(genesis[module]), string(regenesis[module]), module)
+			}
+		})
+	}
+}
+
+func TestExportZeroHeightPreservesCustomState(t *testing.T) {
+	terraApp := apptesting.SetupAppWithGenesis(t, exportChainID, app.NewDefaultGenesisState())
+	populateCustomModules(t, terraApp)
+
+	exported, err := terraApp.ExportAppStateAndValidatorsWithOptions(true, true, nil, nil)
+	require.NoError(t, err)
+	genesis := customModuleGenesis(t, exported)
+	cdc := terraApp.AppCodec()
+
+	var oracleGenesis oracletypes.GenesisState
+	cdc.MustUnmarshalJSON(genesis[oracletypes.ModuleName], &oracleGenesis)
+	require.Equal(t, oracletypes.ExchangeRateTuples{{Denom: core.MicroUSDDenom, ExchangeRate: sdk.NewDec(7)}}, oracletypes.ExchangeRateTuples(oracleGenesis.ExchangeRates))
+	require.Len(t, oracleGenesis.FeederDelegations, 1)
+	require.Len(t, oracleGenesis.MissCounters, 1)
+	require.Zero(t, oracleGenesis.MissCounters[0].MissCounter)
+	require.Empty(t, oracleGenesis.AggregateExchangeRatePrevotes)
+	require.Empty(t, oracleGenesis.AggregateExchangeRateVotes)
+
+	var marketGenesis markettypes.GenesisState
+	cdc.MustUnmarshalJSON(genesis[markettypes.ModuleName], &marketGenesis)
+	// the pool delta recovers at the end of every block
+	require.True(t, marketGenesis.TerraPoolDelta.IsPositive())
+
+	var treasuryGenesis treasurytypes.GenesisState
+	cdc.MustUnmarshalJSON(genesis[treasurytypes.ModuleName], &treasuryGenesis)
+	require.Equal(t, sdk.NewDecWithPrec(3, 3), treasuryGenesis.TaxRate)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 500)), treasuryGenesis.TaxProceeds)
+	require.Empty(t, treasuryGenesis.EpochStates)
+
+	var taxGenesis taxtypes.GenesisState
+	cdc.MustUnmarshalJSON(genesis[taxtypes.ModuleName], &taxGenesis)
+	require.Len(t, taxGenesis.ContractTaxPolicies, 1)
+
+	var dyncommGenesis dyncommtypes.GenesisState
+	cdc.MustUnmarshalJSON(genesis[dyncommtypes.ModuleName], &dyncommGenesis)
+	require.Len(t, dyncommGenesis.ValidatorCommissionRates, 1)
+	rate := dyncommGenesis.ValidatorCommissionRates[0]
+	require.Equal(t, sdk.NewDecWithPrec(1, 3), *rate.TargetCommissionRate)
+	require.True(t, rate.MinCommissionRate.GT(*rate.TargetCommissionRate))
+	require.Empty(t, dyncommGenesis.ValidatorCommissionHistory)
+}
+
+// populateCustomModules sets state in all custom modules and commits the block
+func populateCustomModules(t *testing.T, terraApp *app.TerraApp) {
+	t.Helper()
+
+	header := tmproto.Header{ChainID: exportChainID, Height: terraApp.LastBlockHeight() + 1}
+	ctx := terraApp.NewContext(false, header)
+
+	validators := terraApp.StakingKeeper.GetAllValidators(ctx)
+	require.Len(t, validators, 1)
+	valAddr := validators[0].GetOperator()
+	feeder := sdk.AccAddress(valAddr)
+
+	terraApp.OracleKeeper.SetLunaExchangeRate(ctx, core.MicroUSDDenom, sdk.NewDec(7))
+	terraApp.OracleKeeper.SetFeederDelegation(ctx, valAddr, feeder)
+	terraApp.OracleKeeper.SetMissCounter(ctx, valAddr, 3)
+	terraApp.OracleKeeper.SetAggregateExchangeRatePrevote(ctx, valAddr,
+		oracletypes.NewAggregateExchangeRatePrevote(oracletypes.AggregateVoteHash{1, 2, 3}, valAddr, uint64(header.Height)))
+	terraApp.OracleKeeper.SetAggregateExchangeRateVote(ctx, valAddr,
+		oracletypes.NewAggregateExchangeRateVote(oracletypes.ExchangeRateTuples{{Denom: core.MicroUSDDenom, ExchangeRate: sdk.NewDec(7)}}, valAddr))
+
+	terraApp.MarketKeeper.SetTerraPoolDelta(ctx, sdk.NewDec(1000))
+
+	terraApp.TreasuryKeeper.SetTaxRate(ctx, sdk.NewDecWithPrec(3, 3))
+	terraApp.TreasuryKeeper.SetRewardWeight(ctx, sdk.NewDecWithPrec(5, 2))
+	terraApp.TreasuryKeeper.SetTaxCap(ctx, core.MicroUSDDenom, sdk.NewInt(1000000))
+	terraApp.TreasuryKeeper.SetEpochTaxProceeds(ctx, sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 500)))
+	terraApp.TreasuryKeeper.SetEpochInitialIssuance(ctx, sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 1000000)))
+	terraApp.TreasuryKeeper.SetTR(ctx, 0, sdk.NewDecWithPrec(1, 2))
+
+	require.NoError(t, terraApp.TaxKeeper.SetContractTaxPolicy(ctx, taxtypes.ContractTaxPolicy{
+		CodeId: 1,
+		Mode:   taxtypes.TaxPolicyModeExempt,
+	}))
+
+	// the target rate stays below the commission rate enforced by the min rate
+	validator := validators[0]
+	terraApp.DyncommKeeper.SetTargetCommissionRate(ctx, validator.OperatorAddress, sdk.NewDecWithPrec(1, 3))
+	terraApp.DyncommKeeper.UpdateValidatorMinRates(ctx, validator)
+
+	terraApp.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	terraApp.Commit()
+}
+
+// importApp starts a new app from the exported state
+func importApp(t *testing.T, exported servertypes.ExportedApp) *app.TerraApp {
+	t.Helper()
+
+	terraApp := app.NewTerraApp(
+		log.NewNopLogger(),
+		dbm.NewMemDB(),
+		nil,
+		true,
+		map[int64]bool{},
+		app.DefaultNodeHome,
+		app.MakeEncodingConfig(),
+		simtestutil.EmptyAppOptions{},
+		nil,
+		baseapp.SetChainID(exportChainID),
+	)
+
+	terraApp.InitChain(abci.RequestInitChain{
+		ChainId:         exportChainID,
+		ConsensusParams: exported.ConsensusParams,
+		AppStateBytes:   exported.AppState,
+		InitialHeight:   exported.Height,
+	})
+	terraApp.Commit()
+
+	return terraApp
+}
+
+func customModuleGenesis(t *testing.T, exported servertypes.ExportedApp) map[string]json.RawMessage {
+	t.Helper()
+
+	var genesis map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(exported.AppState, &genesis))
+	return genesis
+}
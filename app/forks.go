@@ -6,11 +6,13 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// BeginBlockForks is intended to be ran in a chain upgrade.
+// BeginBlockForks is intended to be ran in a chain upgrade. The fork logic runs
+// at the activation height of the fork feature on the chain.
 func BeginBlockForks(ctx sdk.Context, app *TerraApp) {
 	for _, fork := range Forks {
-		if ctx.BlockHeight() == fork.UpgradeHeight {
-			ctx.Logger().Info(fmt.Sprintf("applying fork %s at height %d", fork.UpgradeName, fork.UpgradeHeight))
+		height, found := fork.Feature.ActivationHeight(ctx.ChainID())
+		if found && ctx.BlockHeight() == height {
+			ctx.Logger().Info(fmt.Sprintf("applying fork %s (%s) at height %d", fork.UpgradeName, fork.Feature.Name, height))
 
 			fork.BeginForkLogic(ctx, app.AppKeepers, app.mm)
 			return
@@ -0,0 +1,72 @@
+package app_test
+
+import (
+	"testing"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/classic-terra/core/v3/app"
+	"github.com/classic-terra/core/v3/app/keepers"
+	apptesting "github.com/classic-terra/core/v3/app/testing"
+	"github.com/classic-terra/core/v3/app/upgrades"
+	core "github.com/classic-terra/core/v3/types"
+	"github.com/classic-terra/core/v3/types/fork"
+)
+
+type ForksTestSuite struct {
+	apptesting.KeeperTestHelper
+}
+
+func TestForksTestSuite(t *testing.T) {
+	suite.Run(t, new(ForksTestSuite))
+}
+
+func (s *ForksTestSuite) TestBeginBlockForksReplay() {
+	forks := app.Forks
+	s.T().Cleanup(func() { app.Forks = forks })
+
+	for _, chainID := range []string{core.ColumbusChainID, core.BombayChainID} {
+		s.Setup(s.T(), chainID)
+
+		applied := []int64{}
+		app.Forks = []upgrades.Fork{{
+			UpgradeName: "test",
+			Feature:     fork.MustGetFeature(fork.FeatureSwapDisable),
+			BeginForkLogic: func(ctx sdk.Context, _ *keepers.AppKeepers, _ *module.Manager) {
+				applied = append(applied, ctx.BlockHeight())
+			},
+		}}
+
+		for height := fork.SwapDisableHeight - 2; height <= fork.SwapDisableHeight+2; height++ {
+			ctx := s.Ctx.WithBlockHeader(tmproto.Header{ChainID: chainID, Height: height})
+			app.BeginBlockForks(ctx, s.App)
+			s.Require().Equal(chainID != core.ColumbusChainID || height >= fork.SwapDisableHeight, fork.IsFeatureActive(ctx, fork.FeatureSwapDisable))
+		}
+
+		if chainID == core.ColumbusChainID {
+			s.Require().Equal([]int64{fork.SwapDisableHeight}, applied)
+		} else {
+			// the feature has no activation height on bombay, so the fork logic never runs
+			s.Require().Empty(applied)
+		}
+	}
+}
+
+func (s *ForksTestSuite) TestQueryActiveFeatures() {
+	s.Setup(s.T(), core.ColumbusChainID)
+	queryClient := fork.NewQueryClient(s.QueryHelper)
+
+	s.QueryHelper.Ctx = s.Ctx.WithBlockHeight(fork.SwapDisableHeight - 1)
+	res, err := queryClient.ActiveFeatures(sdk.WrapSDKContext(s.QueryHelper.Ctx), &fork.QueryActiveFeaturesRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(res.Features, 2)
+
+	s.QueryHelper.Ctx = s.Ctx.WithBlockHeight(fork.SwapDisableHeight)
+	res, err = queryClient.ActiveFeatures(sdk.WrapSDKContext(s.QueryHelper.Ctx), &fork.QueryActiveFeaturesRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(fork.ActiveFeature{Name: fork.FeatureSwapDisable, ActivationHeight: fork.SwapDisableHeight}, res.Features[2])
+}
@@ -3,6 +3,8 @@ package keepers
 import (
 	"path/filepath"
 
+	packetforwardkeeper "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/keeper"
+	packetforwardtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
 	ibchooks "github.com/cosmos/ibc-apps/modules/ibc-hooks/v7"
 	ibchookskeeper "github.com/cosmos/ibc-apps/modules/ibc-hooks/v7/keeper"
 	ibchookstypes "github.com/cosmos/ibc-apps/modules/ibc-hooks/v7/types"
@@ -18,6 +20,8 @@ import (
 	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
 	ibckeeper "github.com/cosmos/ibc-go/v7/modules/core/keeper"
 
+	stargatekeeper "github.com/classic-terra/core/v3/x/stargate/keeper"
+	stargatetypes "github.com/classic-terra/core/v3/x/stargate/types"
 	taxkeeper "github.com/classic-terra/core/v3/x/tax/keeper"
 	taxtypes "github.com/classic-terra/core/v3/x/tax/types"
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -62,14 +66,21 @@ import (
 	customstaking "github.com/classic-terra/core/v3/custom/staking"
 	customwasmkeeper "github.com/classic-terra/core/v3/custom/wasm/keeper"
 	terrawasm "github.com/classic-terra/core/v3/wasmbinding"
+	circuitbreakerkeeper "github.com/classic-terra/core/v3/x/circuitbreaker/keeper"
+	circuitbreakertypes "github.com/classic-terra/core/v3/x/circuitbreaker/types"
 	dyncommkeeper "github.com/classic-terra/core/v3/x/dyncomm/keeper"
 	dyncommtypes "github.com/classic-terra/core/v3/x/dyncomm/types"
+	icaauthkeeper "github.com/classic-terra/core/v3/x/icaauth/keeper"
+	icaauthtypes "github.com/classic-terra/core/v3/x/icaauth/types"
 	marketkeeper "github.com/classic-terra/core/v3/x/market/keeper"
 	markettypes "github.com/classic-terra/core/v3/x/market/types"
 	oraclekeeper "github.com/classic-terra/core/v3/x/oracle/keeper"
 	oracletypes "github.com/classic-terra/core/v3/x/oracle/types"
+	ratelimitkeeper "github.com/classic-terra/core/v3/x/ratelimit/keeper"
+	ratelimittypes "github.com/classic-terra/core/v3/x/ratelimit/types"
 	treasurykeeper "github.com/classic-terra/core/v3/x/treasury/keeper"
 	treasurytypes "github.com/classic-terra/core/v3/x/treasury/types"
+	vestingkeeper "github.com/classic-terra/core/v3/x/vesting/keeper"
 )
 
 type AppKeepers struct {
@@ -106,6 +117,12 @@ type AppKeepers struct {
 	IBCHooksKeeper        *ibchookskeeper.Keeper
 	ConsensusParamsKeeper consensusparamkeeper.Keeper
 	TaxKeeper             taxkeeper.Keeper
+	StargateKeeper        stargatekeeper.Keeper
+	VestingKeeper         vestingkeeper.Keeper
+	RateLimitKeeper       ratelimitkeeper.Keeper
+	PacketForwardKeeper   *packetforwardkeeper.Keeper
+	ICAAuthKeeper         icaauthkeeper.Keeper
+	CircuitBreakerKeeper  circuitbreakerkeeper.Keeper
 
 	Ics20WasmHooks  *ibchooks.WasmHooks
 	IBCHooksWrapper *ibchooks.ICS4Middleware
@@ -160,6 +177,11 @@ func NewAppKeepers(
 		wasmtypes.StoreKey,
 		dyncommtypes.StoreKey,
 		taxtypes.StoreKey,
+		stargatetypes.StoreKey,
+		ratelimittypes.StoreKey,
+		packetforwardtypes.StoreKey,
+		icaauthtypes.StoreKey,
+		circuitbreakertypes.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
@@ -274,10 +296,30 @@ func NewAppKeepers(
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
+	// BEFORE the keepers routing messages outside of the ante handler, which
+	// are wrapped to reject the messages of tripped breakers
+	appKeepers.CircuitBreakerKeeper = circuitbreakerkeeper.NewKeeper(
+		appCodec,
+		appKeepers.keys[circuitbreakertypes.StoreKey],
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	appKeepers.DyncommKeeper = dyncommkeeper.NewKeeper(
+		appCodec,
+		appKeepers.keys[dyncommtypes.StoreKey],
+		appKeepers.GetSubspace(dyncommtypes.ModuleName),
+		appKeepers.StakingKeeper,
+	)
+
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
 	appKeepers.StakingKeeper.SetHooks(
-		stakingtypes.NewMultiStakingHooks(customstaking.NewTerraStakingHooks(*appKeepers.StakingKeeper), appKeepers.DistrKeeper.Hooks(), appKeepers.SlashingKeeper.Hooks()),
+		stakingtypes.NewMultiStakingHooks(
+			customstaking.NewTerraStakingHooks(*appKeepers.StakingKeeper),
+			appKeepers.DistrKeeper.Hooks(),
+			appKeepers.SlashingKeeper.Hooks(),
+			appKeepers.DyncommKeeper.Hooks(),
+		),
 	)
 
 	// Create IBC Keeper
@@ -307,7 +349,7 @@ func NewAppKeepers(
 		&appKeepers.IBCKeeper.PortKeeper,
 		appKeepers.AccountKeeper,
 		scopedICAHostKeeper,
-		bApp.MsgServiceRouter(),
+		circuitbreakerkeeper.NewMsgRouter(appKeepers.CircuitBreakerKeeper, bApp.MsgServiceRouter(), false),
 	)
 
 	appKeepers.ICAHostKeeper.WithQueryRouter(bApp.GRPCQueryRouter())
@@ -369,18 +411,47 @@ func NewAppKeepers(
 	)
 	appKeepers.IBCHooksWrapper = &hooksMiddleware
 
+	// rate limit the transfers sent through ics29 fee
+	appKeepers.RateLimitKeeper = ratelimitkeeper.NewKeeper(
+		appCodec,
+		appKeepers.keys[ratelimittypes.StoreKey],
+		appKeepers.BankKeeper,
+		appKeepers.IBCFeeKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	// Transfer keeper will be set later once it is initialized
+	appKeepers.PacketForwardKeeper = packetforwardkeeper.NewKeeper(
+		appCodec,
+		appKeepers.keys[packetforwardtypes.StoreKey],
+		nil,
+		appKeepers.IBCKeeper.ChannelKeeper,
+		appKeepers.BankKeeper,
+		appKeepers.RateLimitKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
 	// Create Transfer Keepers AFTER Hooks keeper but BEFORE wasm
 	appKeepers.TransferKeeper = ibctransferkeeper.NewKeeper(
 		appCodec,
 		appKeepers.keys[ibctransfertypes.StoreKey],
 		appKeepers.GetSubspace(ibctransfertypes.ModuleName),
-		appKeepers.IBCFeeKeeper,
+		appKeepers.PacketForwardKeeper, // use packet forward as ics4Wrapper in middleware stack
 		appKeepers.IBCKeeper.ChannelKeeper,
 		&appKeepers.IBCKeeper.PortKeeper,
 		appKeepers.AccountKeeper,
 		appKeepers.BankKeeper,
 		scopedTransferKeeper,
 	)
+	appKeepers.PacketForwardKeeper.SetTransferKeeper(appKeepers.TransferKeeper)
+
+	appKeepers.StargateKeeper = stargatekeeper.NewKeeper(
+		appCodec,
+		appKeepers.keys[stargatetypes.StoreKey],
+		appCodec.(codec.ProtoCodecMarshaler).InterfaceRegistry(),
+		bApp.GRPCQueryRouter(),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
 
 	wasmConfig, err := wasm.ReadWasmConfig(appOpts)
 	if err != nil {
@@ -388,15 +459,17 @@ func NewAppKeepers(
 	}
 	supportedFeatures := "iterator,staking,stargate,terra,cosmwasm_1_1,cosmwasm_1_2,cosmwasm_1_3"
 
+	// messages dispatched by contracts skip the ante handler
+	wasmMsgRouter := circuitbreakerkeeper.NewMsgRouter(appKeepers.CircuitBreakerKeeper, bApp.MsgServiceRouter(), true)
 	wasmMsgHandler := customwasmkeeper.NewMessageHandler(
-		bApp.MsgServiceRouter(),
+		wasmMsgRouter,
 		appKeepers.IBCFeeKeeper,
 		appKeepers.IBCKeeper.ChannelKeeper,
 		scopedWasmKeeper,
 		appKeepers.BankKeeper,
 		appKeepers.TreasuryKeeper,
 		appKeepers.AccountKeeper,
-		appKeepers.TaxKeeper,
+		&appKeepers.TaxKeeper,
 		appCodec,
 		appKeepers.TransferKeeper,
 	)
@@ -407,9 +480,12 @@ func NewAppKeepers(
 	wasmOpts = append(
 		wasmOpts,
 		terrawasm.RegisterCustomPlugins(
+			wasmMsgRouter,
 			&appKeepers.MarketKeeper,
 			&appKeepers.OracleKeeper,
 			&appKeepers.TreasuryKeeper,
+			&appKeepers.TaxKeeper,
+			&appKeepers.DyncommKeeper,
 		)...,
 	)
 	wasmOpts = append(
@@ -417,6 +493,7 @@ func NewAppKeepers(
 		terrawasm.RegisterStargateQueries(
 			*bApp.GRPCQueryRouter(),
 			appCodec,
+			appKeepers.StargateKeeper,
 		)...,
 	)
 	appKeepers.WasmKeeper = wasmkeeper.NewKeeper(
@@ -431,7 +508,7 @@ func NewAppKeepers(
 		&appKeepers.IBCKeeper.PortKeeper,
 		scopedWasmKeeper,
 		appKeepers.TransferKeeper,
-		bApp.MsgServiceRouter(),
+		wasmMsgRouter,
 		bApp.GRPCQueryRouter(),
 		filepath.Join(homePath, "data"),
 		wasmConfig,
@@ -443,6 +520,15 @@ func NewAppKeepers(
 	// AFTER wasm set contractKeeper for ics20 wasm hook
 	appKeepers.Ics20WasmHooks.ContractKeeper = &appKeepers.WasmKeeper
 
+	// AFTER wasm as the packet callbacks are delivered to contracts
+	appKeepers.ICAAuthKeeper = icaauthkeeper.NewKeeper(
+		appCodec,
+		appKeepers.keys[icaauthtypes.StoreKey],
+		appKeepers.ICAControllerKeeper,
+		&appKeepers.WasmKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
 	// register the proposal types
 	govRouter := appKeepers.newGovRouter()
 	govConfig := govtypes.DefaultConfig()
@@ -470,14 +556,17 @@ func NewAppKeepers(
 		appKeepers.BankKeeper,
 		appKeepers.TreasuryKeeper,
 		appKeepers.DistrKeeper,
+		appKeepers.OracleKeeper,
+		&appKeepers.WasmKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
-	appKeepers.DyncommKeeper = dyncommkeeper.NewKeeper(
+	appKeepers.VestingKeeper = vestingkeeper.NewKeeper(
 		appCodec,
-		appKeepers.keys[dyncommtypes.StoreKey],
-		appKeepers.GetSubspace(dyncommtypes.ModuleName),
-		appKeepers.StakingKeeper,
+		appKeepers.AccountKeeper,
+		appKeepers.BankKeeper,
+		appKeepers.DistrKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	appKeepers.ScopedIBCKeeper = scopedIBCKeeper
@@ -521,6 +610,7 @@ func initParamsKeeper(
 	paramsKeeper.Subspace(wasmtypes.ModuleName)
 	paramsKeeper.Subspace(dyncommtypes.ModuleName)
 	paramsKeeper.Subspace(taxtypes.ModuleName)
+	paramsKeeper.Subspace(packetforwardtypes.ModuleName)
 
 	return paramsKeeper
 }
@@ -12,6 +12,8 @@ import (
 	ibcclienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
 	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
 
+	icaauth "github.com/classic-terra/core/v3/x/icaauth/module"
+	ratelimit "github.com/classic-terra/core/v3/x/ratelimit/module"
 	"github.com/classic-terra/core/v3/x/treasury"
 	treasurytypes "github.com/classic-terra/core/v3/x/treasury/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -24,6 +26,8 @@ import (
 	"github.com/CosmWasm/wasmd/x/wasm"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 
+	packetforward "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward"
+	packetforwardkeeper "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/keeper"
 	ibchooks "github.com/cosmos/ibc-apps/modules/ibc-hooks/v7"
 )
 
@@ -46,6 +50,17 @@ func (appKeepers *AppKeepers) newIBCRouter() *porttypes.Router {
 	var transferHookFeeStack porttypes.IBCModule
 
 	transferStack = transfer.NewIBCModule(appKeepers.TransferKeeper)
+	transferStack = ratelimit.NewIBCMiddleware(transferStack, appKeepers.RateLimitKeeper)
+	// forwarded hops are sent through the transfer keeper directly and are therefore never
+	// subject to FilterMsgAndComputeTax or the tax msg server handlers, no burn tax exemption
+	// is required for the intermediate receiver
+	transferStack = packetforward.NewIBCMiddleware(
+		transferStack,
+		appKeepers.PacketForwardKeeper,
+		0, // retries on timeout
+		packetforwardkeeper.DefaultForwardTransferPacketTimeoutTimestamp,
+		packetforwardkeeper.DefaultRefundTransferPacketTimeoutTimestamp,
+	)
 	transferHookStack = ibchooks.NewIBCMiddleware(transferStack, appKeepers.IBCHooksWrapper)
 	transferHookFeeStack = ibcfee.NewIBCMiddleware(transferHookStack, appKeepers.IBCFeeKeeper)
 
@@ -53,10 +68,12 @@ func (appKeepers *AppKeepers) newIBCRouter() *porttypes.Router {
 	// SendPacket, since it is originating from the application to core IBC:
 	// icaAuthModuleKeeper.SendTx -> icaController.SendPacket -> fee.SendPacket -> channel.SendPacket
 	var icaControllerStack porttypes.IBCModule
-	// integration point for custom authentication modules
+	// icaauth is the custom authentication module receiving the packet callbacks of the
+	// interchain accounts it registered, accounts registered through the controller msg
+	// server are not routed to it
 	// see https://medium.com/the-interchain-foundation/ibc-go-v6-changes-to-interchain-accounts-and-how-it-impacts-your-chain-806c185300d7
-	var noAuthzModule porttypes.IBCModule
-	icaControllerStack = icacontroller.NewIBCMiddleware(noAuthzModule, appKeepers.ICAControllerKeeper)
+	icaControllerStack = icaauth.NewIBCModule(appKeepers.ICAAuthKeeper)
+	icaControllerStack = icacontroller.NewIBCMiddleware(icaControllerStack, appKeepers.ICAControllerKeeper)
 	icaControllerStack = ibcfee.NewIBCMiddleware(icaControllerStack, appKeepers.IBCFeeKeeper)
 
 	// RecvPacket, message that originates from core IBC and goes down to app, the flow is:
@@ -0,0 +1,150 @@
+package cli
+
+import (
+	"context"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	appmempool "github.com/classic-terra/core/v3/app/mempool"
+)
+
+const (
+	flagLane       = "lane"
+	flagMsgType    = "msg-type"
+	flagOutputFile = "output-file"
+)
+
+// GetMempoolCmd returns the commands to inspect the mempool of a node.
+func GetMempoolCmd() *cobra.Command {
+	mempoolCmd := &cobra.Command{
+		Use:                        "mempool",
+		Short:                      "Inspecting the app-side mempool of the connected node",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	mempoolCmd.AddCommand(
+		GetCmdPendingTxs(),
+		GetCmdDump(),
+	)
+
+	return mempoolCmd
+}
+
+// GetCmdPendingTxs implements a command to list the pending txs of the mempool.
+func GetCmdPendingTxs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending",
+		Short: "Query the pending txs of the mempool per lane",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := appmempool.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req, err := readPendingTxsRequest(cmd)
+			if err != nil {
+				return err
+			}
+			req.Pagination = pageReq
+
+			res, err := queryClient.PendingTxs(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addFilterFlags(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending txs")
+
+	return cmd
+}
+
+// GetCmdDump implements a command to write all pending txs of the mempool to JSON.
+func GetCmdDump() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dump",
+		Short: "Write the current contents of the mempool to JSON",
+		Long: `Write the current contents of the mempool to JSON, e.g. to debug stuck oracle votes:
+
+$ terrad mempool dump --lane oracle --output-file mempool.json
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := appmempool.NewQueryClient(clientCtx)
+
+			req, err := readPendingTxsRequest(cmd)
+			if err != nil {
+				return err
+			}
+
+			req.Pagination = &query.PageRequest{Limit: query.MaxLimit, CountTotal: true}
+			dump, err := queryClient.PendingTxs(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			outputFile, err := cmd.Flags().GetString(flagOutputFile)
+			if err != nil {
+				return err
+			}
+
+			if outputFile == "" {
+				return clientCtx.PrintProto(dump)
+			}
+
+			bz, err := clientCtx.Codec.MarshalJSON(dump)
+			if err != nil {
+				return err
+			}
+
+			return os.WriteFile(outputFile, bz, 0o600)
+		},
+	}
+
+	addFilterFlags(cmd)
+	cmd.Flags().String(flagOutputFile, "", "Write the JSON to the given file instead of stdout")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func addFilterFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagLane, "", "Only list txs of the given lane, e.g. oracle")
+	cmd.Flags().StringSlice(flagMsgType, nil, "Only list txs containing one of the given message type urls")
+}
+
+func readPendingTxsRequest(cmd *cobra.Command) (*appmempool.QueryPendingTxsRequest, error) {
+	lane, err := cmd.Flags().GetString(flagLane)
+	if err != nil {
+		return nil, err
+	}
+
+	msgTypeURLs, err := cmd.Flags().GetStringSlice(flagMsgType)
+	if err != nil {
+		return nil, err
+	}
+
+	return &appmempool.QueryPendingTxsRequest{Lane: lane, MsgTypeUrls: msgTypeURLs}, nil
+}
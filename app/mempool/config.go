@@ -0,0 +1,283 @@
+package mempool
+
+import (
+	"fmt"
+	"time"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cast"
+)
+
+const (
+	flagLaneMempool               = "lane-mempool"
+	flagLaneMempoolEnable         = flagLaneMempool + ".enable"
+	flagLaneMempoolMaxTxPerSender = flagLaneMempool + ".max-txs-per-sender"
+	flagLaneMempoolTTLBlocks      = flagLaneMempool + ".ttl-blocks"
+	flagLaneMempoolTTLDuration    = flagLaneMempool + ".ttl-duration"
+	flagLaneMaxTxs                = "max-txs"
+	flagLaneBlockSpace            = "block-space"
+
+	flagFifoMempool               = "fifo-mempool"
+	flagFifoMempoolMaxTxPerSender = flagFifoMempool + ".max-txs-per-sender"
+	flagFifoMempoolTTLBlocks      = flagFifoMempool + ".ttl-blocks"
+	flagFifoMempoolTTLDuration    = flagFifoMempool + ".ttl-duration"
+)
+
+// FifoMempoolConfig is the app.toml configuration of the FifoMempool limits.
+type FifoMempoolConfig struct {
+	// MaxTxsPerSender limits the pending txs of a sender, zero means unlimited.
+	MaxTxsPerSender int `mapstructure:"max-txs-per-sender"`
+	// TTLBlocks is the number of blocks after which a pending tx is evicted, zero disables it.
+	TTLBlocks int64 `mapstructure:"ttl-blocks"`
+	// TTLDuration is the time after which a pending tx is evicted, zero disables it.
+	TTLDuration time.Duration `mapstructure:"ttl-duration"`
+}
+
+// DefaultFifoMempoolConfig returns the FifoMempool limits, none are enabled by default.
+func DefaultFifoMempoolConfig() FifoMempoolConfig {
+	return FifoMempoolConfig{}
+}
+
+// ReadFifoMempoolConfig reads the FifoMempool limits from the app options.
+func ReadFifoMempoolConfig(appOpts servertypes.AppOptions) (FifoMempoolConfig, error) {
+	cfg := DefaultFifoMempoolConfig()
+
+	var err error
+	if v := appOpts.Get(flagFifoMempoolMaxTxPerSender); v != nil {
+		if cfg.MaxTxsPerSender, err = cast.ToIntE(v); err != nil {
+			return cfg, err
+		}
+	}
+
+	if v := appOpts.Get(flagFifoMempoolTTLBlocks); v != nil {
+		if cfg.TTLBlocks, err = cast.ToInt64E(v); err != nil {
+			return cfg, err
+		}
+	}
+
+	if v := appOpts.Get(flagFifoMempoolTTLDuration); v != nil {
+		if cfg.TTLDuration, err = cast.ToDurationE(v); err != nil {
+			return cfg, err
+		}
+	}
+
+	if cfg.MaxTxsPerSender < 0 || cfg.TTLBlocks < 0 || cfg.TTLDuration < 0 {
+		return cfg, fmt.Errorf("fifo mempool limits must not be negative")
+	}
+
+	return cfg, nil
+}
+
+// Options returns the FifoMempool options of the configuration.
+func (c FifoMempoolConfig) Options() []FifoMempoolOptions {
+	return []FifoMempoolOptions{
+		FifoMaxTxPerSenderOpt(c.MaxTxsPerSender),
+		FifoTTLOpt(c.TTLBlocks, c.TTLDuration),
+	}
+}
+
+// LaneConfig is the app.toml configuration of a lane.
+type LaneConfig struct {
+	// MaxTxs is the capacity of the lane, zero means unlimited.
+	MaxTxs int `mapstructure:"max-txs"`
+	// BlockSpace is the share of the block bytes the lane may fill.
+	BlockSpace string `mapstructure:"block-space"`
+}
+
+// LaneMempoolConfig is the app.toml configuration of the lane mempool.
+type LaneMempoolConfig struct {
+	// Enable selects the LaneMempool instead of the FifoMempool.
+	Enable bool `mapstructure:"enable"`
+	// MaxTxsPerSender limits the pending txs of a sender, zero means unlimited.
+	MaxTxsPerSender int `mapstructure:"max-txs-per-sender"`
+	// TTLBlocks is the number of blocks after which a pending tx is evicted, zero disables it.
+	TTLBlocks int64 `mapstructure:"ttl-blocks"`
+	// TTLDuration is the time after which a pending tx is evicted, zero disables it.
+	TTLDuration time.Duration `mapstructure:"ttl-duration"`
+
+	Oracle     LaneConfig `mapstructure:"oracle"`
+	IBC        LaneConfig `mapstructure:"ibc"`
+	StakingGov LaneConfig `mapstructure:"staking-gov"`
+	Default    LaneConfig `mapstructure:"default"`
+}
+
+// DefaultLaneMempoolConfig returns the lane mempool configuration of DefaultLanes.
+func DefaultLaneMempoolConfig() LaneMempoolConfig {
+	return LaneMempoolConfig{
+		Enable:     false,
+		Oracle:     LaneConfig{MaxTxs: DefaultMaxTx, BlockSpace: "0.2"},
+		IBC:        LaneConfig{MaxTxs: DefaultMaxTx, BlockSpace: "0.2"},
+		StakingGov: LaneConfig{MaxTxs: DefaultMaxTx, BlockSpace: "0.1"},
+		Default:    LaneConfig{MaxTxs: DefaultMaxTx, BlockSpace: "1"},
+	}
+}
+
+// ReadLaneMempoolConfig reads the lane mempool configuration from the app options.
+// Missing values fall back to DefaultLaneMempoolConfig.
+func ReadLaneMempoolConfig(appOpts servertypes.AppOptions) (LaneMempoolConfig, error) {
+	cfg := DefaultLaneMempoolConfig()
+
+	var err error
+	if v := appOpts.Get(flagLaneMempoolEnable); v != nil {
+		if cfg.Enable, err = cast.ToBoolE(v); err != nil {
+			return cfg, err
+		}
+	}
+
+	if v := appOpts.Get(flagLaneMempoolMaxTxPerSender); v != nil {
+		if cfg.MaxTxsPerSender, err = cast.ToIntE(v); err != nil {
+			return cfg, err
+		}
+	}
+
+	if v := appOpts.Get(flagLaneMempoolTTLBlocks); v != nil {
+		if cfg.TTLBlocks, err = cast.ToInt64E(v); err != nil {
+			return cfg, err
+		}
+	}
+
+	if v := appOpts.Get(flagLaneMempoolTTLDuration); v != nil {
+		if cfg.TTLDuration, err = cast.ToDurationE(v); err != nil {
+			return cfg, err
+		}
+	}
+
+	if cfg.MaxTxsPerSender < 0 || cfg.TTLBlocks < 0 || cfg.TTLDuration < 0 {
+		return cfg, fmt.Errorf("lane mempool limits must not be negative")
+	}
+
+	for _, m := range laneMatchers {
+		laneCfg := cfg.lane(m.name)
+		prefix := fmt.Sprintf("%s.%s.", flagLaneMempool, m.name)
+		if v := appOpts.Get(prefix + flagLaneMaxTxs); v != nil {
+			if laneCfg.MaxTxs, err = cast.ToIntE(v); err != nil {
+				return cfg, err
+			}
+		}
+
+		if v := appOpts.Get(prefix + flagLaneBlockSpace); v != nil {
+			if laneCfg.BlockSpace, err = cast.ToStringE(v); err != nil {
+				return cfg, err
+			}
+		}
+	}
+
+	return cfg, nil
+}
+
+// Options returns the LaneMempool limits of the configuration.
+func (c LaneMempoolConfig) Options() []LaneMempoolOptions {
+	return []LaneMempoolOptions{
+		LaneMaxTxPerSenderOpt(c.MaxTxsPerSender),
+		LaneTTLOpt(c.TTLBlocks, c.TTLDuration),
+	}
+}
+
+// Lanes returns the configured lanes in selection order.
+func (c LaneMempoolConfig) Lanes() ([]Lane, error) {
+	lanes := make([]Lane, len(laneMatchers))
+	for i, m := range laneMatchers {
+		laneCfg := c.lane(m.name)
+		if laneCfg.MaxTxs < 0 {
+			return nil, fmt.Errorf("lane %s: max txs must not be negative: %d", m.name, laneCfg.MaxTxs)
+		}
+
+		blockSpace, err := sdk.NewDecFromStr(laneCfg.BlockSpace)
+		if err != nil {
+			return nil, fmt.Errorf("lane %s: invalid block space: %w", m.name, err)
+		}
+
+		if blockSpace.IsNegative() || blockSpace.GT(sdk.OneDec()) {
+			return nil, fmt.Errorf("lane %s: block space must be between 0 and 1: %s", m.name, blockSpace)
+		}
+
+		lanes[i] = Lane{Name: m.name, Match: m.match, MaxTx: laneCfg.MaxTxs, BlockSpace: blockSpace}
+	}
+
+	return lanes, nil
+}
+
+func (c *LaneMempoolConfig) lane(name string) *LaneConfig {
+	switch name {
+	case LaneOracle:
+		return &c.Oracle
+	case LaneIBC:
+		return &c.IBC
+	case LaneStakingGov:
+		return &c.StakingGov
+	default:
+		return &c.Default
+	}
+}
+
+// ConfigTemplate toml snippet for app.toml
+func ConfigTemplate(c LaneMempoolConfig) string {
+	return fmt.Sprintf(`
+
+###############################################################################
+###                              Lane Mempool                               ###
+###############################################################################
+
+[lane-mempool]
+# Use the lane mempool instead of the FIFO mempool. Lanes are filled in the
+# order below, txs within a lane are ordered by gas price.
+enable = %t
+
+# Limits of the lane mempool, zero disables a limit.
+# Maximum number of pending txs per sender.
+max-txs-per-sender = %d
+# Number of blocks after which a pending tx is evicted.
+ttl-blocks = %d
+# Time after which a pending tx is evicted, e.g. "10m".
+ttl-duration = "%s"
+
+# Every lane has a capacity (max-txs, 0 is unlimited) and a share of the
+# block bytes it may fill (block-space, between 0 and 1).
+[lane-mempool.oracle]
+max-txs = %d
+block-space = "%s"
+
+[lane-mempool.ibc]
+max-txs = %d
+block-space = "%s"
+
+[lane-mempool.staking-gov]
+max-txs = %d
+block-space = "%s"
+
+[lane-mempool.default]
+max-txs = %d
+block-space = "%s"
+`, c.Enable,
+		c.MaxTxsPerSender, c.TTLBlocks, c.TTLDuration,
+		c.Oracle.MaxTxs, c.Oracle.BlockSpace,
+		c.IBC.MaxTxs, c.IBC.BlockSpace,
+		c.StakingGov.MaxTxs, c.StakingGov.BlockSpace,
+		c.Default.MaxTxs, c.Default.BlockSpace,
+	)
+}
+
+// DefaultConfigTemplate toml snippet with default values for app.toml
+func DefaultConfigTemplate() string {
+	return ConfigTemplate(DefaultLaneMempoolConfig())
+}
+
+// FifoConfigTemplate toml snippet for app.toml
+func FifoConfigTemplate(c FifoMempoolConfig) string {
+	return fmt.Sprintf(`
+[fifo-mempool]
+# Limits of the FIFO mempool, zero disables a limit.
+# Maximum number of pending txs per sender.
+max-txs-per-sender = %d
+# Number of blocks after which a pending tx is evicted.
+ttl-blocks = %d
+# Time after which a pending tx is evicted, e.g. "10m".
+ttl-duration = "%s"
+`, c.MaxTxsPerSender, c.TTLBlocks, c.TTLDuration)
+}
+
+// DefaultFifoConfigTemplate toml snippet with default values for app.toml
+func DefaultFifoConfigTemplate() string {
+	return FifoConfigTemplate(DefaultFifoMempoolConfig())
+}
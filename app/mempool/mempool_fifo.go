@@ -2,8 +2,10 @@ package mempool
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/classic-terra/core/v3/app/helper"
 	"github.com/cometbft/cometbft/libs/clist"
@@ -20,6 +22,14 @@ var (
 
 var DefaultMaxTx = 5000
 
+var (
+	// ErrSenderTxLimit is returned when a sender already has the maximum number of pending txs.
+	ErrSenderTxLimit = errors.New("sender has too many pending txs")
+	// ErrReplacementFeeTooLow is returned when a tx with the sequence of a pending tx does
+	// not pay a higher fee than the pending tx.
+	ErrReplacementFeeTooLow = errors.New("replacement tx must pay a higher fee than the pending tx")
+)
+
 // FifoMempool is a mempool implementation that maintains two separate transaction pools:
 // one for oracle transactions and another for regular transactions. Oracle transactions are given
 // priority during iteration.
@@ -31,16 +41,33 @@ var DefaultMaxTx = 5000
 //   - Oracle transactions are processed first in FIFO order
 //   - Regular transactions follow in FIFO order
 //
-// 4. Transaction capacity is limited by maxTx (if > 0)
+// 4. Transaction capacity is limited by maxTx (if > 0) and by maxTxPerSender per sender (if > 0)
+// 5. A transaction with the sequence of a pending transaction of the same sender replaces
+// it if it pays a higher fee (priority), otherwise it is rejected
+// 6. Transactions older than the TTL (in blocks and/or time) are evicted during Select
 //
 // Note: PrepareProposal may terminate iteration early if block size limits are reached.
 type FifoMempool struct {
-	mtx          cmtsync.RWMutex
-	txs          *clist.CList // Regular transactions FIFO queue
-	txsOracle    *clist.CList // Oracle transactions FIFO queue
-	txsMap       sync.Map     // For quick lookup of existing transactions
-	txsMapOracle sync.Map     // For quick lookup of existing transactions
-	maxTx        int
+	mtx            cmtsync.RWMutex
+	txs            *clist.CList // Regular transactions FIFO queue
+	txsOracle      *clist.CList // Oracle transactions FIFO queue
+	txsMap         sync.Map     // For quick lookup of existing transactions
+	txsMapOracle   sync.Map     // For quick lookup of existing transactions
+	senders        map[string]int
+	maxTx          int
+	maxTxPerSender int
+	ttlBlocks      int64
+	ttlDuration    time.Duration
+}
+
+// fifoTx is a pending tx with the data needed for replacement and eviction.
+type fifoTx struct {
+	tx       sdk.Tx
+	key      customTxKey
+	priority int64
+	height   int64
+	time     time.Time
+	received time.Time
 }
 
 type FifoMempoolOptions func(mp *FifoMempool)
@@ -49,6 +76,7 @@ func NewFifoMempool(opts ...FifoMempoolOptions) *FifoMempool {
 	mp := &FifoMempool{
 		txs:       clist.New(),
 		txsOracle: clist.New(),
+		senders:   make(map[string]int),
 		maxTx:     DefaultMaxTx,
 	}
 
@@ -65,13 +93,25 @@ func FifoMaxTxOpt(maxTx int) FifoMempoolOptions {
 	}
 }
 
-func (mp *FifoMempool) Insert(_ context.Context, tx sdk.Tx) error {
-	mp.mtx.RLock()
-	defer mp.mtx.RUnlock()
-	totalTxs := mp.txs.Len() + mp.txsOracle.Len()
-	if mp.maxTx >= 0 && totalTxs >= mp.maxTx {
-		return mempool.ErrMempoolTxMaxCapacity
+// FifoMaxTxPerSenderOpt limits the pending txs of a sender, zero means unlimited.
+func FifoMaxTxPerSenderOpt(maxTxPerSender int) FifoMempoolOptions {
+	return func(mp *FifoMempool) {
+		mp.maxTxPerSender = maxTxPerSender
 	}
+}
+
+// FifoTTLOpt sets the number of blocks and the duration after which a pending tx
+// is evicted, zero disables the respective limit.
+func FifoTTLOpt(ttlBlocks int64, ttlDuration time.Duration) FifoMempoolOptions {
+	return func(mp *FifoMempool) {
+		mp.ttlBlocks = ttlBlocks
+		mp.ttlDuration = ttlDuration
+	}
+}
+
+func (mp *FifoMempool) Insert(ctx context.Context, tx sdk.Tx) error {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
 	if mp.maxTx < 0 {
 		return nil
 	}
@@ -80,35 +120,124 @@ func (mp *FifoMempool) Insert(_ context.Context, tx sdk.Tx) error {
 	if err != nil {
 		return err
 	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	ftx := &fifoTx{
+		tx:       tx,
+		key:      txKey,
+		priority: sdkCtx.Priority(),
+		height:   sdkCtx.BlockHeight(),
+		time:     sdkCtx.BlockTime(),
+		received: time.Now(),
+	}
+
+	if elem, txsMap, txs := mp.lookup(txKey); elem != nil {
+		// same sender-nonce replaces the pending tx only if it pays more
+		if ftx.priority <= elem.Value.(*fifoTx).priority {
+			incrRejectedCounter(ReasonReplacementFeeTooLow)
+			return ErrReplacementFeeTooLow
+		}
+
+		txsMap.Delete(txKey)
+		txs.Remove(elem)
+		mp.senders[txKey.address]--
+		incrEvictedCounter(ReasonReplaced)
+	} else {
+		totalTxs := mp.txs.Len() + mp.txsOracle.Len()
+		if totalTxs >= mp.maxTx {
+			incrRejectedCounter(ReasonCapacity)
+			return mempool.ErrMempoolTxMaxCapacity
+		}
+
+		if mp.maxTxPerSender > 0 && mp.senders[txKey.address] >= mp.maxTxPerSender {
+			incrRejectedCounter(ReasonSenderLimit)
+			return ErrSenderTxLimit
+		}
+	}
+
 	// Add to appropriate queue based on transaction type
 	if helper.IsOracleTx(tx.GetMsgs()) {
-		e := mp.txsOracle.PushBack(tx)
+		e := mp.txsOracle.PushBack(ftx)
 		mp.txsMapOracle.Store(txKey, e)
 	} else {
-		e := mp.txs.PushBack(tx)
+		e := mp.txs.PushBack(ftx)
 		mp.txsMap.Store(txKey, e)
 	}
+	mp.senders[txKey.address]++
 
 	return nil
 }
 
-func (mp *FifoMempool) Select(_ context.Context, _ [][]byte) mempool.Iterator {
-	mp.mtx.RLock()
-	defer mp.mtx.RUnlock()
+// lookup returns the pending tx with the given key together with its queue.
+func (mp *FifoMempool) lookup(txKey customTxKey) (*clist.CElement, *sync.Map, *clist.CList) {
+	if elem, ok := mp.txsMapOracle.Load(txKey); ok {
+		return elem.(*clist.CElement), &mp.txsMapOracle, mp.txsOracle
+	}
+
+	if elem, ok := mp.txsMap.Load(txKey); ok {
+		return elem.(*clist.CElement), &mp.txsMap, mp.txs
+	}
+
+	return nil, nil, nil
+}
+
+// evictExpired removes the txs which exceeded the TTL at the height and time of ctx.
+func (mp *FifoMempool) evictExpired(ctx sdk.Context, txs *clist.CList, txsMap *sync.Map) {
+	for e := txs.Front(); e != nil; {
+		next := e.Next()
+		ftx := e.Value.(*fifoTx)
+
+		reason := ""
+		switch {
+		case mp.ttlBlocks > 0 && ctx.BlockHeight()-ftx.height > mp.ttlBlocks:
+			reason = ReasonTTLHeight
+		case mp.ttlDuration > 0 && ctx.BlockTime().Sub(ftx.time) > mp.ttlDuration:
+			reason = ReasonTTLTime
+		}
+
+		if reason != "" {
+			txsMap.Delete(ftx.key)
+			txs.Remove(e)
+			mp.releaseSender(ftx.key.address)
+			incrEvictedCounter(reason)
+		}
+
+		e = next
+	}
+}
+
+func (mp *FifoMempool) releaseSender(address string) {
+	if mp.senders[address] <= 1 {
+		delete(mp.senders, address)
+		return
+	}
+
+	mp.senders[address]--
+}
+
+func (mp *FifoMempool) Select(ctx context.Context, _ [][]byte) mempool.Iterator {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	if mp.ttlBlocks > 0 || mp.ttlDuration > 0 {
+		sdkCtx := sdk.UnwrapSDKContext(ctx)
+		mp.evictExpired(sdkCtx, mp.txsOracle, &mp.txsMapOracle)
+		mp.evictExpired(sdkCtx, mp.txs, &mp.txsMap)
+	}
+	setLaneSizeGauge(LaneOracle, mp.txsOracle.Len())
+	setLaneSizeGauge(LaneDefault, mp.txs.Len())
+
 	// Pre-allocate slice with exact capacity needed
 	totalTxs := mp.txsOracle.Len() + mp.txs.Len()
 	listTxKey := make([]customTxKey, 0, totalTxs)
 	var newMapTxs sync.Map
 	var newMapTxsOracle sync.Map
 	for e := mp.txsOracle.Front(); e != nil; e = e.Next() {
-		tx := e.Value.(sdk.Tx)
-		txKey, _ := getTxKey(tx)
+		txKey := e.Value.(*fifoTx).key
 		listTxKey = append(listTxKey, txKey)
 		newMapTxsOracle.Store(txKey, e)
 	}
 	for e := mp.txs.Front(); e != nil; e = e.Next() {
-		tx := e.Value.(sdk.Tx)
-		txKey, _ := getTxKey(tx)
+		txKey := e.Value.(*fifoTx).key
 		listTxKey = append(listTxKey, txKey)
 		newMapTxs.Store(txKey, e)
 	}
@@ -155,12 +284,12 @@ func (it *fifoIterator) Next() mempool.Iterator {
 }
 
 func (it *fifoIterator) Tx() sdk.Tx {
-	return it.currentTx.Value.(sdk.Tx)
+	return it.currentTx.Value.(*fifoTx).tx
 }
 
 func (mp *FifoMempool) Remove(tx sdk.Tx) error {
-	mp.mtx.RLock()
-	defer mp.mtx.RUnlock()
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
 	txKey, err := getTxKey(tx)
 	if err != nil {
 		return err
@@ -170,11 +299,13 @@ func (mp *FifoMempool) Remove(tx sdk.Tx) error {
 	if isOracle {
 		if elem, ok := mp.txsMapOracle.LoadAndDelete(txKey); ok {
 			mp.txsOracle.Remove(elem.(*clist.CElement))
+			mp.releaseSender(txKey.address)
 			return nil
 		}
 	} else {
 		if elem, ok := mp.txsMap.LoadAndDelete(txKey); ok {
 			mp.txs.Remove(elem.(*clist.CElement))
+			mp.releaseSender(txKey.address)
 			return nil
 		}
 	}
@@ -188,6 +319,41 @@ func (mp *FifoMempool) CountTx() int {
 	return mp.txs.Len() + mp.txsOracle.Len()
 }
 
+// PendingTxs returns the pending txs in selection order.
+func (mp *FifoMempool) PendingTxs() []PendingTxInfo {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	txs := make([]PendingTxInfo, 0, mp.txsOracle.Len()+mp.txs.Len())
+	for _, l := range []struct {
+		name string
+		txs  *clist.CList
+	}{{LaneOracle, mp.txsOracle}, {LaneDefault, mp.txs}} {
+		for e := l.txs.Front(); e != nil; e = e.Next() {
+			ftx := e.Value.(*fifoTx)
+			txs = append(txs, PendingTxInfo{
+				Lane:       l.name,
+				Tx:         ftx.tx,
+				Priority:   ftx.priority,
+				Height:     ftx.height,
+				ReceivedAt: ftx.received,
+			})
+		}
+	}
+
+	return txs
+}
+
+// LaneSizes returns the number of oracle and regular transactions.
+func (mp *FifoMempool) LaneSizes() map[string]int {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+	return map[string]int{
+		LaneOracle:  mp.txsOracle.Len(),
+		LaneDefault: mp.txs.Len(),
+	}
+}
+
 func getTxKey(tx sdk.Tx) (customTxKey, error) {
 	sigs, err := tx.(signing.SigVerifiableTx).GetSignaturesV2()
 	if err != nil {
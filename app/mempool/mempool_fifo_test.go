@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/rand"
 	"testing"
+	"time"
 
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
@@ -595,6 +596,84 @@ func (s *MempoolTestSuite) TestBatchTx_WhenNotEnoughMemPool() {
 	require.Equal(t, 0, mp.CountTx())
 }
 
+func (s *MempoolTestSuite) TestMaxTxPerSender() {
+	t := s.T()
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	mp := appmempool.NewFifoMempool(appmempool.FifoMaxTxPerSenderOpt(2))
+
+	for i := 0; i < 2; i++ {
+		require.NoError(t, mp.Insert(ctx, testTx{nonce: uint64(i), address: accounts[0].Address}))
+	}
+
+	tx := testTx{nonce: 2, address: accounts[0].Address}
+	require.ErrorIs(t, mp.Insert(ctx, tx), appmempool.ErrSenderTxLimit)
+
+	// other senders are not affected
+	require.NoError(t, mp.Insert(ctx, testTx{nonce: 0, address: accounts[1].Address}))
+
+	// removing a tx frees a slot of the sender
+	require.NoError(t, mp.Remove(testTx{nonce: 0, address: accounts[0].Address}))
+	require.NoError(t, mp.Insert(ctx, tx))
+	require.Equal(t, 3, mp.CountTx())
+}
+
+func (s *MempoolTestSuite) TestReplaceByFee() {
+	t := s.T()
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	mp := appmempool.NewFifoMempool(appmempool.FifoMaxTxOpt(2))
+
+	tx := testTx{id: 0, nonce: 0, address: accounts[0].Address, priority: 10}
+	require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx))
+	require.NoError(t, mp.Insert(ctx, testTx{id: 1, nonce: 0, address: accounts[1].Address}))
+
+	// the same or a lower fee does not replace the pending tx
+	same := testTx{id: 2, nonce: 0, address: accounts[0].Address, priority: 10}
+	require.ErrorIs(t, mp.Insert(ctx.WithPriority(same.priority), same), appmempool.ErrReplacementFeeTooLow)
+
+	// a higher fee replaces it, even if the mempool is full
+	higher := testTx{id: 3, nonce: 0, address: accounts[0].Address, priority: 11}
+	require.NoError(t, mp.Insert(ctx.WithPriority(higher.priority), higher))
+	require.Equal(t, 2, mp.CountTx())
+
+	var ids []int
+	for _, tx := range fetchTxs(mp.Select(ctx, nil), 1000) {
+		ids = append(ids, tx.(testTx).id)
+	}
+	require.Equal(t, []int{1, 3}, ids)
+}
+
+func (s *MempoolTestSuite) TestTTLEviction() {
+	t := s.T()
+	now := time.Now()
+	ctx := sdk.NewContext(nil, tmproto.Header{Height: 1, Time: now}, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 3)
+	mp := appmempool.NewFifoMempool(appmempool.FifoTTLOpt(5, time.Minute), appmempool.FifoMaxTxPerSenderOpt(1))
+
+	require.NoError(t, mp.Insert(ctx, testTx{id: 0, address: accounts[0].Address}))
+	require.NoError(t, mp.Insert(ctx.WithBlockHeight(4), testTx{id: 1, address: accounts[1].Address}))
+	require.NoError(t, mp.Insert(ctx.WithBlockHeight(6).WithBlockTime(now.Add(30*time.Second)), testTx{id: 2, address: accounts[2].Address}))
+
+	// tx 0 exceeds the height ttl
+	selectIDs := func(ctx sdk.Context) []int {
+		var ids []int
+		for _, tx := range fetchTxs(mp.Select(ctx, nil), 1000) {
+			ids = append(ids, tx.(testTx).id)
+		}
+		return ids
+	}
+	require.Equal(t, []int{1, 2}, selectIDs(ctx.WithBlockHeight(7)))
+	require.Equal(t, 2, mp.CountTx())
+
+	// tx 1 exceeds the time ttl
+	require.Equal(t, []int{2}, selectIDs(ctx.WithBlockHeight(7).WithBlockTime(now.Add(80*time.Second))))
+
+	// evicted txs free the slot of their sender
+	require.NoError(t, mp.Insert(ctx, testTx{id: 3, nonce: 1, address: accounts[0].Address}))
+	require.Equal(t, 2, mp.CountTx())
+}
+
 func BenchmarkMempool(b *testing.B) {
 	ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
 	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 100)
@@ -0,0 +1,523 @@
+package mempool
+
+import (
+	"container/heap"
+	"context"
+	"strings"
+	"time"
+
+	"github.com/classic-terra/core/v3/app/helper"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+)
+
+var (
+	_ mempool.Mempool  = (*LaneMempool)(nil)
+	_ mempool.Iterator = (*laneIterator)(nil)
+)
+
+const (
+	LaneOracle     = "oracle"
+	LaneIBC        = "ibc"
+	LaneStakingGov = "staking-gov"
+	LaneDefault    = "default"
+)
+
+// Lane defines a partition of the LaneMempool.
+type Lane struct {
+	// Name identifies the lane.
+	Name string
+	// Match reports whether a tx belongs to the lane. Txs are assigned to the
+	// first matching lane, a nil Match matches every tx.
+	Match func(tx sdk.Tx) bool
+	// MaxTx is the capacity of the lane, zero means unlimited.
+	MaxTx int
+	// BlockSpace is the share of the block bytes the lane may fill during
+	// PrepareProposal.
+	BlockSpace sdk.Dec
+}
+
+// laneMatchers defines the lanes in selection order.
+var laneMatchers = []struct {
+	name  string
+	match func(tx sdk.Tx) bool
+}{
+	{LaneOracle, isOracleTx},
+	{LaneIBC, IsIBCRelayerTx},
+	{LaneStakingGov, IsStakingGovTx},
+	{LaneDefault, nil},
+}
+
+// DefaultLanes returns the default lanes: oracle votes, IBC relaying,
+// staking/governance operations and everything else.
+func DefaultLanes() []Lane {
+	lanes, err := DefaultLaneMempoolConfig().Lanes()
+	if err != nil {
+		panic(err)
+	}
+
+	return lanes
+}
+
+// LaneMempool is a mempool implementation that partitions transactions into lanes.
+// Lanes are selected in the configured order and each lane may only fill its share
+// of the block space (see LaneProposalHandler).
+//
+// Key characteristics:
+// 1. Every lane has its own capacity, the total capacity is limited by maxTx (if > 0)
+// 2. Within a lane, transactions are ordered by their priority, which is the effective
+// gas price set by the fee decorator, while the nonce order of every sender is preserved
+// 3. Transactions with the same priority are selected in FIFO order
+// 4. A transaction with the sequence of a pending transaction of the same sender replaces
+// it if it pays a higher fee (priority), otherwise it is rejected
+// 5. The pending transactions of a sender are limited by maxTxPerSender (if > 0)
+// 6. Transactions older than the TTL (in blocks and/or time) are evicted during Select
+type LaneMempool struct {
+	mtx            cmtsync.RWMutex
+	lanes          []*lane
+	txsMap         map[customTxKey]*laneTx
+	order          uint64
+	senders        map[string]int
+	maxTx          int
+	maxTxPerSender int
+	ttlBlocks      int64
+	ttlDuration    time.Duration
+}
+
+type LaneMempoolOptions func(mp *LaneMempool)
+
+func NewLaneMempool(opts ...LaneMempoolOptions) *LaneMempool {
+	mp := &LaneMempool{
+		txsMap:  make(map[customTxKey]*laneTx),
+		senders: make(map[string]int),
+	}
+
+	for _, opt := range opts {
+		opt(mp)
+	}
+
+	if len(mp.lanes) == 0 {
+		LanesOpt(DefaultLanes()...)(mp)
+	}
+
+	return mp
+}
+
+// LanesOpt sets the lanes of the mempool. A catch-all default lane is appended
+// if no lane matches every tx.
+func LanesOpt(lanes ...Lane) LaneMempoolOptions {
+	return func(mp *LaneMempool) {
+		mp.lanes = make([]*lane, 0, len(lanes)+1)
+		hasCatchAll := false
+		for _, l := range lanes {
+			mp.lanes = append(mp.lanes, newLane(l))
+			hasCatchAll = hasCatchAll || l.Match == nil
+		}
+
+		if !hasCatchAll {
+			mp.lanes = append(mp.lanes, newLane(Lane{Name: LaneDefault, BlockSpace: sdk.OneDec()}))
+		}
+	}
+}
+
+// LaneMaxTxOpt limits the pending txs of all lanes, zero means unlimited.
+func LaneMaxTxOpt(maxTx int) LaneMempoolOptions {
+	return func(mp *LaneMempool) {
+		mp.maxTx = maxTx
+	}
+}
+
+// LaneMaxTxPerSenderOpt limits the pending txs of a sender, zero means unlimited.
+func LaneMaxTxPerSenderOpt(maxTxPerSender int) LaneMempoolOptions {
+	return func(mp *LaneMempool) {
+		mp.maxTxPerSender = maxTxPerSender
+	}
+}
+
+// LaneTTLOpt sets the number of blocks and the duration after which a pending tx
+// is evicted, zero disables the respective limit.
+func LaneTTLOpt(ttlBlocks int64, ttlDuration time.Duration) LaneMempoolOptions {
+	return func(mp *LaneMempool) {
+		mp.ttlBlocks = ttlBlocks
+		mp.ttlDuration = ttlDuration
+	}
+}
+
+func (mp *LaneMempool) Insert(ctx context.Context, tx sdk.Tx) error {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	txKey, err := getTxKey(tx)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	priority := sdkCtx.Priority()
+	l := mp.laneOf(tx)
+	existing, replace := mp.txsMap[txKey]
+	if replace {
+		// same sender-nonce replaces the pending tx only if it pays more
+		if priority <= existing.priority {
+			incrRejectedCounter(ReasonReplacementFeeTooLow)
+			return ErrReplacementFeeTooLow
+		}
+	} else {
+		if mp.maxTx > 0 && len(mp.txsMap) >= mp.maxTx {
+			incrRejectedCounter(ReasonCapacity)
+			return mempool.ErrMempoolTxMaxCapacity
+		}
+
+		if mp.maxTxPerSender > 0 && mp.senders[txKey.address] >= mp.maxTxPerSender {
+			incrRejectedCounter(ReasonSenderLimit)
+			return ErrSenderTxLimit
+		}
+	}
+
+	if l.MaxTx > 0 && l.size >= l.MaxTx && (!replace || existing.lane != l) {
+		incrRejectedCounter(ReasonCapacity)
+		return mempool.ErrMempoolTxMaxCapacity
+	}
+
+	if replace {
+		existing.lane.remove(existing)
+		incrEvictedCounter(ReasonReplaced)
+	} else {
+		mp.senders[txKey.address]++
+	}
+
+	mp.order++
+	ltx := &laneTx{
+		tx:       tx,
+		key:      txKey,
+		priority: priority,
+		order:    mp.order,
+		lane:     l,
+		height:   sdkCtx.BlockHeight(),
+		time:     sdkCtx.BlockTime(),
+		received: time.Now(),
+	}
+	l.insert(ltx)
+	mp.txsMap[txKey] = ltx
+
+	return nil
+}
+
+// evictExpired removes the txs which exceeded the TTL at the height and time of ctx.
+func (mp *LaneMempool) evictExpired(ctx sdk.Context) {
+	for _, ltx := range mp.txsMap {
+		reason := ""
+		switch {
+		case mp.ttlBlocks > 0 && ctx.BlockHeight()-ltx.height > mp.ttlBlocks:
+			reason = ReasonTTLHeight
+		case mp.ttlDuration > 0 && ctx.BlockTime().Sub(ltx.time) > mp.ttlDuration:
+			reason = ReasonTTLTime
+		}
+
+		if reason != "" {
+			mp.remove(ltx)
+			incrEvictedCounter(reason)
+		}
+	}
+}
+
+// remove removes a pending tx and frees the slot of its sender.
+func (mp *LaneMempool) remove(ltx *laneTx) {
+	ltx.lane.remove(ltx)
+	delete(mp.txsMap, ltx.key)
+
+	if mp.senders[ltx.key.address] <= 1 {
+		delete(mp.senders, ltx.key.address)
+		return
+	}
+
+	mp.senders[ltx.key.address]--
+}
+
+func (mp *LaneMempool) Select(ctx context.Context, _ [][]byte) mempool.Iterator {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	if mp.ttlBlocks > 0 || mp.ttlDuration > 0 {
+		mp.evictExpired(sdk.UnwrapSDKContext(ctx))
+	}
+
+	var txs []sdk.Tx
+	for _, l := range mp.lanes {
+		txs = append(txs, l.ordered()...)
+		setLaneSizeGauge(l.Name, l.size)
+	}
+
+	if len(txs) == 0 {
+		return nil
+	}
+
+	return &laneIterator{txs: txs}
+}
+
+// SelectLanes returns the ordered transactions of every lane, after removing
+// the txs which exceeded the TTL at the height and time of ctx.
+func (mp *LaneMempool) SelectLanes(ctx sdk.Context) []LaneTxs {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	if mp.ttlBlocks > 0 || mp.ttlDuration > 0 {
+		mp.evictExpired(ctx)
+	}
+
+	laneTxs := make([]LaneTxs, len(mp.lanes))
+	for i, l := range mp.lanes {
+		laneTxs[i] = LaneTxs{Lane: l.Lane, Txs: l.ordered()}
+	}
+
+	return laneTxs
+}
+
+// PendingTxs returns the pending txs in selection order.
+func (mp *LaneMempool) PendingTxs() []PendingTxInfo {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	txs := make([]PendingTxInfo, 0, len(mp.txsMap))
+	for _, l := range mp.lanes {
+		for _, ltx := range l.orderedTxs() {
+			txs = append(txs, PendingTxInfo{
+				Lane:       l.Name,
+				Tx:         ltx.tx,
+				Priority:   ltx.priority,
+				Height:     ltx.height,
+				ReceivedAt: ltx.received,
+			})
+		}
+	}
+
+	return txs
+}
+
+// LaneTxs holds the ordered transactions of a lane.
+type LaneTxs struct {
+	Lane Lane
+	Txs  []sdk.Tx
+}
+
+func (mp *LaneMempool) Remove(tx sdk.Tx) error {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	txKey, err := getTxKey(tx)
+	if err != nil {
+		return err
+	}
+
+	ltx, ok := mp.txsMap[txKey]
+	if !ok {
+		return mempool.ErrTxNotFound
+	}
+
+	mp.remove(ltx)
+
+	return nil
+}
+
+func (mp *LaneMempool) CountTx() int {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+	return len(mp.txsMap)
+}
+
+// LaneSizes returns the number of transactions per lane.
+func (mp *LaneMempool) LaneSizes() map[string]int {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	sizes := make(map[string]int, len(mp.lanes))
+	for _, l := range mp.lanes {
+		sizes[l.Name] = l.size
+	}
+
+	return sizes
+}
+
+func (mp *LaneMempool) laneOf(tx sdk.Tx) *lane {
+	for _, l := range mp.lanes {
+		if l.Match == nil || l.Match(tx) {
+			return l
+		}
+	}
+
+	// unreachable as LanesOpt always adds a catch-all lane
+	return mp.lanes[len(mp.lanes)-1]
+}
+
+type laneIterator struct {
+	txs []sdk.Tx
+}
+
+func (it *laneIterator) Next() mempool.Iterator {
+	it.txs = it.txs[1:]
+	if len(it.txs) == 0 {
+		return nil
+	}
+
+	return it
+}
+
+func (it *laneIterator) Tx() sdk.Tx {
+	return it.txs[0]
+}
+
+type laneTx struct {
+	tx       sdk.Tx
+	key      customTxKey
+	priority int64
+	order    uint64
+	lane     *lane
+	height   int64
+	time     time.Time
+	received time.Time
+}
+
+type lane struct {
+	Lane
+	// senders holds the pending txs of every sender sorted by nonce
+	senders map[string][]*laneTx
+	size    int
+}
+
+func newLane(l Lane) *lane {
+	if l.BlockSpace.IsNil() {
+		l.BlockSpace = sdk.OneDec()
+	}
+
+	return &lane{Lane: l, senders: make(map[string][]*laneTx)}
+}
+
+func (l *lane) insert(ltx *laneTx) {
+	txs := l.senders[ltx.key.address]
+	i := len(txs)
+	for i > 0 && txs[i-1].key.nonce > ltx.key.nonce {
+		i--
+	}
+
+	txs = append(txs, nil)
+	copy(txs[i+1:], txs[i:])
+	txs[i] = ltx
+	l.senders[ltx.key.address] = txs
+	l.size++
+}
+
+func (l *lane) remove(ltx *laneTx) {
+	txs := l.senders[ltx.key.address]
+	for i, tx := range txs {
+		if tx == ltx {
+			txs = append(txs[:i], txs[i+1:]...)
+			l.size--
+			break
+		}
+	}
+
+	if len(txs) == 0 {
+		delete(l.senders, ltx.key.address)
+		return
+	}
+
+	l.senders[ltx.key.address] = txs
+}
+
+// ordered returns the txs of the lane by descending priority, while every sender's
+// txs stay in nonce order.
+func (l *lane) ordered() []sdk.Tx {
+	ltxs := l.orderedTxs()
+	txs := make([]sdk.Tx, len(ltxs))
+	for i, ltx := range ltxs {
+		txs[i] = ltx.tx
+	}
+
+	return txs
+}
+
+func (l *lane) orderedTxs() []*laneTx {
+	txs := make([]*laneTx, 0, l.size)
+	heads := make(senderHeap, 0, len(l.senders))
+	for _, senderTxs := range l.senders {
+		heads = append(heads, senderTxs)
+	}
+
+	heap.Init(&heads)
+	for heads.Len() > 0 {
+		senderTxs := heads[0]
+		txs = append(txs, senderTxs[0])
+		if len(senderTxs) == 1 {
+			heap.Pop(&heads)
+		} else {
+			heads[0] = senderTxs[1:]
+			heap.Fix(&heads, 0)
+		}
+	}
+
+	return txs
+}
+
+// senderHeap orders the pending txs of the senders by the priority of their
+// lowest nonce tx.
+type senderHeap [][]*laneTx
+
+func (h senderHeap) Len() int { return len(h) }
+
+func (h senderHeap) Less(i, j int) bool {
+	if h[i][0].priority != h[j][0].priority {
+		return h[i][0].priority > h[j][0].priority
+	}
+
+	return h[i][0].order < h[j][0].order
+}
+
+func (h senderHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *senderHeap) Push(x interface{}) { *h = append(*h, x.([]*laneTx)) }
+
+func (h *senderHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
+
+func isOracleTx(tx sdk.Tx) bool {
+	return helper.IsOracleTx(tx.GetMsgs())
+}
+
+// IsIBCRelayerTx returns true if all messages of the tx are IBC core messages
+// (client updates, packets, acknowledgements and handshakes).
+func IsIBCRelayerTx(tx sdk.Tx) bool {
+	return allMsgsHavePrefix(tx.GetMsgs(), "/ibc.core.")
+}
+
+// IsStakingGovTx returns true if all messages of the tx are staking, slashing,
+// distribution or governance messages.
+func IsStakingGovTx(tx sdk.Tx) bool {
+	return allMsgsHavePrefix(tx.GetMsgs(), "/cosmos.staking.", "/cosmos.slashing.", "/cosmos.distribution.", "/cosmos.gov.")
+}
+
+func allMsgsHavePrefix(msgs []sdk.Msg, prefixes ...string) bool {
+	if len(msgs) == 0 {
+		return false
+	}
+
+	for _, msg := range msgs {
+		typeURL := sdk.MsgTypeURL(msg)
+		matched := false
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(typeURL, prefix) {
+				matched = true
+				break
+			}
+		}
+
+		if !matched {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,308 @@
+package mempool_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+
+	appmempool "github.com/classic-terra/core/v3/app/mempool"
+	oracleexported "github.com/classic-terra/core/v3/x/oracle/exported"
+)
+
+func (s *MempoolTestSuite) TestLaneTxOrder() {
+	t := s.T()
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 5)
+	sa := accounts[0].Address
+	sb := accounts[1].Address
+	sc := accounts[2].Address
+
+	tests := []struct {
+		txs   []txSpec
+		order []int
+	}{
+		{
+			// higher gas price first
+			txs: []txSpec{
+				{p: 5, n: 0, a: sa},
+				{p: 10, n: 0, a: sb},
+				{p: 7, n: 0, a: sc},
+			},
+			order: []int{1, 2, 0},
+		},
+		{
+			// nonce order of a sender is preserved
+			txs: []txSpec{
+				{p: 5, n: 0, a: sa},
+				{p: 20, n: 1, a: sa},
+				{p: 10, n: 0, a: sb},
+			},
+			order: []int{2, 0, 1},
+		},
+		{
+			// same gas price is FIFO
+			txs: []txSpec{
+				{p: 10, n: 1, a: sa},
+				{p: 10, n: 0, a: sb},
+				{p: 10, n: 0, a: sa},
+				{p: 10, n: 1, a: sb},
+			},
+			order: []int{1, 2, 0, 3},
+		},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("case %d", i), func(t *testing.T) {
+			pool := appmempool.NewLaneMempool()
+			for i, ts := range tt.txs {
+				tx := testTx{id: i, priority: int64(ts.p), nonce: uint64(ts.n), address: ts.a}
+				require.NoError(t, pool.Insert(ctx.WithPriority(tx.priority), tx))
+			}
+
+			orderedTxs := fetchTxs(pool.Select(ctx, nil), 1000)
+			var txOrder []int
+			for _, tx := range orderedTxs {
+				txOrder = append(txOrder, tx.(testTx).id)
+			}
+			for _, tx := range orderedTxs {
+				require.NoError(t, pool.Remove(tx))
+			}
+			require.Equal(t, tt.order, txOrder)
+			require.Equal(t, 0, pool.CountTx())
+		})
+	}
+}
+
+func (s *MempoolTestSuite) TestLaneSelection() {
+	t := s.T()
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 4)
+	pool := appmempool.NewLaneMempool()
+
+	txs := []testTx{
+		{id: 0, priority: 100, address: accounts[0].Address, msgs: []sdk.Msg{&banktypes.MsgSend{}}},
+		{id: 1, priority: 1, address: accounts[1].Address, msgs: []sdk.Msg{&stakingtypes.MsgDelegate{}}},
+		{id: 2, priority: 1, address: accounts[2].Address, msgs: []sdk.Msg{&clienttypes.MsgUpdateClient{}}},
+		{id: 3, priority: 1, address: accounts[3].Address, msgs: []sdk.Msg{&oracleexported.MsgAggregateExchangeRateVote{}}},
+	}
+	for _, tx := range txs {
+		require.NoError(t, pool.Insert(ctx.WithPriority(tx.priority), tx))
+	}
+
+	require.Equal(t, map[string]int{
+		appmempool.LaneOracle:     1,
+		appmempool.LaneIBC:        1,
+		appmempool.LaneStakingGov: 1,
+		appmempool.LaneDefault:    1,
+	}, pool.LaneSizes())
+
+	var txOrder []int
+	for _, tx := range fetchTxs(pool.Select(ctx, nil), 1000) {
+		txOrder = append(txOrder, tx.(testTx).id)
+	}
+	require.Equal(t, []int{3, 2, 1, 0}, txOrder)
+}
+
+func (s *MempoolTestSuite) TestLaneMaxTx() {
+	t := s.T()
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	pool := appmempool.NewLaneMempool(appmempool.LanesOpt(
+		appmempool.Lane{Name: appmempool.LaneDefault, MaxTx: 1, BlockSpace: sdk.OneDec()},
+	))
+
+	tx := testTx{nonce: 0, address: accounts[0].Address, priority: 1}
+	require.NoError(t, pool.Insert(ctx.WithPriority(tx.priority), tx))
+
+	// a new tx exceeds the capacity
+	tx2 := testTx{nonce: 1, address: accounts[0].Address, priority: 1}
+	require.Equal(t, mempool.ErrMempoolTxMaxCapacity, pool.Insert(ctx.WithPriority(tx2.priority), tx2))
+
+	// the same sender-nonce replaces the pending tx
+	replacement := testTx{id: 1, nonce: 0, address: accounts[0].Address, priority: 2}
+	require.NoError(t, pool.Insert(ctx.WithPriority(replacement.priority), replacement))
+	require.Equal(t, 1, pool.CountTx())
+	require.Equal(t, 1, pool.Select(ctx, nil).Tx().(testTx).id)
+
+	require.ErrorIs(t, pool.Remove(tx2), mempool.ErrTxNotFound)
+	require.NoError(t, pool.Remove(replacement))
+	require.Equal(t, 0, pool.CountTx())
+	require.Nil(t, pool.Select(ctx, nil))
+}
+
+func (s *MempoolTestSuite) TestLaneMempoolMaxTx() {
+	t := s.T()
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 3)
+	pool := appmempool.NewLaneMempool(appmempool.LaneMaxTxOpt(2))
+
+	// the cap applies across lanes
+	require.NoError(t, pool.Insert(ctx, testTx{nonce: 0, address: accounts[0].Address}))
+	require.NoError(t, pool.Insert(ctx, testTx{nonce: 0, address: accounts[1].Address, msgs: []sdk.Msg{&oracleexported.MsgAggregateExchangeRateVote{}}}))
+	require.Equal(t, map[string]int{appmempool.LaneOracle: 1, appmempool.LaneIBC: 0, appmempool.LaneStakingGov: 0, appmempool.LaneDefault: 1}, pool.LaneSizes())
+
+	tx := testTx{nonce: 0, address: accounts[2].Address}
+	require.Equal(t, mempool.ErrMempoolTxMaxCapacity, pool.Insert(ctx, tx))
+
+	// a replacement does not take a new slot
+	replacement := testTx{id: 1, nonce: 0, address: accounts[0].Address, priority: 1}
+	require.NoError(t, pool.Insert(ctx.WithPriority(replacement.priority), replacement))
+
+	require.NoError(t, pool.Remove(replacement))
+	require.NoError(t, pool.Insert(ctx, tx))
+	require.Equal(t, 2, pool.CountTx())
+}
+
+func (s *MempoolTestSuite) TestLaneMaxTxPerSender() {
+	t := s.T()
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	pool := appmempool.NewLaneMempool(appmempool.LaneMaxTxPerSenderOpt(2))
+
+	for i := 0; i < 2; i++ {
+		require.NoError(t, pool.Insert(ctx, testTx{nonce: uint64(i), address: accounts[0].Address}))
+	}
+
+	tx := testTx{nonce: 2, address: accounts[0].Address}
+	require.ErrorIs(t, pool.Insert(ctx, tx), appmempool.ErrSenderTxLimit)
+
+	// other senders are not affected
+	require.NoError(t, pool.Insert(ctx, testTx{nonce: 0, address: accounts[1].Address}))
+
+	// removing a tx frees a slot of the sender
+	require.NoError(t, pool.Remove(testTx{nonce: 0, address: accounts[0].Address}))
+	require.NoError(t, pool.Insert(ctx, tx))
+	require.Equal(t, 3, pool.CountTx())
+}
+
+func (s *MempoolTestSuite) TestLaneReplaceByFee() {
+	t := s.T()
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	pool := appmempool.NewLaneMempool(
+		appmempool.LanesOpt(appmempool.Lane{Name: appmempool.LaneDefault, MaxTx: 2, BlockSpace: sdk.OneDec()}),
+		appmempool.LaneMaxTxPerSenderOpt(1),
+	)
+
+	tx := testTx{id: 0, nonce: 0, address: accounts[0].Address, priority: 10}
+	require.NoError(t, pool.Insert(ctx.WithPriority(tx.priority), tx))
+	require.NoError(t, pool.Insert(ctx.WithPriority(20), testTx{id: 1, nonce: 0, address: accounts[1].Address, priority: 20}))
+
+	// the same or a lower fee does not replace the pending tx
+	lower := testTx{id: 2, nonce: 0, address: accounts[0].Address, priority: 9}
+	require.ErrorIs(t, pool.Insert(ctx.WithPriority(lower.priority), lower), appmempool.ErrReplacementFeeTooLow)
+	same := testTx{id: 3, nonce: 0, address: accounts[0].Address, priority: 10}
+	require.ErrorIs(t, pool.Insert(ctx.WithPriority(same.priority), same), appmempool.ErrReplacementFeeTooLow)
+
+	// a higher fee replaces it, even if the lane and the sender are full
+	higher := testTx{id: 4, nonce: 0, address: accounts[0].Address, priority: 30}
+	require.NoError(t, pool.Insert(ctx.WithPriority(higher.priority), higher))
+	require.Equal(t, 2, pool.CountTx())
+
+	var ids []int
+	for _, tx := range fetchTxs(pool.Select(ctx, nil), 1000) {
+		ids = append(ids, tx.(testTx).id)
+	}
+	require.Equal(t, []int{4, 1}, ids)
+}
+
+func (s *MempoolTestSuite) TestLaneTTLEviction() {
+	t := s.T()
+	now := time.Now()
+	ctx := sdk.NewContext(nil, tmproto.Header{Height: 1, Time: now}, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 3)
+	pool := appmempool.NewLaneMempool(appmempool.LaneTTLOpt(5, time.Minute), appmempool.LaneMaxTxPerSenderOpt(1))
+
+	require.NoError(t, pool.Insert(ctx, testTx{id: 0, address: accounts[0].Address}))
+	require.NoError(t, pool.Insert(ctx.WithBlockHeight(4), testTx{id: 1, address: accounts[1].Address}))
+	require.NoError(t, pool.Insert(ctx.WithBlockHeight(6).WithBlockTime(now.Add(30*time.Second)), testTx{id: 2, address: accounts[2].Address}))
+
+	// tx 0 exceeds the height ttl
+	selectIDs := func(ctx sdk.Context) []int {
+		var ids []int
+		for _, tx := range fetchTxs(pool.Select(ctx, nil), 1000) {
+			ids = append(ids, tx.(testTx).id)
+		}
+		return ids
+	}
+	require.ElementsMatch(t, []int{1, 2}, selectIDs(ctx.WithBlockHeight(7)))
+	require.Equal(t, 2, pool.CountTx())
+
+	// tx 1 exceeds the time ttl
+	require.Equal(t, []int{2}, selectIDs(ctx.WithBlockHeight(7).WithBlockTime(now.Add(80*time.Second))))
+
+	// evicted txs free the slot of their sender
+	require.NoError(t, pool.Insert(ctx, testTx{id: 3, nonce: 1, address: accounts[0].Address}))
+	require.Equal(t, 2, pool.CountTx())
+}
+
+type testTxVerifier struct {
+	txSize int
+}
+
+func (v testTxVerifier) PrepareProposalVerifyTx(tx sdk.Tx) ([]byte, error) {
+	bz := make([]byte, v.txSize)
+	bz[0] = byte(tx.(testTx).id)
+	return bz, nil
+}
+
+func (v testTxVerifier) ProcessProposalVerifyTx(_ []byte) (sdk.Tx, error) {
+	panic("not implemented")
+}
+
+func TestLaneProposalHandlerBlockSpace(t *testing.T) {
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 10)
+	pool := appmempool.NewLaneMempool(appmempool.LanesOpt(
+		appmempool.Lane{Name: appmempool.LaneOracle, Match: func(tx sdk.Tx) bool { return tx.(testTx).id < 5 }, BlockSpace: sdk.NewDecWithPrec(5, 1)},
+		appmempool.Lane{Name: appmempool.LaneDefault, BlockSpace: sdk.OneDec()},
+	))
+
+	for i, acc := range accounts {
+		tx := testTx{id: i, address: acc.Address, priority: int64(i)}
+		require.NoError(t, pool.Insert(ctx.WithPriority(tx.priority), tx))
+	}
+
+	// 10 bytes per tx, the first lane can fill 30 of 60 bytes
+	handler := appmempool.NewLaneProposalHandler(pool, testTxVerifier{txSize: 10})
+	res := handler.PrepareProposalHandler()(ctx, abci.RequestPrepareProposal{MaxTxBytes: 60})
+
+	var ids []int
+	for _, bz := range res.Txs {
+		ids = append(ids, int(bz[0]))
+	}
+	require.Equal(t, []int{4, 3, 2, 9, 8, 7}, ids)
+}
+
+func TestLaneProposalHandlerTTLEviction(t *testing.T) {
+	now := time.Now()
+	ctx := sdk.NewContext(nil, tmproto.Header{Height: 1, Time: now}, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	pool := appmempool.NewLaneMempool(appmempool.LaneTTLOpt(5, 0))
+
+	require.NoError(t, pool.Insert(ctx, testTx{id: 0, address: accounts[0].Address}))
+	require.NoError(t, pool.Insert(ctx.WithBlockHeight(4), testTx{id: 1, address: accounts[1].Address}))
+
+	// tx 0 exceeds the height ttl at the proposal height
+	handler := appmempool.NewLaneProposalHandler(pool, testTxVerifier{txSize: 10})
+	res := handler.PrepareProposalHandler()(ctx.WithBlockHeight(7), abci.RequestPrepareProposal{MaxTxBytes: 100})
+
+	var ids []int
+	for _, bz := range res.Txs {
+		ids = append(ids, int(bz[0]))
+	}
+	require.Equal(t, []int{1}, ids)
+	require.Equal(t, 1, pool.CountTx())
+}
@@ -0,0 +1,50 @@
+package mempool
+
+import (
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+const (
+	metricKeyMempool  = "mempool"
+	metricKeyLaneSize = "lane_size"
+	metricKeyEvicted  = "evicted"
+	metricKeyRejected = "rejected"
+
+	// rejection reasons
+	ReasonCapacity             = "capacity"
+	ReasonSenderLimit          = "sender_limit"
+	ReasonReplacementFeeTooLow = "replacement_fee_too_low"
+
+	// eviction reasons
+	ReasonTTLHeight = "ttl_height"
+	ReasonTTLTime   = "ttl_time"
+	ReasonReplaced  = "replaced"
+)
+
+// setLaneSizeGauge reports the number of pending txs of a lane.
+func setLaneSizeGauge(lane string, size int) {
+	telemetry.SetGaugeWithLabels(
+		[]string{metricKeyMempool, metricKeyLaneSize},
+		float32(size),
+		[]metrics.Label{telemetry.NewLabel("lane", lane)},
+	)
+}
+
+// incrEvictedCounter counts a tx removed from the mempool without being included in a block.
+func incrEvictedCounter(reason string) {
+	telemetry.IncrCounterWithLabels(
+		[]string{metricKeyMempool, metricKeyEvicted},
+		1,
+		[]metrics.Label{telemetry.NewLabel("reason", reason)},
+	)
+}
+
+// incrRejectedCounter counts a tx refused by Insert.
+func incrRejectedCounter(reason string) {
+	telemetry.IncrCounterWithLabels(
+		[]string{metricKeyMempool, metricKeyRejected},
+		1,
+		[]metrics.Label{telemetry.NewLabel("reason", reason)},
+	)
+}
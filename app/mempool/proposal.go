@@ -0,0 +1,107 @@
+package mempool
+
+import (
+	"errors"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// LaneProposalHandler prepares proposals from a LaneMempool. Lanes are filled in
+// order and every lane is limited to its share of the block bytes, so that a
+// congested lane cannot starve the ones behind it.
+type LaneProposalHandler struct {
+	mempool    *LaneMempool
+	txVerifier baseapp.ProposalTxVerifier
+	*baseapp.DefaultProposalHandler
+}
+
+func NewLaneProposalHandler(mp *LaneMempool, txVerifier baseapp.ProposalTxVerifier) *LaneProposalHandler {
+	return &LaneProposalHandler{
+		mempool:                mp,
+		txVerifier:             txVerifier,
+		DefaultProposalHandler: baseapp.NewDefaultProposalHandler(mp, txVerifier),
+	}
+}
+
+// PrepareProposalHandler selects the transactions of every lane by priority until
+// the lane's share of req.MaxTxBytes, the remaining block bytes or the block gas
+// limit is reached. Invalid transactions are removed from the mempool.
+func (h *LaneProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req abci.RequestPrepareProposal) abci.ResponsePrepareProposal {
+		var maxBlockGas uint64
+		if cp := ctx.ConsensusParams(); cp != nil && cp.Block != nil && cp.Block.MaxGas > 0 {
+			maxBlockGas = uint64(cp.Block.MaxGas)
+		}
+
+		maxTxBytes := uint64(req.MaxTxBytes)
+		var (
+			selectedTxs  [][]byte
+			totalTxBytes uint64
+			totalTxGas   uint64
+		)
+		selectedTxsSignersSeqs := make(map[string]uint64)
+
+		for _, laneTxs := range h.mempool.SelectLanes(ctx) {
+			laneMaxTxBytes := laneTxs.Lane.BlockSpace.MulInt64(req.MaxTxBytes).TruncateInt().Uint64()
+			var laneTxBytes uint64
+
+			for _, memTx := range laneTxs.Txs {
+				sigs, err := memTx.(signing.SigVerifiableTx).GetSignaturesV2()
+				if err != nil {
+					panic(fmt.Errorf("failed to get signatures: %w", err))
+				}
+
+				// the txs of a signer must be included with consecutive sequences
+				txSignersSeqs := make(map[string]uint64)
+				shouldAdd := true
+				for _, sig := range sigs {
+					signer := sdk.AccAddress(sig.PubKey.Address()).String()
+					if seq, ok := selectedTxsSignersSeqs[signer]; ok && seq+1 != sig.Sequence {
+						shouldAdd = false
+						break
+					}
+					txSignersSeqs[signer] = sig.Sequence
+				}
+				if !shouldAdd {
+					continue
+				}
+
+				txBz, err := h.txVerifier.PrepareProposalVerifyTx(memTx)
+				if err != nil {
+					if err := h.mempool.Remove(memTx); err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
+						panic(err)
+					}
+					continue
+				}
+
+				txSize := uint64(len(txBz))
+				if laneTxBytes+txSize > laneMaxTxBytes || totalTxBytes+txSize > maxTxBytes {
+					continue
+				}
+
+				var txGas uint64
+				if gasTx, ok := memTx.(baseapp.GasTx); ok {
+					txGas = gasTx.GetGas()
+				}
+				if maxBlockGas > 0 && totalTxGas+txGas > maxBlockGas {
+					continue
+				}
+
+				selectedTxs = append(selectedTxs, txBz)
+				laneTxBytes += txSize
+				totalTxBytes += txSize
+				totalTxGas += txGas
+				for signer, seq := range txSignersSeqs {
+					selectedTxsSignersSeqs[signer] = seq
+				}
+			}
+		}
+
+		return abci.ResponsePrepareProposal{Txs: selectedTxs}
+	}
+}
@@ -0,0 +1,177 @@
+package mempool
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+var _ QueryServer = queryServer{}
+
+// PendingTxInfo describes a pending tx of an InspectableMempool.
+type PendingTxInfo struct {
+	Lane       string
+	Tx         sdk.Tx
+	Priority   int64
+	Height     int64
+	ReceivedAt time.Time
+}
+
+// InspectableMempool is a mempool whose pending txs can be listed.
+type InspectableMempool interface {
+	mempool.Mempool
+
+	// PendingTxs returns the pending txs in selection order.
+	PendingTxs() []PendingTxInfo
+	// LaneSizes returns the number of pending txs per lane.
+	LaneSizes() map[string]int
+}
+
+var (
+	_ InspectableMempool = (*FifoMempool)(nil)
+	_ InspectableMempool = (*LaneMempool)(nil)
+)
+
+// queryServer is the server of the node-local mempool Query service.
+type queryServer struct {
+	mempool   InspectableMempool
+	txEncoder sdk.TxEncoder
+}
+
+// NewQueryServer creates a new mempool Query service server.
+func NewQueryServer(mp InspectableMempool, txEncoder sdk.TxEncoder) QueryServer {
+	return queryServer{
+		mempool:   mp,
+		txEncoder: txEncoder,
+	}
+}
+
+// PendingTxs implements the QueryServer.PendingTxs RPC method.
+func (q queryServer) PendingTxs(_ context.Context, req *QueryPendingTxsRequest) (*QueryPendingTxsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	offset, limit, countTotal := uint64(0), uint64(query.DefaultLimit), false
+	if req.Pagination != nil {
+		if len(req.Pagination.Key) != 0 {
+			return nil, status.Error(codes.InvalidArgument, "key based pagination is not supported, use offset")
+		}
+
+		offset, countTotal = req.Pagination.Offset, req.Pagination.CountTotal
+		if req.Pagination.Limit > 0 {
+			limit = req.Pagination.Limit
+		}
+	}
+
+	now := time.Now()
+	txs := []PendingTx{}
+	total := uint64(0)
+	for _, info := range q.mempool.PendingTxs() {
+		if req.Lane != "" && info.Lane != req.Lane {
+			continue
+		}
+
+		msgTypeURLs := make([]string, len(info.Tx.GetMsgs()))
+		for i, msg := range info.Tx.GetMsgs() {
+			msgTypeURLs[i] = sdk.MsgTypeURL(msg)
+		}
+
+		if len(req.MsgTypeUrls) != 0 && !containsAny(msgTypeURLs, req.MsgTypeUrls) {
+			continue
+		}
+
+		total++
+		if total <= offset || uint64(len(txs)) >= limit {
+			continue
+		}
+
+		pendingTx, err := q.pendingTx(info, msgTypeURLs, now)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		txs = append(txs, pendingTx)
+	}
+
+	laneSizes := []LaneSize{}
+	for lane, size := range q.mempool.LaneSizes() {
+		laneSizes = append(laneSizes, LaneSize{Lane: lane, Count: uint64(size)})
+	}
+	sort.Slice(laneSizes, func(i, j int) bool { return laneSizes[i].Lane < laneSizes[j].Lane })
+
+	pageRes := &query.PageResponse{}
+	if countTotal {
+		pageRes.Total = total
+	}
+
+	return &QueryPendingTxsResponse{Txs: txs, LaneSizes: laneSizes, Pagination: pageRes}, nil
+}
+
+func (q queryServer) pendingTx(info PendingTxInfo, msgTypeURLs []string, now time.Time) (PendingTx, error) {
+	bz, err := q.txEncoder(info.Tx)
+	if err != nil {
+		return PendingTx{}, fmt.Errorf("failed to encode tx: %w", err)
+	}
+
+	txKey, err := getTxKey(info.Tx)
+	if err != nil {
+		return PendingTx{}, err
+	}
+
+	pendingTx := PendingTx{
+		Lane:        info.Lane,
+		Hash:        fmt.Sprintf("%X", cmttypes.Tx(bz).Hash()),
+		Sender:      txKey.address,
+		Sequence:    txKey.nonce,
+		Priority:    info.Priority,
+		MsgTypeUrls: msgTypeURLs,
+		Height:      info.Height,
+		ReceivedAt:  info.ReceivedAt,
+		Age:         now.Sub(info.ReceivedAt),
+	}
+
+	if feeTx, ok := info.Tx.(sdk.FeeTx); ok {
+		pendingTx.Gas = feeTx.GetGas()
+		pendingTx.Fee = feeTx.GetFee()
+	}
+
+	return pendingTx, nil
+}
+
+func containsAny(values []string, wanted []string) bool {
+	for _, v := range values {
+		for _, w := range wanted {
+			if v == w {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// RegisterQueryService registers the mempool Query service on the gRPC router
+// if the mempool can be inspected.
+func RegisterQueryService(qrt gogogrpc.Server, mp mempool.Mempool, txEncoder sdk.TxEncoder) {
+	if imp, ok := mp.(InspectableMempool); ok {
+		RegisterQueryServer(qrt, NewQueryServer(imp, txEncoder))
+	}
+}
+
+// RegisterGRPCGatewayRoutes mounts the mempool Query service's GRPC-gateway routes
+// on the given Mux.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	_ = RegisterQueryHandlerClient(context.Background(), mux, NewQueryClient(clientConn))
+}
@@ -0,0 +1,81 @@
+package mempool_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	appmempool "github.com/classic-terra/core/v3/app/mempool"
+	oracleexported "github.com/classic-terra/core/v3/x/oracle/exported"
+)
+
+func testTxEncoder(tx sdk.Tx) ([]byte, error) {
+	return []byte{byte(tx.(testTx).id)}, nil
+}
+
+func TestQueryPendingTxs(t *testing.T) {
+	ctx := sdk.NewContext(nil, tmproto.Header{Height: 10}, false, log.NewNopLogger())
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 4)
+	mp := appmempool.NewFifoMempool()
+
+	txs := []testTx{
+		{id: 0, address: accounts[0].Address, msgs: []sdk.Msg{&banktypes.MsgSend{}}},
+		{id: 1, address: accounts[1].Address, msgs: []sdk.Msg{&oracleexported.MsgAggregateExchangeRateVote{}}},
+		{id: 2, address: accounts[2].Address, msgs: []sdk.Msg{&banktypes.MsgSend{}}},
+		{id: 3, address: accounts[3].Address, nonce: 7, msgs: []sdk.Msg{&banktypes.MsgMultiSend{}}},
+	}
+	for _, tx := range txs {
+		require.NoError(t, mp.Insert(ctx, tx))
+	}
+
+	server := appmempool.NewQueryServer(mp, testTxEncoder)
+
+	res, err := server.PendingTxs(ctx, &appmempool.QueryPendingTxsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Txs, 4)
+	require.Equal(t, []appmempool.LaneSize{
+		{Lane: appmempool.LaneDefault, Count: 3},
+		{Lane: appmempool.LaneOracle, Count: 1},
+	}, res.LaneSizes)
+
+	oracleTx := res.Txs[0]
+	require.Equal(t, appmempool.LaneOracle, oracleTx.Lane)
+	require.Equal(t, accounts[1].Address.String(), oracleTx.Sender)
+	require.Equal(t, int64(10), oracleTx.Height)
+	require.Equal(t, []string{sdk.MsgTypeURL(&oracleexported.MsgAggregateExchangeRateVote{})}, oracleTx.MsgTypeUrls)
+	require.Len(t, oracleTx.Hash, 64)
+
+	// lane filter
+	res, err = server.PendingTxs(ctx, &appmempool.QueryPendingTxsRequest{Lane: appmempool.LaneOracle})
+	require.NoError(t, err)
+	require.Len(t, res.Txs, 1)
+
+	// msg type filter
+	res, err = server.PendingTxs(ctx, &appmempool.QueryPendingTxsRequest{
+		MsgTypeUrls: []string{sdk.MsgTypeURL(&banktypes.MsgMultiSend{})},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Txs, 1)
+	require.Equal(t, uint64(7), res.Txs[0].Sequence)
+
+	// pagination
+	res, err = server.PendingTxs(ctx, &appmempool.QueryPendingTxsRequest{
+		Lane:       appmempool.LaneDefault,
+		Pagination: &query.PageRequest{Offset: 1, Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Txs, 1)
+	require.Equal(t, accounts[2].Address.String(), res.Txs[0].Sender)
+	require.Equal(t, uint64(3), res.Pagination.Total)
+
+	_, err = server.PendingTxs(ctx, &appmempool.QueryPendingTxsRequest{Pagination: &query.PageRequest{Key: []byte{1}}})
+	require.Error(t, err)
+}
@@ -19,17 +19,26 @@ import (
 	customstaking "github.com/classic-terra/core/v3/custom/staking"
 	customupgrade "github.com/classic-terra/core/v3/custom/upgrade"
 	customwasm "github.com/classic-terra/core/v3/custom/wasm"
+	circuitbreakermodule "github.com/classic-terra/core/v3/x/circuitbreaker/module"
+	circuitbreakertypes "github.com/classic-terra/core/v3/x/circuitbreaker/types"
 	"github.com/classic-terra/core/v3/x/dyncomm"
 	dyncommtypes "github.com/classic-terra/core/v3/x/dyncomm/types"
+	icaauthmodule "github.com/classic-terra/core/v3/x/icaauth/module"
+	icaauthtypes "github.com/classic-terra/core/v3/x/icaauth/types"
 	"github.com/classic-terra/core/v3/x/market"
 	markettypes "github.com/classic-terra/core/v3/x/market/types"
 	"github.com/classic-terra/core/v3/x/oracle"
 	oracletypes "github.com/classic-terra/core/v3/x/oracle/types"
+	ratelimitmodule "github.com/classic-terra/core/v3/x/ratelimit/module"
+	ratelimittypes "github.com/classic-terra/core/v3/x/ratelimit/types"
+	stargatemodule "github.com/classic-terra/core/v3/x/stargate/module"
+	stargatetypes "github.com/classic-terra/core/v3/x/stargate/types"
 	taxmodule "github.com/classic-terra/core/v3/x/tax/module"
 	"github.com/classic-terra/core/v3/x/treasury"
 	treasuryclient "github.com/classic-terra/core/v3/x/treasury/client"
 	treasurytypes "github.com/classic-terra/core/v3/x/treasury/types"
 	"github.com/classic-terra/core/v3/x/vesting"
+	vestingtypes "github.com/classic-terra/core/v3/x/vesting/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -65,6 +74,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/upgrade"
 	upgradeclient "github.com/cosmos/cosmos-sdk/x/upgrade/client"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	packetforward "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward"
+	packetforwardtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
 	ibchooks "github.com/cosmos/ibc-apps/modules/ibc-hooks/v7"
 	ibchookstypes "github.com/cosmos/ibc-apps/modules/ibc-hooks/v7/types"
 	ica "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts"
@@ -80,6 +91,7 @@ import (
 
 	taxbank "github.com/classic-terra/core/v3/x/tax/modules/bank"
 	taxmarket "github.com/classic-terra/core/v3/x/tax/modules/market"
+	taxvesting "github.com/classic-terra/core/v3/x/tax/modules/vesting"
 	taxtypes "github.com/classic-terra/core/v3/x/tax/types"
 
 	// unnamed import of statik for swagger UI support
@@ -128,8 +140,13 @@ var (
 		ibcfee.AppModuleBasic{},
 		dyncomm.AppModuleBasic{},
 		ibchooks.AppModuleBasic{},
+		packetforward.AppModuleBasic{},
 		consensus.AppModuleBasic{},
 		taxmodule.AppModuleBasic{},
+		stargatemodule.AppModuleBasic{},
+		ratelimitmodule.AppModuleBasic{},
+		icaauthmodule.AppModuleBasic{},
+		circuitbreakermodule.AppModuleBasic{},
 	)
 	// module account permissions
 	maccPerms = map[string][]string{
@@ -188,10 +205,16 @@ func appModules(
 		oracle.NewAppModule(appCodec, app.OracleKeeper, app.AccountKeeper, app.BankKeeper),
 		treasury.NewAppModule(appCodec, app.TreasuryKeeper),
 		wasm.NewAppModule(appCodec, &app.WasmKeeper, app.StakingKeeper, app.AccountKeeper, app.BankKeeper, app.MsgServiceRouter(), app.GetSubspace(wasmtypes.ModuleName)),
-		dyncomm.NewAppModule(appCodec, app.DyncommKeeper, app.StakingKeeper),
+		dyncomm.NewAppModule(appCodec, app.DyncommKeeper, app.StakingKeeper, app.AccountKeeper, app.BankKeeper),
 		ibchooks.NewAppModule(app.AccountKeeper),
+		packetforward.NewAppModule(app.PacketForwardKeeper, app.GetSubspace(packetforwardtypes.ModuleName)),
 		consensus.NewAppModule(appCodec, app.ConsensusParamsKeeper),
-		taxmodule.NewAppModule(appCodec, app.TaxKeeper),
+		taxmodule.NewAppModule(appCodec, app.TaxKeeper, app.AccountKeeper, app.BankKeeper, app.TreasuryKeeper, &app.WasmKeeper),
+		stargatemodule.NewAppModule(appCodec, app.StargateKeeper),
+		taxvesting.NewAppModule(app.VestingKeeper, app.TreasuryKeeper, app.TaxKeeper),
+		ratelimitmodule.NewAppModule(appCodec, app.RateLimitKeeper),
+		icaauthmodule.NewAppModule(appCodec, app.ICAAuthKeeper),
+		circuitbreakermodule.NewAppModule(appCodec, app.CircuitBreakerKeeper),
 		crisis.NewAppModule(app.CrisisKeeper, skipGenesisInvariants, app.GetSubspace(crisistypes.ModuleName)), // always be last to make sure that it checks for all invariants and not only part of them
 	}
 }
@@ -211,7 +234,7 @@ func simulationModules(
 		mint.NewAppModule(appCodec, app.MintKeeper, app.AccountKeeper, nil, app.GetSubspace(minttypes.ModuleName)),
 		slashing.NewAppModule(appCodec, app.SlashingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper, app.GetSubspace(slashingtypes.ModuleName)),
 		distr.NewAppModule(appCodec, app.DistrKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper, app.GetSubspace(distrtypes.ModuleName)),
-		staking.NewAppModule(appCodec, app.StakingKeeper, app.AccountKeeper, app.BankKeeper, app.GetSubspace(stakingtypes.ModuleName)),
+		customstaking.NewAppModule(appCodec, app.StakingKeeper, app.AccountKeeper, app.BankKeeper, app.GetSubspace(stakingtypes.ModuleName)),
 		evidence.NewAppModule(app.EvidenceKeeper),
 		params.NewAppModule(app.ParamsKeeper),
 		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
@@ -223,8 +246,8 @@ func simulationModules(
 		market.NewAppModule(appCodec, app.MarketKeeper, app.AccountKeeper, app.BankKeeper, app.OracleKeeper),
 		treasury.NewAppModule(appCodec, app.TreasuryKeeper),
 		wasm.NewAppModule(appCodec, &app.WasmKeeper, app.StakingKeeper, app.AccountKeeper, app.BankKeeper, app.MsgServiceRouter(), app.GetSubspace(wasmtypes.ModuleName)),
-		dyncomm.NewAppModule(appCodec, app.DyncommKeeper, app.StakingKeeper),
-		taxmodule.NewAppModule(appCodec, app.TaxKeeper),
+		dyncomm.NewAppModule(appCodec, app.DyncommKeeper, app.StakingKeeper, app.AccountKeeper, app.BankKeeper),
+		taxmodule.NewAppModule(appCodec, app.TaxKeeper, app.AccountKeeper, app.BankKeeper, app.TreasuryKeeper, &app.WasmKeeper),
 	}
 }
 
@@ -251,6 +274,7 @@ func orderBeginBlockers() []string {
 		icatypes.ModuleName,
 		ibcfeetypes.ModuleName,
 		ibchookstypes.ModuleName,
+		packetforwardtypes.ModuleName,
 		// Terra Classic modules
 		oracletypes.ModuleName,
 		treasurytypes.ModuleName,
@@ -258,6 +282,11 @@ func orderBeginBlockers() []string {
 		wasmtypes.ModuleName,
 		dyncommtypes.ModuleName,
 		taxtypes.ModuleName,
+		stargatetypes.ModuleName,
+		vestingtypes.ModuleName,
+		ratelimittypes.ModuleName,
+		icaauthtypes.ModuleName,
+		circuitbreakertypes.ModuleName,
 		// consensus module
 		consensusparamtypes.ModuleName,
 	}
@@ -265,7 +294,6 @@ func orderBeginBlockers() []string {
 
 func orderEndBlockers() []string {
 	return []string{
-		crisistypes.ModuleName,
 		govtypes.ModuleName,
 		stakingtypes.ModuleName,
 		capabilitytypes.ModuleName,
@@ -286,6 +314,7 @@ func orderEndBlockers() []string {
 		icatypes.ModuleName,
 		ibcfeetypes.ModuleName,
 		ibchookstypes.ModuleName,
+		packetforwardtypes.ModuleName,
 		// Terra Classic modules
 		oracletypes.ModuleName,
 		treasurytypes.ModuleName,
@@ -293,8 +322,15 @@ func orderEndBlockers() []string {
 		wasmtypes.ModuleName,
 		dyncommtypes.ModuleName,
 		taxtypes.ModuleName,
+		stargatetypes.ModuleName,
+		vestingtypes.ModuleName,
+		ratelimittypes.ModuleName,
+		icaauthtypes.ModuleName,
+		circuitbreakertypes.ModuleName,
 		// consensus module
 		consensusparamtypes.ModuleName,
+		// crisis checks the invariants after the treasury burned the taxes of the block
+		crisistypes.ModuleName,
 	}
 }
 
@@ -308,7 +344,6 @@ func orderInitGenesis() []string {
 		slashingtypes.ModuleName,
 		govtypes.ModuleName,
 		minttypes.ModuleName,
-		crisistypes.ModuleName,
 		genutiltypes.ModuleName,
 		evidencetypes.ModuleName,
 		authz.ModuleName,
@@ -321,6 +356,7 @@ func orderInitGenesis() []string {
 		icatypes.ModuleName,
 		ibcfeetypes.ModuleName,
 		ibchookstypes.ModuleName,
+		packetforwardtypes.ModuleName,
 		// Terra Classic modules
 		markettypes.ModuleName,
 		oracletypes.ModuleName,
@@ -328,7 +364,14 @@ func orderInitGenesis() []string {
 		wasmtypes.ModuleName,
 		dyncommtypes.ModuleName,
 		taxtypes.ModuleName,
+		stargatetypes.ModuleName,
+		vestingtypes.ModuleName,
+		ratelimittypes.ModuleName,
+		icaauthtypes.ModuleName,
+		circuitbreakertypes.ModuleName,
 		// consensus module
 		consensusparamtypes.ModuleName,
+		// crisis asserts the invariants of all modules once they are initialized
+		crisistypes.ModuleName,
 	}
 }
@@ -14,11 +14,13 @@ import (
 	dbm "github.com/cometbft/cometbft-db"
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cometbft/cometbft/libs/rand"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/simapp"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/cosmos/cosmos-sdk/store"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	"github.com/cosmos/cosmos-sdk/types/module"
@@ -47,29 +49,30 @@ func init() {
 	simcli.GetSimulatorFlags()
 }
 
-func setupSimulationApp(b *testing.B, msg string) (simtypes.Config, dbm.DB, simtestutil.AppOptionsMap, *terraapp.TerraApp) {
+func setupSimulationApp(tb testing.TB, msg string) (simtypes.Config, dbm.DB, simtestutil.AppOptionsMap, *terraapp.TerraApp) {
 	config := simcli.NewConfigFromFlags()
 	config.ChainID = SimAppChainID
 
 	db, dir, logger, skip, err := simtestutil.SetupSimulation(config, "leveldb-app-sim", "Simulation", simcli.FlagVerboseValue, simcli.FlagEnabledValue)
 	if skip {
-		b.Skip(msg)
+		tb.Skip(msg)
 	}
-	require.NoError(b, err, "simulation setup failed")
+	require.NoError(tb, err, "simulation setup failed")
 
-	b.Cleanup(func() {
-		require.NoError(b, db.Close())
-		require.NoError(b, os.RemoveAll(dir))
+	tb.Cleanup(func() {
+		require.NoError(tb, db.Close())
+		require.NoError(tb, os.RemoveAll(dir))
 	})
 
 	appOptions := make(simtestutil.AppOptionsMap, 0)
+	appOptions[server.FlagInvCheckPeriod] = simcli.FlagPeriodValue
 
 	app := terraapp.NewTerraApp(
 		logger, db, nil, true, map[int64]bool{},
 		dir, terraapp.MakeEncodingConfig(),
 		appOptions, emptyWasmOpts, interBlockCacheOpt(), fauxMerkleModeOpt(), baseapp.SetChainID(SimAppChainID),
 	)
-	require.Equal(b, "WasmApp", app.Name())
+	require.Equal(tb, "TerraApp", app.Name())
 	return config, db, appOptions, app
 }
 
@@ -105,6 +108,35 @@ func BenchmarkFullAppSimulation(b *testing.B) {
 	}
 }
 
+func TestFullAppSimulation(t *testing.T) {
+	config, db, _, app := setupSimulationApp(t, "skipping application simulation")
+
+	// run randomized simulation, the crisis module asserts the invariants every FlagPeriodValue blocks
+	_, simParams, simErr := simulation.SimulateFromSeed(
+		t,
+		os.Stdout,
+		app.BaseApp,
+		AppStateFn(app.AppCodec(), app.SimulationManager(), app.DefaultGenesis()),
+		simtypes.RandomAccounts,
+		simtestutil.SimulationOperations(app, app.AppCodec(), config),
+		app.ModuleAccountAddrs(),
+		config,
+		app.AppCodec(),
+	)
+
+	// export state and simParams before the simulation error is checked
+	require.NoError(t, simtestutil.CheckExportSimulation(app, config, simParams))
+	require.NoError(t, simErr)
+
+	// the invariants of all modules hold at the end of the simulation
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+	app.CrisisKeeper.AssertInvariants(ctx)
+
+	if config.Commit {
+		simtestutil.PrintStats(db)
+	}
+}
+
 // TODO: Make another test for the fuzzer itself, which just has noOp txs
 // and doesn't depend on the application.
 func TestAppStateDeterminism(t *testing.T) {
@@ -0,0 +1,152 @@
+package streaming
+
+import (
+	"fmt"
+	"strings"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+)
+
+const (
+	flagStateStreaming                = "state-streaming"
+	flagStateStreamingEnable          = flagStateStreaming + ".enable"
+	flagStateStreamingModules         = flagStateStreaming + ".modules"
+	flagStateStreamingWriteDir        = flagStateStreaming + ".write-dir"
+	flagStateStreamingPrefix          = flagStateStreaming + ".prefix"
+	flagStateStreamingStopNodeOnError = flagStateStreaming + ".stop-node-on-error"
+	flagStateStreamingFsync           = flagStateStreaming + ".fsync"
+)
+
+// Config is the app.toml configuration of the state streaming service.
+type Config struct {
+	// Enable streams the state changes of the configured modules.
+	Enable bool `mapstructure:"enable"`
+	// Modules are the modules whose state changes are streamed.
+	Modules []string `mapstructure:"modules"`
+	// WriteDir is the output directory, relative paths are based on the node home.
+	WriteDir string `mapstructure:"write-dir"`
+	// Prefix is prepended to the names of the output files.
+	Prefix string `mapstructure:"prefix"`
+	// StopNodeOnError halts the node if the state changes of a block can not be written.
+	StopNodeOnError bool `mapstructure:"stop-node-on-error"`
+	// Fsync syncs every output file to disk before it is moved into place.
+	Fsync bool `mapstructure:"fsync"`
+}
+
+// DefaultConfig returns the state streaming configuration, streaming is disabled by default.
+func DefaultConfig() Config {
+	return Config{
+		Enable:          false,
+		Modules:         Modules(),
+		WriteDir:        "data/state-streaming",
+		Prefix:          "",
+		StopNodeOnError: true,
+		Fsync:           false,
+	}
+}
+
+// ReadConfig reads the state streaming configuration from the app options.
+// Missing values fall back to DefaultConfig.
+func ReadConfig(appOpts servertypes.AppOptions) (Config, error) {
+	cfg := DefaultConfig()
+
+	var err error
+	if v := appOpts.Get(flagStateStreamingEnable); v != nil {
+		if cfg.Enable, err = cast.ToBoolE(v); err != nil {
+			return cfg, err
+		}
+	}
+
+	if v := appOpts.Get(flagStateStreamingModules); v != nil {
+		if cfg.Modules, err = cast.ToStringSliceE(v); err != nil {
+			return cfg, err
+		}
+	}
+
+	if v := appOpts.Get(flagStateStreamingWriteDir); v != nil {
+		if cfg.WriteDir, err = cast.ToStringE(v); err != nil {
+			return cfg, err
+		}
+	}
+
+	if v := appOpts.Get(flagStateStreamingPrefix); v != nil {
+		if cfg.Prefix, err = cast.ToStringE(v); err != nil {
+			return cfg, err
+		}
+	}
+
+	if v := appOpts.Get(flagStateStreamingStopNodeOnError); v != nil {
+		if cfg.StopNodeOnError, err = cast.ToBoolE(v); err != nil {
+			return cfg, err
+		}
+	}
+
+	if v := appOpts.Get(flagStateStreamingFsync); v != nil {
+		if cfg.Fsync, err = cast.ToBoolE(v); err != nil {
+			return cfg, err
+		}
+	}
+
+	return cfg, nil
+}
+
+// Validate checks that all configured modules can be streamed.
+func (c Config) Validate() error {
+	if len(c.Modules) == 0 {
+		return fmt.Errorf("no modules to stream")
+	}
+
+	for _, module := range c.Modules {
+		if _, ok := decoders[module]; !ok {
+			return fmt.Errorf("state changes of module %s can not be streamed, supported modules are %v", module, Modules())
+		}
+	}
+
+	if c.WriteDir == "" {
+		return fmt.Errorf("write dir must not be empty")
+	}
+
+	return nil
+}
+
+// ConfigTemplate toml snippet for app.toml
+func ConfigTemplate(c Config) string {
+	modules := make([]string, len(c.Modules))
+	for i, module := range c.Modules {
+		modules[i] = fmt.Sprintf("%q", module)
+	}
+
+	return fmt.Sprintf(`
+
+###############################################################################
+###                             State Streaming                             ###
+###############################################################################
+
+[state-streaming]
+# Write the decoded state changes of the custom modules of every block into a
+# JSON Lines file <write-dir>/<prefix>block-<height>.jsonl.
+enable = %t
+
+# Modules whose state changes are written, a subset of %s.
+modules = [%s]
+
+# Output directory, a relative path is based on the node home.
+write-dir = "%s"
+
+# Prefix of the output file names.
+prefix = "%s"
+
+# Halt the node if the state changes of a block can not be written, otherwise
+# the error is logged and the block is missing from the output.
+stop-node-on-error = %t
+
+# Sync every output file to disk before it is moved into place.
+fsync = %t
+`, c.Enable, strings.Join(Modules(), ", "), strings.Join(modules, ", "), c.WriteDir, c.Prefix, c.StopNodeOnError, c.Fsync)
+}
+
+// DefaultConfigTemplate toml snippet with default values for app.toml
+func DefaultConfigTemplate() string {
+	return ConfigTemplate(DefaultConfig())
+}
@@ -0,0 +1,280 @@
+package streaming
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/cosmos/gogoproto/types"
+
+	dyncommtypes "github.com/classic-terra/core/v3/x/dyncomm/types"
+	markettypes "github.com/classic-terra/core/v3/x/market/types"
+	oracletypes "github.com/classic-terra/core/v3/x/oracle/types"
+	taxtypes "github.com/classic-terra/core/v3/x/tax/types"
+	treasurytypes "github.com/classic-terra/core/v3/x/treasury/types"
+)
+
+// RecordTypeUnknown is the type of records whose key prefix is not known to
+// the decoder of the module, their key and value are hex encoded.
+const RecordTypeUnknown = "unknown"
+
+// Record is a decoded state change of a module store.
+type Record struct {
+	Height int64  `json:"height"`
+	Module string `json:"module"`
+	Type   string `json:"type"`
+	// Key holds the decoded parts of the store key, e.g. the denom or validator.
+	Key map[string]string `json:"key,omitempty"`
+	// Value is the JSON encoding of the stored value, empty for deletions.
+	Value  json.RawMessage `json:"value,omitempty"`
+	Delete bool            `json:"delete,omitempty"`
+}
+
+// decoder decodes a store entry of a module into a record, a nil value is
+// a deletion. Height, module and delete are set by the caller.
+type decoder func(cdc codec.Codec, key, value []byte) (Record, error)
+
+var decoders = map[string]decoder{
+	oracletypes.ModuleName:   decodeOracle,
+	markettypes.ModuleName:   decodeMarket,
+	treasurytypes.ModuleName: decodeTreasury,
+	taxtypes.ModuleName:      decodeTax,
+	dyncommtypes.ModuleName:  decodeDyncomm,
+}
+
+// Modules returns the sorted names of the modules whose state changes can be streamed.
+func Modules() []string {
+	modules := make([]string, 0, len(decoders))
+	for module := range decoders {
+		modules = append(modules, module)
+	}
+	sort.Strings(modules)
+	return modules
+}
+
+// Decode decodes a store entry of the module into a record.
+func Decode(cdc codec.Codec, module string, key, value []byte) (Record, error) {
+	decode, ok := decoders[module]
+	if !ok {
+		return Record{}, fmt.Errorf("no decoder for module %s", module)
+	}
+
+	if len(key) == 0 {
+		return Record{}, fmt.Errorf("empty %s store key", module)
+	}
+
+	record, err := decode(cdc, key, value)
+	if err != nil {
+		return Record{}, fmt.Errorf("failed to decode %s store key %X: %w", module, key, err)
+	}
+	record.Module = module
+	record.Delete = value == nil
+
+	return record, nil
+}
+
+func decodeOracle(cdc codec.Codec, key, value []byte) (Record, error) {
+	switch prefix, rest := key[:1], key[1:]; {
+	case bytes.Equal(prefix, oracletypes.ExchangeRateKey):
+		return protoRecord(cdc, "exchange_rate", map[string]string{"denom": string(rest)}, value, &sdk.DecProto{})
+	case bytes.Equal(prefix, oracletypes.FeederDelegationKey):
+		return validatorRecord(rest, "feeder_delegation", value, func(bz []byte) (json.RawMessage, error) {
+			return json.Marshal(sdk.AccAddress(bz).String())
+		})
+	case bytes.Equal(prefix, oracletypes.MissCounterKey):
+		return validatorRecord(rest, "miss_counter", value, protoValue(cdc, &gogotypes.UInt64Value{}))
+	case bytes.Equal(prefix, oracletypes.AggregateExchangeRatePrevoteKey):
+		return validatorRecord(rest, "aggregate_prevote", value, protoValue(cdc, &oracletypes.AggregateExchangeRatePrevote{}))
+	case bytes.Equal(prefix, oracletypes.AggregateExchangeRateVoteKey):
+		return validatorRecord(rest, "aggregate_vote", value, protoValue(cdc, &oracletypes.AggregateExchangeRateVote{}))
+	case bytes.Equal(prefix, oracletypes.TobinTaxKey):
+		return protoRecord(cdc, "tobin_tax", map[string]string{"denom": string(rest)}, value, &sdk.DecProto{})
+	default:
+		return unknownRecord(key, value), nil
+	}
+}
+
+func decodeMarket(cdc codec.Codec, key, value []byte) (Record, error) {
+	if bytes.Equal(key, markettypes.TerraPoolDeltaKey) {
+		return protoRecord(cdc, "terra_pool_delta", nil, value, &sdk.DecProto{})
+	}
+
+	return unknownRecord(key, value), nil
+}
+
+func decodeTreasury(cdc codec.Codec, key, value []byte) (Record, error) {
+	switch prefix, rest := key[:1], key[1:]; {
+	case bytes.Equal(key, treasurytypes.TaxRateKey):
+		return protoRecord(cdc, "tax_rate", nil, value, &sdk.DecProto{})
+	case bytes.Equal(key, treasurytypes.RewardWeightKey):
+		return protoRecord(cdc, "reward_weight", nil, value, &sdk.DecProto{})
+	case bytes.Equal(prefix, treasurytypes.TaxCapKey):
+		return protoRecord(cdc, "tax_cap", map[string]string{"denom": string(rest)}, value, &sdk.IntProto{})
+	case bytes.Equal(key, treasurytypes.TaxProceedsKey):
+		return protoRecord(cdc, "tax_proceeds", nil, value, &treasurytypes.EpochTaxProceeds{})
+	case bytes.Equal(key, treasurytypes.EpochInitialIssuanceKey):
+		return protoRecord(cdc, "epoch_initial_issuance", nil, value, &treasurytypes.EpochInitialIssuance{})
+	case bytes.Equal(prefix, treasurytypes.TRKey):
+		return epochRecord(cdc, "tax_reward", rest, value, &sdk.DecProto{})
+	case bytes.Equal(prefix, treasurytypes.SRKey):
+		return epochRecord(cdc, "seigniorage_reward", rest, value, &sdk.DecProto{})
+	case bytes.Equal(prefix, treasurytypes.TSLKey):
+		return epochRecord(cdc, "total_staked_luna", rest, value, &sdk.IntProto{})
+	case bytes.Equal(prefix, treasurytypes.BurnTaxExemptionListPrefix):
+		record := Record{Type: "burn_tax_exemption", Key: map[string]string{"address": string(rest)}}
+		if value != nil {
+			record.Value = json.RawMessage("true")
+		}
+		return record, nil
+	default:
+		return unknownRecord(key, value), nil
+	}
+}
+
+func decodeTax(cdc codec.Codec, key, value []byte) (Record, error) {
+	switch prefix, rest := key[:1], key[1:]; {
+	case bytes.Equal(key, taxtypes.ParamsKey):
+		return protoRecord(cdc, "params", nil, value, &taxtypes.Params{})
+	case bytes.Equal(prefix, taxtypes.GasDiscountUsageKeyPrefix):
+		signer, msgTypeURL, err := lengthPrefixed(rest)
+		if err != nil {
+			return Record{}, err
+		}
+		keys := map[string]string{"signer": sdk.AccAddress(signer).String(), "msg_type_url": string(msgTypeURL)}
+		return protoRecord(cdc, "gas_discount_usage", keys, value, &taxtypes.GasDiscountUsage{})
+	case bytes.Equal(prefix, taxtypes.ContractTaxPolicyKeyPrefix):
+		return contractRecord(cdc, "contract_tax_policy", rest, value, &taxtypes.ContractTaxPolicy{})
+	case bytes.Equal(prefix, taxtypes.CodeTaxPolicyKeyPrefix):
+		if len(rest) != 8 {
+			return Record{}, fmt.Errorf("invalid code id length %d", len(rest))
+		}
+		keys := map[string]string{"code_id": strconv.FormatUint(sdk.BigEndianToUint64(rest), 10)}
+		return protoRecord(cdc, "code_tax_policy", keys, value, &taxtypes.ContractTaxPolicy{})
+	case bytes.Equal(prefix, taxtypes.ContractTaxUsageKeyPrefix):
+		return contractRecord(cdc, "contract_tax_usage", rest, value, &taxtypes.ContractTaxUsage{})
+	default:
+		return unknownRecord(key, value), nil
+	}
+}
+
+func decodeDyncomm(cdc codec.Codec, key, value []byte) (Record, error) {
+	switch prefix, rest := key[:1], key[1:]; {
+	case bytes.Equal(prefix, dyncommtypes.MinCommissionRatesPrefix):
+		keys := map[string]string{"validator": string(rest)}
+		return protoRecord(cdc, "commission_rate", keys, value, &dyncommtypes.ValidatorCommissionRate{})
+	case bytes.Equal(prefix, dyncommtypes.CommissionHistoryPrefix):
+		validator, epoch, err := lengthPrefixed(rest)
+		if err != nil {
+			return Record{}, err
+		}
+		if len(epoch) != 8 {
+			return Record{}, fmt.Errorf("invalid epoch length %d", len(epoch))
+		}
+		keys := map[string]string{"validator": string(validator), "epoch": strconv.FormatUint(sdk.BigEndianToUint64(epoch), 10)}
+		return protoRecord(cdc, "commission_history", keys, value, &dyncommtypes.ValidatorCommissionHistory{})
+	case bytes.Equal(prefix, dyncommtypes.DirtyValidatorsPrefix):
+		return validatorRecord(rest, "dirty_validator", value, func([]byte) (json.RawMessage, error) {
+			return json.RawMessage("true"), nil
+		})
+	default:
+		return unknownRecord(key, value), nil
+	}
+}
+
+// protoRecord decodes the value into msg and returns its JSON encoding as record value.
+func protoRecord(cdc codec.Codec, recordType string, keys map[string]string, value []byte, msg codec.ProtoMarshaler) (Record, error) {
+	record := Record{Type: recordType, Key: keys}
+	if value == nil {
+		return record, nil
+	}
+
+	bz, err := protoValue(cdc, msg)(value)
+	if err != nil {
+		return Record{}, err
+	}
+	record.Value = bz
+
+	return record, nil
+}
+
+// protoValue returns a function decoding a value into msg and encoding it as JSON.
+func protoValue(cdc codec.Codec, msg codec.ProtoMarshaler) func([]byte) (json.RawMessage, error) {
+	return func(value []byte) (json.RawMessage, error) {
+		if err := cdc.Unmarshal(value, msg); err != nil {
+			return nil, err
+		}
+		return cdc.MarshalJSON(msg)
+	}
+}
+
+// validatorRecord decodes a store entry keyed by a length prefixed validator address.
+func validatorRecord(key []byte, recordType string, value []byte, decodeValue func([]byte) (json.RawMessage, error)) (Record, error) {
+	validator, rest, err := lengthPrefixed(key)
+	if err != nil {
+		return Record{}, err
+	}
+	if len(rest) != 0 {
+		return Record{}, fmt.Errorf("unexpected %d trailing key bytes", len(rest))
+	}
+
+	record := Record{Type: recordType, Key: map[string]string{"validator": sdk.ValAddress(validator).String()}}
+	if value != nil {
+		if record.Value, err = decodeValue(value); err != nil {
+			return Record{}, err
+		}
+	}
+
+	return record, nil
+}
+
+// contractRecord decodes a store entry keyed by a length prefixed contract address.
+func contractRecord(cdc codec.Codec, recordType string, key, value []byte, msg codec.ProtoMarshaler) (Record, error) {
+	contract, rest, err := lengthPrefixed(key)
+	if err != nil {
+		return Record{}, err
+	}
+	if len(rest) != 0 {
+		return Record{}, fmt.Errorf("unexpected %d trailing key bytes", len(rest))
+	}
+
+	return protoRecord(cdc, recordType, map[string]string{"contract": sdk.AccAddress(contract).String()}, value, msg)
+}
+
+// epochRecord decodes a treasury store entry keyed by a little endian epoch.
+func epochRecord(cdc codec.Codec, recordType string, key, value []byte, msg codec.ProtoMarshaler) (Record, error) {
+	if len(key) != 8 {
+		return Record{}, fmt.Errorf("invalid epoch length %d", len(key))
+	}
+
+	keys := map[string]string{"epoch": strconv.FormatInt(int64(binary.LittleEndian.Uint64(key)), 10)}
+	return protoRecord(cdc, recordType, keys, value, msg)
+}
+
+func unknownRecord(key, value []byte) Record {
+	record := Record{Type: RecordTypeUnknown, Key: map[string]string{"raw": hex.EncodeToString(key)}}
+	if value != nil {
+		// the error is impossible for a string
+		record.Value, _ = json.Marshal(hex.EncodeToString(value))
+	}
+	return record
+}
+
+// lengthPrefixed splits a length prefixed byte slice from the beginning of bz.
+func lengthPrefixed(bz []byte) (prefixed, rest []byte, err error) {
+	if len(bz) == 0 {
+		return nil, nil, fmt.Errorf("missing length prefix")
+	}
+
+	length := int(bz[0])
+	if len(bz) < 1+length {
+		return nil, nil, fmt.Errorf("length prefix %d exceeds %d key bytes", length, len(bz)-1)
+	}
+
+	return bz[1 : 1+length], bz[1+length:], nil
+}
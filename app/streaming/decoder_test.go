@@ -0,0 +1,203 @@
+package streaming_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogotypes "github.com/cosmos/gogoproto/types"
+
+	"github.com/classic-terra/core/v3/app"
+	appstreaming "github.com/classic-terra/core/v3/app/streaming"
+	core "github.com/classic-terra/core/v3/types"
+	dyncommtypes "github.com/classic-terra/core/v3/x/dyncomm/types"
+	markettypes "github.com/classic-terra/core/v3/x/market/types"
+	oracletypes "github.com/classic-terra/core/v3/x/oracle/types"
+	taxtypes "github.com/classic-terra/core/v3/x/tax/types"
+	treasurytypes "github.com/classic-terra/core/v3/x/treasury/types"
+)
+
+func TestDecode(t *testing.T) {
+	cdc := app.MakeEncodingConfig().Marshaler
+	valAddr := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+	accAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	rate := sdk.NewDecWithPrec(15, 1)
+
+	mustMarshal := func(msg codec.ProtoMarshaler) []byte {
+		return cdc.MustMarshal(msg)
+	}
+
+	tests := []struct {
+		name       string
+		module     string
+		key        []byte
+		value      []byte
+		recordType string
+		recordKey  map[string]string
+		json       string
+	}{
+		{
+			name:       "oracle exchange rate",
+			module:     oracletypes.ModuleName,
+			key:        oracletypes.GetExchangeRateKey(core.MicroUSDDenom),
+			value:      mustMarshal(&sdk.DecProto{Dec: rate}),
+			recordType: "exchange_rate",
+			recordKey:  map[string]string{"denom": core.MicroUSDDenom},
+			json:       `{"dec":"1.500000000000000000"}`,
+		},
+		{
+			name:       "oracle feeder delegation",
+			module:     oracletypes.ModuleName,
+			key:        oracletypes.GetFeederDelegationKey(valAddr),
+			value:      accAddr,
+			recordType: "feeder_delegation",
+			recordKey:  map[string]string{"validator": valAddr.String()},
+			json:       `"` + accAddr.String() + `"`,
+		},
+		{
+			name:       "oracle miss counter",
+			module:     oracletypes.ModuleName,
+			key:        oracletypes.GetMissCounterKey(valAddr),
+			value:      mustMarshal(&gogotypes.UInt64Value{Value: 3}),
+			recordType: "miss_counter",
+			recordKey:  map[string]string{"validator": valAddr.String()},
+			json:       `"3"`,
+		},
+		{
+			name:       "oracle aggregate vote deleted",
+			module:     oracletypes.ModuleName,
+			key:        oracletypes.GetAggregateExchangeRateVoteKey(valAddr),
+			recordType: "aggregate_vote",
+			recordKey:  map[string]string{"validator": valAddr.String()},
+		},
+		{
+			name:       "market terra pool delta",
+			module:     markettypes.ModuleName,
+			key:        markettypes.TerraPoolDeltaKey,
+			value:      mustMarshal(&sdk.DecProto{Dec: rate}),
+			recordType: "terra_pool_delta",
+			json:       `{"dec":"1.500000000000000000"}`,
+		},
+		{
+			name:       "treasury tax cap",
+			module:     treasurytypes.ModuleName,
+			key:        treasurytypes.GetTaxCapKey(core.MicroUSDDenom),
+			value:      mustMarshal(&sdk.IntProto{Int: sdk.NewInt(1000)}),
+			recordType: "tax_cap",
+			recordKey:  map[string]string{"denom": core.MicroUSDDenom},
+			json:       `{"int":"1000"}`,
+		},
+		{
+			name:       "treasury tax proceeds",
+			module:     treasurytypes.ModuleName,
+			key:        treasurytypes.TaxProceedsKey,
+			value:      mustMarshal(&treasurytypes.EpochTaxProceeds{TaxProceeds: sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 500))}),
+			recordType: "tax_proceeds",
+			json:       `{"tax_proceeds":[{"denom":"uluna","amount":"500"}]}`,
+		},
+		{
+			name:       "treasury tax reward",
+			module:     treasurytypes.ModuleName,
+			key:        treasurytypes.GetTRKey(7),
+			value:      mustMarshal(&sdk.DecProto{Dec: rate}),
+			recordType: "tax_reward",
+			recordKey:  map[string]string{"epoch": "7"},
+			json:       `{"dec":"1.500000000000000000"}`,
+		},
+		{
+			name:       "treasury burn tax exemption",
+			module:     treasurytypes.ModuleName,
+			key:        append(treasurytypes.BurnTaxExemptionListPrefix, []byte(accAddr.String())...),
+			value:      []byte{0x01},
+			recordType: "burn_tax_exemption",
+			recordKey:  map[string]string{"address": accAddr.String()},
+			json:       `true`,
+		},
+		{
+			name:       "tax gas discount usage",
+			module:     taxtypes.ModuleName,
+			key:        taxtypes.GetGasDiscountUsageKey(accAddr, "/cosmos.bank.v1beta1.MsgSend"),
+			value:      mustMarshal(&taxtypes.GasDiscountUsage{WindowStart: 10, Count: 2}),
+			recordType: "gas_discount_usage",
+			recordKey:  map[string]string{"signer": accAddr.String(), "msg_type_url": "/cosmos.bank.v1beta1.MsgSend"},
+		},
+		{
+			name:       "tax code tax policy",
+			module:     taxtypes.ModuleName,
+			key:        taxtypes.GetCodeTaxPolicyKey(5),
+			value:      mustMarshal(&taxtypes.ContractTaxPolicy{CodeId: 5, Mode: taxtypes.TaxPolicyModeExempt}),
+			recordType: "code_tax_policy",
+			recordKey:  map[string]string{"code_id": "5"},
+		},
+		{
+			name:       "tax contract tax usage",
+			module:     taxtypes.ModuleName,
+			key:        taxtypes.GetContractTaxUsageKey(accAddr),
+			recordType: "contract_tax_usage",
+			recordKey:  map[string]string{"contract": accAddr.String()},
+		},
+		{
+			name:       "dyncomm commission history",
+			module:     dyncommtypes.ModuleName,
+			key:        dyncommtypes.GetCommissionHistoryKey(valAddr.String(), 4),
+			value:      mustMarshal(&dyncommtypes.ValidatorCommissionHistory{ValidatorAddress: valAddr.String(), Epoch: 4, VotingPower: rate, MinCommissionRate: rate, TargetCommissionRate: rate}),
+			recordType: "commission_history",
+			recordKey:  map[string]string{"validator": valAddr.String(), "epoch": "4"},
+		},
+		{
+			name:       "dyncomm dirty validator",
+			module:     dyncommtypes.ModuleName,
+			key:        dyncommtypes.GetDirtyValidatorKey(valAddr),
+			value:      valAddr,
+			recordType: "dirty_validator",
+			recordKey:  map[string]string{"validator": valAddr.String()},
+			json:       `true`,
+		},
+		{
+			name:       "unknown prefix",
+			module:     markettypes.ModuleName,
+			key:        []byte{0xff, 0x01},
+			value:      []byte{0xab},
+			recordType: appstreaming.RecordTypeUnknown,
+			recordKey:  map[string]string{"raw": "ff01"},
+			json:       `"ab"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			record, err := appstreaming.Decode(cdc, tc.module, tc.key, tc.value)
+			require.NoError(t, err)
+			require.Equal(t, tc.module, record.Module)
+			require.Equal(t, tc.recordType, record.Type)
+			require.Equal(t, tc.recordKey, record.Key)
+			require.Equal(t, tc.value == nil, record.Delete)
+			if tc.value == nil {
+				require.Empty(t, record.Value)
+			} else {
+				require.NotEmpty(t, record.Value)
+			}
+			if tc.json != "" {
+				require.JSONEq(t, tc.json, string(record.Value))
+			}
+		})
+	}
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	cdc := app.MakeEncodingConfig().Marshaler
+
+	// length prefix exceeds the key
+	_, err := appstreaming.Decode(cdc, oracletypes.ModuleName, append(oracletypes.MissCounterKey, 20, 1), []byte{})
+	require.Error(t, err)
+
+	// value is not a DecProto
+	_, err = appstreaming.Decode(cdc, markettypes.ModuleName, markettypes.TerraPoolDeltaKey, []byte{0xff})
+	require.Error(t, err)
+
+	_, err = appstreaming.Decode(cdc, "bank", []byte{0x01}, nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,204 @@
+package streaming
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ baseapp.StreamingService = (*Service)(nil)
+
+// Service is a streaming service writing the decoded state changes of the
+// configured modules of every committed block into a JSON Lines file.
+type Service struct {
+	cfg       Config
+	dir       string
+	cdc       codec.Codec
+	logger    log.Logger
+	modules   map[string]string
+	listeners map[storetypes.StoreKey]*storetypes.MemoryListener
+}
+
+// NewService creates the streaming service for the modules of the config, keys
+// are the kv store keys of the app indexed by module name.
+func NewService(
+	cfg Config,
+	homePath string,
+	keys map[string]*storetypes.KVStoreKey,
+	cdc codec.Codec,
+	logger log.Logger,
+) (*Service, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	dir := cfg.WriteDir
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(homePath, dir)
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create state streaming dir: %w", err)
+	}
+
+	s := &Service{
+		cfg:       cfg,
+		dir:       dir,
+		cdc:       cdc,
+		logger:    logger.With("module", "state-streaming"),
+		modules:   make(map[string]string, len(cfg.Modules)),
+		listeners: make(map[storetypes.StoreKey]*storetypes.MemoryListener, len(cfg.Modules)),
+	}
+
+	for _, module := range cfg.Modules {
+		key, ok := keys[module]
+		if !ok {
+			return nil, fmt.Errorf("no store key for module %s", module)
+		}
+
+		s.modules[key.Name()] = module
+		s.listeners[key] = storetypes.NewMemoryListener(key)
+	}
+
+	return s, nil
+}
+
+// Stream implements baseapp.StreamingService, the records are written on commit.
+func (s *Service) Stream(*sync.WaitGroup) error {
+	return nil
+}
+
+// Listeners implements baseapp.StreamingService.
+func (s *Service) Listeners() map[storetypes.StoreKey][]storetypes.WriteListener {
+	listeners := make(map[storetypes.StoreKey][]storetypes.WriteListener, len(s.listeners))
+	for key, listener := range s.listeners {
+		listeners[key] = []storetypes.WriteListener{listener}
+	}
+	return listeners
+}
+
+// ListenBeginBlock implements baseapp.ABCIListener.
+func (s *Service) ListenBeginBlock(context.Context, abci.RequestBeginBlock, abci.ResponseBeginBlock) error {
+	return nil
+}
+
+// ListenEndBlock implements baseapp.ABCIListener.
+func (s *Service) ListenEndBlock(context.Context, abci.RequestEndBlock, abci.ResponseEndBlock) error {
+	return nil
+}
+
+// ListenDeliverTx implements baseapp.ABCIListener.
+func (s *Service) ListenDeliverTx(context.Context, abci.RequestDeliverTx, abci.ResponseDeliverTx) error {
+	return nil
+}
+
+// ListenCommit implements baseapp.ABCIListener, it writes the state changes
+// of the committed block. The node only halts on a failed write if
+// StopNodeOnError is set.
+func (s *Service) ListenCommit(ctx context.Context, _ abci.ResponseCommit) error {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+
+	err := s.writeBlock(height, s.popRecords(height))
+	if err != nil {
+		if s.cfg.StopNodeOnError {
+			return err
+		}
+		s.logger.Error("failed to write state changes", "height", height, "err", err)
+	}
+
+	return nil
+}
+
+// Close implements io.Closer.
+func (s *Service) Close() error {
+	return nil
+}
+
+// popRecords drains the listeners and decodes the state changes in the order
+// of the configured modules. Changes which can not be decoded are logged and
+// kept as unknown records.
+func (s *Service) popRecords(height int64) []Record {
+	pairs := make(map[string][]storetypes.StoreKVPair, len(s.listeners))
+	for key, listener := range s.listeners {
+		pairs[s.modules[key.Name()]] = listener.PopStateCache()
+	}
+
+	var records []Record
+	for _, module := range s.cfg.Modules {
+		for _, pair := range pairs[module] {
+			value := pair.Value
+			if pair.Delete {
+				value = nil
+			}
+
+			record, err := Decode(s.cdc, module, pair.Key, value)
+			if err != nil {
+				s.logger.Error("failed to decode state change", "module", module, "err", err)
+				record = unknownRecord(pair.Key, value)
+				record.Module = module
+				record.Delete = pair.Delete
+			}
+			record.Height = height
+
+			records = append(records, record)
+		}
+	}
+
+	return records
+}
+
+// writeBlock writes the records of a block into a temporary file which is
+// renamed once complete, so readers never see a partially written block.
+func (s *Service) writeBlock(height int64, records []Record) (err error) {
+	path := s.BlockFile(height)
+	tmp := path + ".tmp"
+
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = f.Close()
+			_ = os.Remove(tmp)
+		}
+	}()
+
+	w := bufio.NewWriter(f)
+	enc := json.NewEncoder(w)
+	for _, record := range records {
+		if err := enc.Encode(record); err != nil {
+			return err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if s.cfg.Fsync {
+		if err := f.Sync(); err != nil {
+			return err
+		}
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, path)
+}
+
+// BlockFile returns the path of the output file of the block at height.
+func (s *Service) BlockFile(height int64) string {
+	return filepath.Join(s.dir, fmt.Sprintf("%sblock-%d.jsonl", s.cfg.Prefix, height))
+}
@@ -0,0 +1,137 @@
+package streaming_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/classic-terra/core/v3/app"
+	appstreaming "github.com/classic-terra/core/v3/app/streaming"
+	apptesting "github.com/classic-terra/core/v3/app/testing"
+	core "github.com/classic-terra/core/v3/types"
+	oracletypes "github.com/classic-terra/core/v3/x/oracle/types"
+	treasurytypes "github.com/classic-terra/core/v3/x/treasury/types"
+)
+
+const chainID = "streaming-test"
+
+func TestServiceWritesCommittedBlock(t *testing.T) {
+	terraApp := apptesting.SetupApp(t, chainID)
+	// the listeners only observe blocks started after they are added
+	terraApp.EndBlock(abci.RequestEndBlock{Height: terraApp.LastBlockHeight() + 1})
+	terraApp.Commit()
+
+	cfg := appstreaming.DefaultConfig()
+	cfg.Enable = true
+	cfg.Modules = []string{oracletypes.ModuleName, treasurytypes.ModuleName}
+	cfg.WriteDir = "streaming"
+	cfg.Prefix = "terra-"
+
+	service, err := appstreaming.NewService(cfg, t.TempDir(), terraApp.GetKVStoreKey(), terraApp.AppCodec(), log.NewNopLogger())
+	require.NoError(t, err)
+	terraApp.SetStreamingService(service)
+
+	height := commitBlock(terraApp, func(ctx sdk.Context) {
+		terraApp.OracleKeeper.SetLunaExchangeRate(ctx, core.MicroUSDDenom, sdk.NewDec(7))
+		terraApp.OracleKeeper.DeleteLunaExchangeRate(ctx, core.MicroKRWDenom)
+		terraApp.TreasuryKeeper.SetTaxCap(ctx, core.MicroUSDDenom, sdk.NewInt(1000))
+		terraApp.MarketKeeper.SetTerraPoolDelta(ctx, sdk.NewDec(1000))
+	})
+
+	records := readRecords(t, service.BlockFile(height))
+	require.NotEmpty(t, records)
+
+	var oracleRecords, treasuryRecords []appstreaming.Record
+	for _, record := range records {
+		require.Equal(t, height, record.Height)
+		require.NotEqual(t, appstreaming.RecordTypeUnknown, record.Type)
+
+		switch record.Module {
+		case oracletypes.ModuleName:
+			oracleRecords = append(oracleRecords, record)
+		case treasurytypes.ModuleName:
+			treasuryRecords = append(treasuryRecords, record)
+		default:
+			t.Fatalf("unexpected record of module %s", record.Module)
+		}
+	}
+
+	// oracle records come first in the order of the configured modules
+	require.Equal(t, oracletypes.ModuleName, records[0].Module)
+	require.Contains(t, oracleRecords, appstreaming.Record{
+		Height: height,
+		Module: oracletypes.ModuleName,
+		Type:   "exchange_rate",
+		Key:    map[string]string{"denom": core.MicroUSDDenom},
+		Value:  json.RawMessage(`{"dec":"7.000000000000000000"}`),
+	})
+	require.Contains(t, oracleRecords, appstreaming.Record{
+		Height: height,
+		Module: oracletypes.ModuleName,
+		Type:   "exchange_rate",
+		Key:    map[string]string{"denom": core.MicroKRWDenom},
+		Delete: true,
+	})
+	require.Contains(t, treasuryRecords, appstreaming.Record{
+		Height: height,
+		Module: treasurytypes.ModuleName,
+		Type:   "tax_cap",
+		Key:    map[string]string{"denom": core.MicroUSDDenom},
+		Value:  json.RawMessage(`{"int":"1000"}`),
+	})
+
+	// the listeners are drained on commit
+	height = commitBlock(terraApp, func(sdk.Context) {})
+	for _, record := range readRecords(t, service.BlockFile(height)) {
+		require.Equal(t, height, record.Height)
+		require.NotEqual(t, "tax_cap", record.Type)
+	}
+}
+
+func TestNewServiceInvalidConfig(t *testing.T) {
+	terraApp := apptesting.SetupApp(t, chainID)
+
+	cfg := appstreaming.DefaultConfig()
+	cfg.Modules = []string{"bank"}
+
+	_, err := appstreaming.NewService(cfg, t.TempDir(), terraApp.GetKVStoreKey(), terraApp.AppCodec(), log.NewNopLogger())
+	require.Error(t, err)
+}
+
+// commitBlock applies the state changes in a new block and commits it
+func commitBlock(terraApp *app.TerraApp, change func(ctx sdk.Context)) int64 {
+	header := tmproto.Header{ChainID: chainID, Height: terraApp.LastBlockHeight() + 1}
+	terraApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	change(terraApp.NewContext(false, header))
+	terraApp.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	terraApp.Commit()
+
+	return header.Height
+}
+
+func readRecords(t *testing.T, path string) []appstreaming.Record {
+	t.Helper()
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var records []appstreaming.Record
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var record appstreaming.Record
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+		records = append(records, record)
+	}
+	require.NoError(t, scanner.Err())
+
+	return records
+}
@@ -13,6 +13,7 @@ import (
 	dyncommtypes "github.com/classic-terra/core/v3/x/dyncomm/types"
 	markettypes "github.com/classic-terra/core/v3/x/market/types"
 	oracletypes "github.com/classic-terra/core/v3/x/oracle/types"
+	stargatetypes "github.com/classic-terra/core/v3/x/stargate/types"
 	taxtypes "github.com/classic-terra/core/v3/x/tax/types"
 	treasurytypes "github.com/classic-terra/core/v3/x/treasury/types"
 	dbm "github.com/cometbft/cometbft-db"
@@ -103,6 +104,14 @@ func (EmptyAppOptions) Get(_ string) interface{} { return nil }
 func SetupApp(t *testing.T, chainID string) *app.TerraApp {
 	t.Helper()
 
+	return SetupAppWithGenesis(t, chainID, app.GenesisState{})
+}
+
+// SetupAppWithGenesis is SetupApp starting from the genesis state of the given
+// modules
+func SetupAppWithGenesis(t *testing.T, chainID string, genesisState app.GenesisState) *app.TerraApp {
+	t.Helper()
+
 	privVal := NewPV()
 	pubKey, err := privVal.GetPubKey()
 	require.NoError(t, err)
@@ -118,7 +127,7 @@ func SetupApp(t *testing.T, chainID string) *app.TerraApp {
 		Coins:   sdk.NewCoins(sdk.NewCoin(appparams.BondDenom, sdk.NewInt(100000000000000))),
 	}
 	genesisAccounts := []authtypes.GenesisAccount{acc}
-	app := SetupWithGenesisValSet(t, chainID, valSet, genesisAccounts, balance)
+	app := setupWithGenesisValSet(t, chainID, genesisState, valSet, genesisAccounts, balance)
 
 	return app
 }
@@ -130,7 +139,16 @@ func SetupApp(t *testing.T, chainID string) *app.TerraApp {
 func SetupWithGenesisValSet(t *testing.T, chainID string, valSet *tmtypes.ValidatorSet, genAccs []authtypes.GenesisAccount, balances ...banktypes.Balance) *app.TerraApp {
 	t.Helper()
 
+	return setupWithGenesisValSet(t, chainID, app.GenesisState{}, valSet, genAccs, balances...)
+}
+
+func setupWithGenesisValSet(t *testing.T, chainID string, moduleGenesis app.GenesisState, valSet *tmtypes.ValidatorSet, genAccs []authtypes.GenesisAccount, balances ...banktypes.Balance) *app.TerraApp {
+	t.Helper()
+
 	terraApp, genesisState := setup(chainID)
+	for module, state := range moduleGenesis {
+		genesisState[module] = state
+	}
 	genesisState = genesisStateWithValSet(t, terraApp, genesisState, valSet, genAccs, balances...)
 
 	stateBytes, err := json.MarshalIndent(genesisState, "", "")
@@ -285,6 +303,10 @@ func genesisStateWithValSet(t *testing.T,
 	treasuryGensis := treasurytypes.DefaultGenesisState()
 	genesisState[treasurytypes.ModuleName] = app.AppCodec().MustMarshalJSON(treasuryGensis)
 
+	// update stargate genesis state
+	stargateGenesis := stargatetypes.DefaultGenesisState()
+	genesisState[stargatetypes.ModuleName] = app.AppCodec().MustMarshalJSON(stargateGenesis)
+
 	// update tax genesis state
 	taxGenesis := taxtypes.DefaultGenesisState()
 	taxGenesis.Params.GasPrices = sdk.NewDecCoins(sdk.NewDecCoin(core.MicroSDRDenom, sdk.ZeroInt())) // tests normally rely on zero gas price, so we are setting it here and fall back to the normal ctx.MinGasPrices
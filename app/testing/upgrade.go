@@ -0,0 +1,173 @@
+package helpers
+
+import (
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	"github.com/classic-terra/core/v3/app"
+	"github.com/classic-terra/core/v3/app/upgrades"
+)
+
+// TestingT is the subset of testing.TB used by the upgrade harness
+type TestingT interface {
+	require.TestingT
+	Helper()
+}
+
+// UpgradeFixture describes the exported state of a network an upgrade is tested against.
+// The state is loaded with the InitGenesis of the current binary, so params a
+// migration adds must be present in the exported state.
+type UpgradeFixture struct {
+	// GenesisFile is a genesis or `terrad export` JSON file
+	GenesisFile string
+	// ModuleVersions is the module version map of the binary which exported the state
+	ModuleVersions module.VersionMap
+	// StoreKeys are the KV store keys of the binary which exported the state
+	StoreKeys []string
+}
+
+// RunUpgrade initialises a TerraApp with the state of the fixture at the initial
+// height of its genesis, applies the upgrade in the next block and asserts the
+// invariants of all modules before and after the upgrade. The test fails if the
+// store upgrades miss or add store keys, or the module versions are not migrated.
+// The app and the context of the upgrade block are returned for further checks.
+func RunUpgrade(t TestingT, fixture UpgradeFixture, upgrade upgrades.Upgrade) (*app.TerraApp, sdk.Context) {
+	t.Helper()
+
+	genDoc, err := tmtypes.GenesisDocFromFile(fixture.GenesisFile)
+	require.NoError(t, err)
+
+	terraApp := app.NewTerraApp(
+		log.NewNopLogger(),
+		dbm.NewMemDB(),
+		nil,
+		true,
+		map[int64]bool{},
+		app.DefaultNodeHome,
+		app.MakeEncodingConfig(),
+		simtestutil.EmptyAppOptions{},
+		emptyWasmOpts,
+		baseapp.SetChainID(genDoc.ChainID),
+	)
+
+	// the handler of the upgrade under test replaces the handler registered by the app
+	terraApp.UpgradeKeeper.SetUpgradeHandler(
+		upgrade.UpgradeName,
+		upgrade.CreateUpgradeHandler(terraApp.ModuleManager(), terraApp.Configurator(), terraApp.BaseApp, terraApp.AppKeepers),
+	)
+
+	consensusParams := genDoc.ConsensusParams.ToProto()
+	terraApp.InitChain(abci.RequestInitChain{
+		Time:            genDoc.GenesisTime,
+		ChainId:         genDoc.ChainID,
+		ConsensusParams: &consensusParams,
+		AppStateBytes:   genDoc.AppState,
+		InitialHeight:   genDoc.InitialHeight,
+	})
+
+	// restore the module versions of the exporting binary and schedule the upgrade
+	header := tmproto.Header{ChainID: genDoc.ChainID, Height: genDoc.InitialHeight, Time: genDoc.GenesisTime}
+	terraApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := terraApp.NewContext(false, header)
+
+	setModuleVersionMap(ctx, terraApp, fixture.ModuleVersions)
+	assertInvariants(t, ctx, terraApp)
+
+	plan := upgradetypes.Plan{Name: upgrade.UpgradeName, Height: header.Height + 1}
+	require.NoError(t, terraApp.UpgradeKeeper.ScheduleUpgrade(ctx, plan))
+
+	terraApp.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	terraApp.Commit()
+
+	// the upgrade module applies the upgrade at the begin block of the plan height
+	header = tmproto.Header{ChainID: genDoc.ChainID, Height: plan.Height, Time: genDoc.GenesisTime.Add(5 * time.Second)}
+	require.NotPanics(t, func() {
+		terraApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	}, "upgrade %s failed", upgrade.UpgradeName)
+	ctx = terraApp.NewContext(false, header)
+
+	name, height := terraApp.UpgradeKeeper.GetLastCompletedUpgrade(ctx)
+	require.Equal(t, upgrade.UpgradeName, name)
+	require.Equal(t, plan.Height, height)
+
+	require.Equal(t, terraApp.ModuleManager().GetVersionMap(), terraApp.UpgradeKeeper.GetModuleVersionMap(ctx),
+		"module versions are not migrated by the upgrade")
+	require.ElementsMatch(t, upgradedStoreKeys(fixture.StoreKeys, upgrade.StoreUpgrades), storeKeys(terraApp),
+		"store upgrades do not match the store keys of the app")
+	assertInvariants(t, ctx, terraApp)
+
+	return terraApp, ctx
+}
+
+// setModuleVersionMap replaces the module version map set by InitChain
+func setModuleVersionMap(ctx sdk.Context, terraApp *app.TerraApp, versionMap module.VersionMap) {
+	store := prefix.NewStore(ctx.KVStore(terraApp.GetKey(upgradetypes.StoreKey)), []byte{upgradetypes.VersionMapByte})
+	iterator := store.Iterator(nil, nil)
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	terraApp.UpgradeKeeper.SetModuleVersionMap(ctx, versionMap)
+}
+
+func assertInvariants(t TestingT, ctx sdk.Context, terraApp *app.TerraApp) {
+	t.Helper()
+
+	require.NotPanics(t, func() {
+		terraApp.CrisisKeeper.AssertInvariants(ctx)
+	}, "invariant broken at height %d", ctx.BlockHeight())
+}
+
+// upgradedStoreKeys returns the store keys after applying the store upgrades
+func upgradedStoreKeys(keys []string, storeUpgrades storetypes.StoreUpgrades) []string {
+	upgraded := map[string]bool{}
+	for _, key := range keys {
+		upgraded[key] = true
+	}
+	for _, key := range storeUpgrades.Added {
+		upgraded[key] = true
+	}
+	for _, rename := range storeUpgrades.Renamed {
+		delete(upgraded, rename.OldKey)
+		upgraded[rename.NewKey] = true
+	}
+	for _, key := range storeUpgrades.Deleted {
+		delete(upgraded, key)
+	}
+
+	result := make([]string, 0, len(upgraded))
+	for key := range upgraded {
+		result = append(result, key)
+	}
+
+	return result
+}
+
+func storeKeys(terraApp *app.TerraApp) []string {
+	keys := make([]string, 0, len(terraApp.GetKVStoreKey()))
+	for key := range terraApp.GetKVStoreKey() {
+		keys = append(keys, key)
+	}
+
+	return keys
+}
@@ -7,18 +7,18 @@ import (
 
 var DisableSwapFork = upgrades.Fork{
 	UpgradeName:    "v0.5.20",
-	UpgradeHeight:  fork.SwapDisableHeight,
+	Feature:        fork.MustGetFeature(fork.FeatureSwapDisable),
 	BeginForkLogic: runForkLogicSwapDisable,
 }
 
 var IbcEnableFork = upgrades.Fork{
 	UpgradeName:    "v0.5.23",
-	UpgradeHeight:  fork.IbcEnableHeight,
+	Feature:        fork.MustGetFeature(fork.FeatureIbcEnable),
 	BeginForkLogic: runForkLogicIbcEnable,
 }
 
 var VersionMapEnableFork = upgrades.Fork{
 	UpgradeName:    "v1.0.5",
-	UpgradeHeight:  fork.VersionMapEnableHeight,
+	Feature:        fork.MustGetFeature(fork.FeatureVersionMapEnable),
 	BeginForkLogic: runForkLogicVersionMapEnable,
 }
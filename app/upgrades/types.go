@@ -8,6 +8,7 @@ import (
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
 	"github.com/classic-terra/core/v3/app/keepers"
+	"github.com/classic-terra/core/v3/types/fork"
 )
 
 // BaseAppParamManager defines an interrace that BaseApp is expected to fullfil
@@ -33,15 +34,16 @@ type Upgrade struct {
 }
 
 // Fork defines a struct containing the requisite fields for a non-software upgrade proposal
-// Hard Fork at a given height to implement.
-// There is one time code that can be added for the start of the Fork, in `BeginForkLogic`.
-// Any other change in the code should be height-gated, if the goal is to have old and new binaries
-// to be compatible prior to the upgrade height.
+// Hard Fork activating a registered soft-fork feature.
+// There is one time code that can be added for the start of the Fork, in `BeginForkLogic`,
+// which runs at the activation height of the feature on the chain.
+// Any other change in the code should be gated with `fork.IsFeatureActive`, if the goal is to
+// have old and new binaries to be compatible prior to the upgrade height.
 type Fork struct {
 	// Upgrade version name, for the upgrade handler, e.g. `v7`
 	UpgradeName string
-	// height the upgrade occurs at
-	UpgradeHeight int64
+	// feature activated by the fork, with its activation height per chain-id
+	Feature fork.Feature
 
 	// Function that runs some custom state transition code at the beginning of a fork.
 	BeginForkLogic func(ctx sdk.Context, keppers *keepers.AppKeepers, mm *module.Manager)
@@ -0,0 +1,28 @@
+package v12
+
+import (
+	"github.com/classic-terra/core/v3/app/upgrades"
+	store "github.com/cosmos/cosmos-sdk/store/types"
+	packetforwardtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/types"
+
+	circuitbreakertypes "github.com/classic-terra/core/v3/x/circuitbreaker/types"
+	icaauthtypes "github.com/classic-terra/core/v3/x/icaauth/types"
+	ratelimittypes "github.com/classic-terra/core/v3/x/ratelimit/types"
+	stargatetypes "github.com/classic-terra/core/v3/x/stargate/types"
+)
+
+const UpgradeName = "v12"
+
+var Upgrade = upgrades.Upgrade{
+	UpgradeName:          UpgradeName,
+	CreateUpgradeHandler: CreateV12UpgradeHandler,
+	StoreUpgrades: store.StoreUpgrades{
+		Added: []string{
+			stargatetypes.ModuleName,
+			ratelimittypes.ModuleName,
+			packetforwardtypes.ModuleName,
+			icaauthtypes.ModuleName,
+			circuitbreakertypes.ModuleName,
+		},
+	},
+}
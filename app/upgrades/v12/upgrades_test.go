@@ -0,0 +1,182 @@
+package v12_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	consensusparamtypes "github.com/cosmos/cosmos-sdk/x/consensus/types"
+	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	ibchookstypes "github.com/cosmos/ibc-apps/modules/ibc-hooks/v7/types"
+	icacontrollertypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/controller/types"
+	icahosttypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/host/types"
+	ibcfeetypes "github.com/cosmos/ibc-go/v7/modules/apps/29-fee/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	"github.com/classic-terra/core/v3/app/keepers"
+	apptesting "github.com/classic-terra/core/v3/app/testing"
+	"github.com/classic-terra/core/v3/app/upgrades"
+	v12 "github.com/classic-terra/core/v3/app/upgrades/v12"
+	core "github.com/classic-terra/core/v3/types"
+	circuitbreakertypes "github.com/classic-terra/core/v3/x/circuitbreaker/types"
+	dyncommtypes "github.com/classic-terra/core/v3/x/dyncomm/types"
+	markettypes "github.com/classic-terra/core/v3/x/market/types"
+	oracletypes "github.com/classic-terra/core/v3/x/oracle/types"
+	stargatetypes "github.com/classic-terra/core/v3/x/stargate/types"
+	taxtypes "github.com/classic-terra/core/v3/x/tax/types"
+	treasurytypes "github.com/classic-terra/core/v3/x/treasury/types"
+)
+
+// v11Fixture is the state exported by a v11 network
+var v11Fixture = apptesting.UpgradeFixture{
+	GenesisFile: "testdata/v11_genesis.json",
+	ModuleVersions: module.VersionMap{
+		"auth":               4,
+		"authz":              2,
+		"bank":               4,
+		"capability":         1,
+		"consensus":          1,
+		"crisis":             2,
+		"distribution":       3,
+		"dyncomm":            1,
+		"evidence":           1,
+		"feegrant":           2,
+		"feeibc":             1,
+		"genutil":            1,
+		"gov":                4,
+		"ibc":                4,
+		"ibchooks":           1,
+		"interchainaccounts": 2,
+		"market":             1,
+		"mint":               2,
+		"oracle":             1,
+		"params":             1,
+		"slashing":           3,
+		"staking":            4,
+		"tax":                1,
+		"transfer":           3,
+		"treasury":           3,
+		"upgrade":            2,
+		"vesting":            1,
+		"wasm":               4,
+	},
+	StoreKeys: []string{
+		crisistypes.StoreKey,
+		authtypes.StoreKey,
+		banktypes.StoreKey,
+		stakingtypes.StoreKey,
+		minttypes.StoreKey,
+		distrtypes.StoreKey,
+		slashingtypes.StoreKey,
+		govtypes.StoreKey,
+		paramstypes.StoreKey,
+		consensusparamtypes.StoreKey,
+		upgradetypes.StoreKey,
+		feegrant.StoreKey,
+		evidencetypes.StoreKey,
+		capabilitytypes.StoreKey,
+		authzkeeper.StoreKey,
+		ibcexported.StoreKey,
+		ibctransfertypes.StoreKey,
+		ibcfeetypes.StoreKey,
+		icacontrollertypes.StoreKey,
+		icahosttypes.StoreKey,
+		ibchookstypes.StoreKey,
+		oracletypes.StoreKey,
+		markettypes.StoreKey,
+		treasurytypes.StoreKey,
+		wasmtypes.StoreKey,
+		dyncommtypes.StoreKey,
+		taxtypes.StoreKey,
+	},
+}
+
+func TestUpgrade(t *testing.T) {
+	terraApp, ctx := apptesting.RunUpgrade(t, v11Fixture, v12.Upgrade)
+
+	// the new modules are initialised with their default genesis
+	require.Len(t, terraApp.StargateKeeper.GetWhitelistedQueries(ctx), len(stargatetypes.DefaultGenesisState().WhitelistedQueries))
+	require.Equal(t, circuitbreakertypes.DefaultParams(), terraApp.CircuitBreakerKeeper.GetParams(ctx))
+}
+
+func TestUpgradeStoreKeys(t *testing.T) {
+	missing := v12.Upgrade
+	missing.StoreUpgrades = storetypes.StoreUpgrades{Added: v12.Upgrade.StoreUpgrades.Added[1:]}
+	require.Contains(t, runFailingUpgrade(t, missing), "store upgrades do not match")
+
+	extra := v12.Upgrade
+	extra.StoreUpgrades = storetypes.StoreUpgrades{Added: append([]string{"extra"}, v12.Upgrade.StoreUpgrades.Added...)}
+	require.Contains(t, runFailingUpgrade(t, extra), "store upgrades do not match")
+}
+
+func TestUpgradeBrokenBalances(t *testing.T) {
+	broken := v12.Upgrade
+	broken.CreateUpgradeHandler = func(mm *module.Manager, cfg module.Configurator, bpm upgrades.BaseAppParamManager, keepers *keepers.AppKeepers) upgradetypes.UpgradeHandler {
+		handler := v12.CreateV12UpgradeHandler(mm, cfg, bpm, keepers)
+		return func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			// move bonded tokens out of the bonded pool without unbonding them
+			recipient := sdk.AccAddress([]byte("broken-balance-addr-"))
+			coins := sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 1))
+			if err := keepers.BankKeeper.SendCoinsFromModuleToAccount(ctx, stakingtypes.BondedPoolName, recipient, coins); err != nil {
+				return nil, err
+			}
+
+			return handler(ctx, plan, fromVM)
+		}
+	}
+
+	require.Contains(t, runFailingUpgrade(t, broken), "invariant broken")
+}
+
+// failureRecorder records the failures of the upgrade harness
+type failureRecorder struct {
+	failures string
+}
+
+func (r *failureRecorder) Errorf(format string, args ...interface{}) {
+	r.failures += fmt.Sprintf(format, args...)
+}
+
+func (r *failureRecorder) FailNow() {
+	panic(r)
+}
+
+func (*failureRecorder) Helper() {}
+
+// runFailingUpgrade runs the upgrade harness and returns its failures
+func runFailingUpgrade(t *testing.T, upgrade upgrades.Upgrade) string {
+	t.Helper()
+
+	recorder := &failureRecorder{}
+	func() {
+		defer func() {
+			if r := recover(); r != nil && r != recorder {
+				panic(r)
+			}
+		}()
+		apptesting.RunUpgrade(recorder, v11Fixture, upgrade)
+	}()
+
+	require.NotEmpty(t, recorder.failures, "upgrade %s did not fail", upgrade.UpgradeName)
+	return recorder.failures
+}
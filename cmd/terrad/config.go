@@ -4,6 +4,8 @@ import (
 	"fmt"
 	//	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	appmempool "github.com/classic-terra/core/v3/app/mempool"
+	appstreaming "github.com/classic-terra/core/v3/app/streaming"
 	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
 )
 
@@ -18,7 +20,10 @@ const (
 // TerraAppConfig terra specify app config
 type TerraAppConfig struct {
 	serverconfig.Config
-	Wasm wasmtypes.WasmConfig `mapstructure:"wasm"`
+	Wasm           wasmtypes.WasmConfig         `mapstructure:"wasm"`
+	LaneMempool    appmempool.LaneMempoolConfig `mapstructure:"lane-mempool"`
+	FifoMempool    appmempool.FifoMempoolConfig `mapstructure:"fifo-mempool"`
+	StateStreaming appstreaming.Config          `mapstructure:"state-streaming"`
 }
 
 // ConfigTemplate toml snippet for app.toml
@@ -79,11 +84,15 @@ func initAppConfig() (string, interface{}) {
 	srvCfg.MinGasPrices = "0uluna"
 
 	terraAppConfig := TerraAppConfig{
-		Config: *srvCfg,
-		Wasm:   wasmtypes.DefaultWasmConfig(),
+		Config:         *srvCfg,
+		Wasm:           wasmtypes.DefaultWasmConfig(),
+		LaneMempool:    appmempool.DefaultLaneMempoolConfig(),
+		FifoMempool:    appmempool.DefaultFifoMempoolConfig(),
+		StateStreaming: appstreaming.DefaultConfig(),
 	}
 
-	terraAppTemplate := serverconfig.DefaultConfigTemplate + DefaultWasmConfigTemplate()
+	terraAppTemplate := serverconfig.DefaultConfigTemplate + DefaultWasmConfigTemplate() + appmempool.DefaultConfigTemplate() + appmempool.DefaultFifoConfigTemplate() +
+		appstreaming.DefaultConfigTemplate()
 
 	return terraAppTemplate, terraAppConfig
 }
@@ -1,9 +1,14 @@
 package main
 
 import (
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
+
+	appmempool "github.com/classic-terra/core/v3/app/mempool"
 )
 
 func TestOverrideConfigCacheSize(t *testing.T) {
@@ -13,3 +18,42 @@ func TestOverrideConfigCacheSize(t *testing.T) {
 	require.Equal(t, terraCfg.Config.IAVLCacheSize, uint64(DefaultIAVLCacheSize))
 	require.Equal(t, terraCfg.Config.IAVLDisableFastNode, IavlDisablefastNodeDefault)
 }
+
+func TestLaneMempoolConfig(t *testing.T) {
+	_, cfg := initAppConfig()
+	terraCfg, ok := cfg.(TerraAppConfig)
+	require.True(t, ok)
+	require.False(t, terraCfg.LaneMempool.Enable)
+
+	lanes, err := terraCfg.LaneMempool.Lanes()
+	require.NoError(t, err)
+	require.Len(t, lanes, 4)
+}
+
+func TestLaneMempoolConfigTemplate(t *testing.T) {
+	laneCfg := appmempool.DefaultLaneMempoolConfig()
+	laneCfg.MaxTxsPerSender = 10
+	laneCfg.TTLBlocks = 20
+	laneCfg.TTLDuration = time.Minute
+
+	// the lane mempool limits are read from its own section
+	v := viper.New()
+	v.SetConfigType("toml")
+	require.NoError(t, v.ReadConfig(strings.NewReader(appmempool.ConfigTemplate(laneCfg)+appmempool.DefaultFifoConfigTemplate())))
+
+	readCfg, err := appmempool.ReadLaneMempoolConfig(v)
+	require.NoError(t, err)
+	require.Equal(t, laneCfg, readCfg)
+
+	fifoCfg, err := appmempool.ReadFifoMempoolConfig(v)
+	require.NoError(t, err)
+	require.Equal(t, appmempool.DefaultFifoMempoolConfig(), fifoCfg)
+}
+
+func TestStateStreamingConfig(t *testing.T) {
+	_, cfg := initAppConfig()
+	terraCfg, ok := cfg.(TerraAppConfig)
+	require.True(t, ok)
+	require.False(t, terraCfg.StateStreaming.Enable)
+	require.NoError(t, terraCfg.StateStreaming.Validate())
+}
@@ -39,9 +39,11 @@ import (
 
 	terraapp "github.com/classic-terra/core/v3/app"
 	terralegacy "github.com/classic-terra/core/v3/app/legacy"
+	appmempoolcli "github.com/classic-terra/core/v3/app/mempool/client/cli"
 	"github.com/classic-terra/core/v3/app/params"
 	authcustomcli "github.com/classic-terra/core/v3/custom/auth/client/cli"
 	core "github.com/classic-terra/core/v3/types"
+	forkcli "github.com/classic-terra/core/v3/types/fork/client/cli"
 
 	"github.com/CosmWasm/wasmd/x/wasm"
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
@@ -138,10 +140,12 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 	)
 
 	server.AddCommands(rootCmd, terraapp.DefaultNodeHome, a.newApp, a.appExport, addModuleInitFlags)
+	addExportFlags(rootCmd)
 
 	// add keybase, auxiliary RPC, query, and tx child commands
 	rootCmd.AddCommand(
 		rpc.StatusCommand(),
+		appmempoolcli.GetMempoolCmd(),
 		queryCommand(),
 		txCommand(),
 		keys.Commands(terraapp.DefaultNodeHome),
@@ -153,6 +157,15 @@ func addModuleInitFlags(startCmd *cobra.Command) {
 	wasm.AddModuleInitFlags(startCmd)
 }
 
+func addExportFlags(rootCmd *cobra.Command) {
+	for _, cmd := range rootCmd.Commands() {
+		if cmd.Name() == "export" {
+			cmd.Flags().Bool(terraapp.FlagPreserveCustomState, false,
+				"Keep the oracle, market, treasury, tax and dyncomm state on a zero height export")
+		}
+	}
+}
+
 func queryCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:                        "query",
@@ -170,6 +183,7 @@ func queryCommand() *cobra.Command {
 		authcmd.QueryTxsByEventsCmd(),
 		authcmd.QueryTxCmd(),
 		authcustomcli.GetTxFeesEstimateCommand(),
+		forkcli.GetQueryCmd(),
 	)
 
 	terraapp.ModuleBasics.AddQueryCommands(cmd)
@@ -308,5 +322,6 @@ func (a appCreator) appExport(
 		terraApp = terraapp.NewTerraApp(logger, db, traceStore, true, map[int64]bool{}, homePath, a.encodingConfig, appOpts, wasmOpts)
 	}
 
-	return terraApp.ExportAppStateAndValidators(forZeroHeight, jailAllowedAddrs, modulesToExport)
+	preserveCustomState := cast.ToBool(appOpts.Get(terraapp.FlagPreserveCustomState))
+	return terraApp.ExportAppStateAndValidatorsWithOptions(forZeroHeight, preserveCustomState, jailAllowedAddrs, modulesToExport)
 }
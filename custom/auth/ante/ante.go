@@ -10,6 +10,8 @@ import (
 	distributionkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 
+	circuitbreakerante "github.com/classic-terra/core/v3/x/circuitbreaker/ante"
+	circuitbreakerkeeper "github.com/classic-terra/core/v3/x/circuitbreaker/keeper"
 	dyncommante "github.com/classic-terra/core/v3/x/dyncomm/ante"
 	dyncommkeeper "github.com/classic-terra/core/v3/x/dyncomm/keeper"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -43,6 +45,7 @@ type HandlerOptions struct {
 	DyncommKeeper          dyncommkeeper.Keeper
 	StakingKeeper          *stakingkeeper.Keeper
 	TaxKeeper              *taxkeeper.Keeper
+	CircuitBreakerKeeper   circuitbreakerkeeper.Keeper
 	Cdc                    codec.BinaryCodec
 }
 
@@ -88,6 +91,8 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		wasmkeeper.NewCountTXDecorator(options.TXCounterStoreKey),
 		ante.NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
 		ante.NewValidateBasicDecorator(),
+		// CircuitBreakerDecorator rejects the messages of tripped breakers
+		circuitbreakerante.NewCircuitBreakerDecorator(options.CircuitBreakerKeeper),
 		ante.NewTxTimeoutHeightDecorator(),
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
 		// SpammingPreventionDecorator prevents spamming oracle vote tx attempts at same height
@@ -47,4 +47,5 @@ type GovKeeper interface {
 
 type TaxKeeper interface {
 	GetBurnTaxRate(ctx sdk.Context) sdk.Dec
+	IsSenderPaysUpfrontContract(ctx sdk.Context, contract sdk.AccAddress) bool
 }
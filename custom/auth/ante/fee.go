@@ -70,6 +70,10 @@ func (fd FeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, nex
 			return ctx, err
 		}
 
+		if !helper.IsOracleTx(msgs) {
+			fd.taxKeeper.RecordGasDiscountUsage(ctx, msgs)
+		}
+
 		if !refundNonTaxableTax {
 			nonTaxableTaxes = sdk.Coins{}
 		}
@@ -86,7 +90,15 @@ func (fd FeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, nex
 		return newCtx, err
 	}
 
-	newCtx = newCtx.WithPriority(priority).WithValue(taxtypes.ContextKeyTaxReverseCharge, reverseCharge)
+	// taxes are only prepaid if they are paid with the fee
+	prepaid := taxtypes.NewPrepaidTax()
+	if !reverseCharge {
+		prepaid = ComputePrepaidTax(ctx, fd.treasuryKeeper, fd.taxKeeper, simulate, msgs...)
+	}
+
+	newCtx = newCtx.WithPriority(priority).
+		WithValue(taxtypes.ContextKeyTaxReverseCharge, reverseCharge).
+		WithValue(taxtypes.ContextKeyTaxPrepaid, prepaid)
 
 	return next(newCtx, tx, simulate)
 }
@@ -173,6 +185,19 @@ func (fd FeeDecorator) checkDeductFee(ctx sdk.Context, feeTx sdk.FeeTx, taxes sd
 		),
 	}
 
+	// fees paid in whitelisted non-native denoms are forwarded to the fee collector
+	// as they are, the conversion is only recorded for transparency
+	for _, coin := range fee {
+		if _, found := fd.taxKeeper.GetFeeDenom(ctx, coin.Denom); found {
+			events = append(events, sdk.NewEvent(
+				taxtypes.EventTypeFeeConversion,
+				sdk.NewAttribute(taxtypes.AttributeKeyFeeAmount, fee.String()),
+				sdk.NewAttribute(taxtypes.AttributeKeyNativeFeeAmount, fd.taxKeeper.ConvertFeeToNative(ctx, fee).String()),
+			))
+			break
+		}
+	}
+
 	if !feesOrTax.IsZero() {
 		// we will only deduct the fees from the account, not the tax
 		// the tax will be deducted in the message route for reverse charge
@@ -225,6 +250,7 @@ func DeductFees(bankKeeper types.BankKeeper, ctx sdk.Context, acc types.AccountI
 // checkTxFee implements the default fee logic, where the minimum price per
 // unit of gas is fixed and set by each validator, can the tx priority is computed from the gas price.
 // Transaction with only oracle messages will skip gas fee check and will have the most priority.
+// Other transactions get their gas prices scaled by the governance managed gas discounts.
 // It also checks enough fee for treasury tax
 func (fd FeeDecorator) checkTxFee(ctx sdk.Context, tx sdk.Tx, taxes sdk.Coins, nonTaxableTaxes sdk.Coins) (int64, bool, bool, error) {
 	feeTx, ok := tx.(sdk.FeeTx)
@@ -244,6 +270,10 @@ func (fd FeeDecorator) checkTxFee(ctx sdk.Context, tx sdk.Tx, taxes sdk.Coins, n
 	if !isOracleTx {
 		minRequiredGasFees := sdk.Coins{}
 		minGasPrices := fd.taxKeeper.GetEffectiveGasPrices(ctx)
+		if multiplier := fd.taxKeeper.GetGasPriceMultiplier(ctx, msgs); !multiplier.Equal(sdk.OneDec()) {
+			minGasPrices = minGasPrices.MulDec(multiplier)
+		}
+
 		if !minGasPrices.IsZero() {
 			// Determine the required fees by multiplying each required minimum gas
 			// price by the gas limit, where fee = ceil(minGasPrice * gasLimit).
@@ -266,7 +296,7 @@ func (fd FeeDecorator) checkTxFee(ctx sdk.Context, tx sdk.Tx, taxes sdk.Coins, n
 				remainingFees = remainingFees.Sub(taxes...)
 
 				// Check if remaining fees cover gas after taxes
-				if !minRequiredGasFees.IsZero() && !remainingFees.IsAnyGTE(minRequiredGasFees) {
+				if !fd.coversGasFees(ctx, remainingFees, minRequiredGasFees) {
 					// If the remaining fees do not cover the gas fees, tax cannot be covered
 					// So fall back to reverse charge
 					reverseCharge = true
@@ -280,7 +310,7 @@ func (fd FeeDecorator) checkTxFee(ctx sdk.Context, tx sdk.Tx, taxes sdk.Coins, n
 			feeCoinsAfterTax := remainingFees.Sub(nonTaxableTaxes...)
 
 			// Check if remaining fees cover gas after non-taxable taxes
-			if !minRequiredGasFees.IsZero() && !feeCoinsAfterTax.IsAnyGTE(minRequiredGasFees) {
+			if !fd.coversGasFees(ctx, feeCoinsAfterTax, minRequiredGasFees) {
 				// If the remaining fees do not cover the gas fees, non-taxable taxes cannot be refunded
 				// We cannot reset to feeCoins as tax might have been deducted earlier
 				refundNonTaxableTaxes = false
@@ -291,7 +321,7 @@ func (fd FeeDecorator) checkTxFee(ctx sdk.Context, tx sdk.Tx, taxes sdk.Coins, n
 		}
 
 		// Check if the remaining paid fees are enough to cover the gas fees
-		if !minRequiredGasFees.IsZero() && !remainingFees.IsAnyGTE(minRequiredGasFees) {
+		if !fd.coversGasFees(ctx, remainingFees, minRequiredGasFees) {
 			return 0, reverseCharge, refundNonTaxableTaxes, errorsmod.Wrapf(
 				sdkerrors.ErrInsufficientFee,
 				"insufficient fees; got: %q, required: %q(gas) [+ %q(tax)]",
@@ -303,12 +333,22 @@ func (fd FeeDecorator) checkTxFee(ctx sdk.Context, tx sdk.Tx, taxes sdk.Coins, n
 	priority := int64(math.MaxInt64)
 
 	if !isOracleTx {
-		priority = getTxPriority(feeCoins, int64(gas))
+		priority = getTxPriority(fd.taxKeeper.ConvertFeeToNative(ctx, feeCoins), int64(gas))
 	}
 
 	return priority, reverseCharge, refundNonTaxableTaxes, nil
 }
 
+// coversGasFees checks whether the fees cover the required gas fees. Fee denoms
+// whitelisted in the tax params are valued by their native equivalent.
+func (fd FeeDecorator) coversGasFees(ctx sdk.Context, fees sdk.Coins, minRequiredGasFees sdk.Coins) bool {
+	if minRequiredGasFees.IsZero() {
+		return true
+	}
+
+	return fd.taxKeeper.ConvertFeeToNative(ctx, fees).IsAnyGTE(minRequiredGasFees)
+}
+
 // getTxPriority returns a naive tx priority based on the amount of the smallest denomination of the gas price
 // provided in a transaction.
 // NOTE: This implementation should be used with a great consideration as it opens potential attack vectors
@@ -10,6 +10,8 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	marketexported "github.com/classic-terra/core/v3/x/market/exported"
+	taxtypes "github.com/classic-terra/core/v3/x/tax/types"
+	vestingtypes "github.com/classic-terra/core/v3/x/vesting/types"
 )
 
 var IBCRegexp = regexp.MustCompile("^ibc/[a-fA-F0-9]{64}$")
@@ -51,6 +53,11 @@ func FilterMsgAndComputeTax(ctx sdk.Context, tk TreasuryKeeper, th TaxKeeper, si
 				}
 			}
 
+		case *vestingtypes.MsgCreateLazyGradedVestingAccount:
+			if !tk.HasBurnTaxExemptionAddress(ctx, msg.FromAddress, msg.ToAddress) {
+				taxes = taxes.Add(computeTax(ctx, tk, th, msg.Amount, simulate)...)
+			}
+
 		case *marketexported.MsgSwapSend:
 			taxes = taxes.Add(computeTax(ctx, tk, th, sdk.NewCoins(msg.OfferCoin), simulate)...)
 
@@ -64,7 +71,13 @@ func FilterMsgAndComputeTax(ctx sdk.Context, tk TreasuryKeeper, th TaxKeeper, si
 
 		case *wasmtypes.MsgExecuteContract:
 			if !tk.HasBurnTaxExemptionContract(ctx, msg.Contract) {
-				nonTaxableTaxes = nonTaxableTaxes.Add(computeTax(ctx, tk, th, msg.Funds, simulate)...)
+				// the sender of a sender pays upfront contract prepays the tax
+				// of the messages the contract dispatches with the funds
+				if isSenderPaysUpfrontContract(ctx, th, msg.Contract) {
+					taxes = taxes.Add(computeTax(ctx, tk, th, msg.Funds, simulate)...)
+				} else {
+					nonTaxableTaxes = nonTaxableTaxes.Add(computeTax(ctx, tk, th, msg.Funds, simulate)...)
+				}
 			}
 		case *authz.MsgExec:
 			messages, err := msg.GetMessages()
@@ -79,6 +92,36 @@ func FilterMsgAndComputeTax(ctx sdk.Context, tk TreasuryKeeper, th TaxKeeper, si
 	return taxes, nonTaxableTaxes
 }
 
+// ComputePrepaidTax returns the taxes prepaid with the fee for the funds sent
+// to sender pays upfront contracts, they cover the taxes of the messages the
+// contracts dispatch.
+func ComputePrepaidTax(ctx sdk.Context, tk TreasuryKeeper, th TaxKeeper, simulate bool, msgs ...sdk.Msg) *taxtypes.PrepaidTax {
+	prepaid := taxtypes.NewPrepaidTax()
+	addPrepaidTax(ctx, tk, th, simulate, prepaid, msgs...)
+	return prepaid
+}
+
+func addPrepaidTax(ctx sdk.Context, tk TreasuryKeeper, th TaxKeeper, simulate bool, prepaid *taxtypes.PrepaidTax, msgs ...sdk.Msg) {
+	for _, msg := range msgs {
+		switch msg := msg.(type) {
+		case *wasmtypes.MsgExecuteContract:
+			if !tk.HasBurnTaxExemptionContract(ctx, msg.Contract) && isSenderPaysUpfrontContract(ctx, th, msg.Contract) {
+				prepaid.Add(msg.Contract, computeTax(ctx, tk, th, msg.Funds, simulate))
+			}
+		case *authz.MsgExec:
+			messages, err := msg.GetMessages()
+			if err == nil {
+				addPrepaidTax(ctx, tk, th, simulate, prepaid, messages...)
+			}
+		}
+	}
+}
+
+func isSenderPaysUpfrontContract(ctx sdk.Context, th TaxKeeper, contract string) bool {
+	addr, err := sdk.AccAddressFromBech32(contract)
+	return err == nil && th.IsSenderPaysUpfrontContract(ctx, addr)
+}
+
 // computes the stability tax according to tax-rate and tax-cap
 func computeTax(ctx sdk.Context, tk TreasuryKeeper, th TaxKeeper, principal sdk.Coins, simulate bool) sdk.Coins {
 	taxRate := th.GetBurnTaxRate(ctx)
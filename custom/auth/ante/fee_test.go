@@ -23,6 +23,7 @@ import (
 	oracletypes "github.com/classic-terra/core/v3/x/oracle/types"
 	"github.com/classic-terra/core/v3/x/tax/post"
 	taxtypes "github.com/classic-terra/core/v3/x/tax/types"
+	vestingtypes "github.com/classic-terra/core/v3/x/vesting/types"
 )
 
 func (s *AnteTestSuite) TestDeductFeeDecorator_ZeroGas() {
@@ -121,6 +122,162 @@ func (s *AnteTestSuite) TestEnsureMempoolFees() {
 	s.Require().Equal(int64(10), newCtx.Priority())
 }
 
+func (s *AnteTestSuite) TestEnsureMempoolFeesWithFeeDenom() {
+	s.SetupTest(true) // setup
+	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+
+	mfd := ante.NewFeeDecorator(s.app.AccountKeeper, s.app.BankKeeper, s.app.FeeGrantKeeper, s.app.TreasuryKeeper, s.app.DistrKeeper, s.app.TaxKeeper)
+	antehandler := sdk.ChainAnteDecorators(mfd)
+
+	ibcDenom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	coins := sdk.NewCoins(sdk.NewCoin(ibcDenom, sdk.NewInt(1000)))
+	testutil.FundAccount(s.app.BankKeeper, s.ctx, addr1, coins)
+
+	// msg and signatures
+	msg := testdata.NewTestMsg(addr1)
+	feeAmount := sdk.NewCoins(sdk.NewCoin(ibcDenom, sdk.NewInt(100)))
+	gasLimit := uint64(100)
+	s.Require().NoError(s.txBuilder.SetMsgs(msg))
+	s.txBuilder.SetFeeAmount(feeAmount)
+	s.txBuilder.SetGasLimit(gasLimit)
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx, err := s.CreateTestTx(privs, accNums, accSeqs, s.ctx.ChainID())
+	s.Require().NoError(err)
+
+	// 100 gas at 2uluna requires 200uluna
+	s.ctx = s.ctx.WithIsCheckTx(true).WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoinFromDec(core.MicroLunaDenom, sdk.NewDec(2))))
+
+	// the ibc denom is not whitelisted yet
+	cacheCtx, _ := s.ctx.CacheContext()
+	_, err = antehandler(cacheCtx, tx, false)
+	s.Require().Error(err)
+
+	// governance set conversion rate: 1 ibc = 1.5uluna, 100 ibc = 150uluna
+	taxParams := s.app.TaxKeeper.GetParams(s.ctx)
+	taxParams.FeeDenoms = []taxtypes.FeeDenom{taxtypes.NewFeeDenom(ibcDenom, core.MicroLunaDenom, sdk.NewDecWithPrec(15, 1))}
+	s.Require().NoError(s.app.TaxKeeper.SetParams(s.ctx, taxParams))
+
+	cacheCtx, _ = s.ctx.CacheContext()
+	_, err = antehandler(cacheCtx, tx, false)
+	s.Require().Error(err)
+
+	// 1 ibc = 3uluna, 100 ibc = 300uluna
+	taxParams.FeeDenoms = []taxtypes.FeeDenom{taxtypes.NewFeeDenom(ibcDenom, core.MicroLunaDenom, sdk.NewDec(3))}
+	s.Require().NoError(s.app.TaxKeeper.SetParams(s.ctx, taxParams))
+
+	cacheCtx, _ = s.ctx.CacheContext()
+	_, err = antehandler(cacheCtx, tx, false)
+	s.Require().NoError(err)
+
+	// oracle priced: luna = 0.25 ibc, 100 ibc = 400uluna
+	taxParams.FeeDenoms = []taxtypes.FeeDenom{taxtypes.NewFeeDenom(ibcDenom, core.MicroLunaDenom, sdk.ZeroDec())}
+	s.Require().NoError(s.app.TaxKeeper.SetParams(s.ctx, taxParams))
+
+	cacheCtx, _ = s.ctx.CacheContext()
+	_, err = antehandler(cacheCtx, tx, false)
+	s.Require().Error(err, "fee denom without oracle rate must not be accepted")
+
+	s.app.OracleKeeper.SetLunaExchangeRate(s.ctx, ibcDenom, sdk.NewDecWithPrec(25, 2))
+
+	_, err = antehandler(s.ctx, tx, false)
+	s.Require().NoError(err)
+
+	// the fee is forwarded to the fee collector in the ibc denom
+	feeCollector := s.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	s.Require().Equal(feeAmount, s.app.BankKeeper.GetAllBalances(s.ctx, feeCollector))
+}
+
+func (s *AnteTestSuite) TestEnsureMempoolFeesWithGasDiscount() {
+	s.SetupTest(true) // setup
+	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+
+	mfd := ante.NewFeeDecorator(s.app.AccountKeeper, s.app.BankKeeper, s.app.FeeGrantKeeper, s.app.TreasuryKeeper, s.app.DistrKeeper, s.app.TaxKeeper)
+	antehandler := sdk.ChainAnteDecorators(mfd)
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	coins := sdk.NewCoins(sdk.NewCoin(core.MicroLunaDenom, sdk.NewInt(1000)))
+	testutil.FundAccount(s.app.BankKeeper, s.ctx, addr1, coins)
+
+	// msg and signatures
+	msg := testdata.NewTestMsg(addr1)
+	feeAmount := sdk.NewCoins(sdk.NewCoin(core.MicroLunaDenom, sdk.NewInt(100)))
+	gasLimit := uint64(100)
+	s.Require().NoError(s.txBuilder.SetMsgs(msg))
+	s.txBuilder.SetFeeAmount(feeAmount)
+	s.txBuilder.SetGasLimit(gasLimit)
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx, err := s.CreateTestTx(privs, accNums, accSeqs, s.ctx.ChainID())
+	s.Require().NoError(err)
+
+	// 100 gas at 2uluna requires 200uluna
+	s.ctx = s.ctx.WithIsCheckTx(true).WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoinFromDec(core.MicroLunaDenom, sdk.NewDec(2))))
+
+	cacheCtx, _ := s.ctx.CacheContext()
+	_, err = antehandler(cacheCtx, tx, false)
+	s.Require().Error(err)
+
+	// half price for one tx per 10 blocks
+	taxParams := s.app.TaxKeeper.GetParams(s.ctx)
+	taxParams.GasDiscounts = []taxtypes.GasDiscount{{
+		MsgTypeUrl:         sdk.MsgTypeURL(msg),
+		GasPriceMultiplier: sdk.NewDecWithPrec(5, 1),
+		MaxTxsPerWindow:    1,
+		WindowBlocks:       10,
+	}}
+	s.Require().NoError(s.app.TaxKeeper.SetParams(s.ctx, taxParams))
+	s.Require().Equal(taxParams.GasDiscounts, s.app.TaxKeeper.GetParams(s.ctx).GasDiscounts)
+
+	_, err = antehandler(s.ctx, tx, false)
+	s.Require().NoError(err)
+
+	// the signer exhausted the discount of the current window
+	_, err = antehandler(s.ctx.WithBlockHeight(s.ctx.BlockHeight()+9), tx, false)
+	s.Require().Error(err)
+
+	_, err = antehandler(s.ctx.WithBlockHeight(s.ctx.BlockHeight()+10), tx, false)
+	s.Require().NoError(err)
+
+	// the usage of the current window is kept
+	s.app.TaxKeeper.PruneGasDiscountUsages(s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 19))
+	s.Require().Equal(uint64(1), s.app.TaxKeeper.GetGasDiscountUsage(s.ctx, addr1, sdk.MsgTypeURL(msg)).Count)
+
+	// the discount is restricted to other signers
+	taxParams.GasDiscounts[0].AllowedSigners = []string{sdk.AccAddress(priv1.PubKey().Address()).String()}
+	taxParams.GasDiscounts[0].MaxTxsPerWindow = 0
+	s.Require().NoError(s.app.TaxKeeper.SetParams(s.ctx, taxParams))
+
+	_, err = antehandler(s.ctx, tx, false)
+	s.Require().NoError(err)
+
+	_, _, addr2 := testdata.KeyTestPubAddr()
+	taxParams.GasDiscounts[0].AllowedSigners = []string{addr2.String()}
+	s.Require().NoError(s.app.TaxKeeper.SetParams(s.ctx, taxParams))
+
+	_, err = antehandler(s.ctx, tx, false)
+	s.Require().Error(err)
+
+	// txs paying the full price are not counted and expired usages are pruned
+	taxParams.GasDiscounts[0].MaxTxsPerWindow = 1
+	s.Require().NoError(s.app.TaxKeeper.SetParams(s.ctx, taxParams))
+
+	s.txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(core.MicroLunaDenom, sdk.NewInt(200))))
+	tx, err = s.CreateTestTx(privs, accNums, accSeqs, s.ctx.ChainID())
+	s.Require().NoError(err)
+
+	ctx := s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 20)
+	_, err = antehandler(ctx, tx, false)
+	s.Require().NoError(err)
+
+	s.app.TaxKeeper.PruneGasDiscountUsages(ctx)
+	s.Require().Equal(taxtypes.GasDiscountUsage{}, s.app.TaxKeeper.GetGasDiscountUsage(ctx, addr1, sdk.MsgTypeURL(msg)))
+}
+
 func (s *AnteTestSuite) TestDeductFees() {
 	s.SetupTest(true) // setup
 	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
@@ -273,6 +430,65 @@ func (s *AnteTestSuite) TestEnsureMempoolFeesSwapSend() {
 	s.Require().NoError(err, "Decorator should not have errored on fee higher than local gasPrice")
 }
 
+func (s *AnteTestSuite) TestEnsureMempoolFeesCreateVestingAccount() {
+	s.SetupTest(true) // setup
+	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+
+	mfd := ante.NewFeeDecorator(s.app.AccountKeeper, s.app.BankKeeper, s.app.FeeGrantKeeper, s.app.TreasuryKeeper, s.app.DistrKeeper, s.app.TaxKeeper)
+	antehandler := sdk.ChainAnteDecorators(mfd)
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	coins := sdk.NewCoins(sdk.NewCoin(core.MicroSDRDenom, sdk.NewInt(1000000)))
+	testutil.FundAccount(s.app.BankKeeper, s.ctx, addr1, coins)
+
+	// msg and signatures
+	sendAmount := int64(1000000)
+	sendCoin := sdk.NewInt64Coin(core.MicroSDRDenom, sendAmount)
+	_, _, addr2 := testdata.KeyTestPubAddr()
+	msg := vestingtypes.NewMsgCreateLazyGradedVestingAccount(addr1, addr2, sdk.NewCoins(sendCoin), vestingtypes.VestingSchedules{
+		vestingtypes.NewVestingSchedule(core.MicroSDRDenom, vestingtypes.Schedules{
+			vestingtypes.NewSchedule(s.ctx.BlockTime().Unix(), s.ctx.BlockTime().Add(time.Hour).Unix(), sdk.OneDec()),
+		}),
+	})
+
+	feeAmount := testdata.NewTestFeeAmount()
+	gasLimit := testdata.NewTestGasLimit()
+	s.Require().NoError(s.txBuilder.SetMsgs(msg))
+	s.txBuilder.SetFeeAmount(feeAmount)
+	s.txBuilder.SetGasLimit(gasLimit)
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx, err := s.CreateTestTx(privs, accNums, accSeqs, s.ctx.ChainID())
+	s.Require().NoError(err)
+
+	// set zero gas prices
+	s.ctx = s.ctx.WithMinGasPrices(sdk.NewDecCoins())
+
+	// Set IsCheckTx to true
+	s.ctx = s.ctx.WithIsCheckTx(true)
+
+	// antehandler errors with insufficient fees due to tax
+	_, err = antehandler(s.ctx, tx, false)
+	s.Require().Error(err, "Decorator should errored on low fee for local gasPrice + tax")
+
+	tk := s.app.TreasuryKeeper
+	th := s.app.TaxKeeper
+	expectedTax := th.GetBurnTaxRate(s.ctx).MulInt64(sendAmount).TruncateInt()
+	if taxCap := tk.GetTaxCap(s.ctx, core.MicroSDRDenom); expectedTax.GT(taxCap) {
+		expectedTax = taxCap
+	}
+
+	// set tax amount
+	s.txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin(core.MicroSDRDenom, expectedTax)))
+	tx, err = s.CreateTestTx(privs, accNums, accSeqs, s.ctx.ChainID())
+	s.Require().NoError(err)
+
+	// must pass with tax
+	_, err = antehandler(s.ctx, tx, false)
+	s.Require().NoError(err, "Decorator should not have errored on fee higher than local gasPrice")
+}
+
 func (s *AnteTestSuite) TestEnsureMempoolFeesMultiSend() {
 	s.SetupTest(true) // setup
 	s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
@@ -1444,6 +1660,76 @@ func (s *AnteTestSuite) TestEnsureIBCUntaxed() {
 	s.Require().True(taxProceeds.Empty())
 }
 
+// go test -v -run ^TestAnteTestSuite/TestSenderPaysUpfrontPrepaidTax$ github.com/classic-terra/core/v3/custom/auth/ante
+func (s *AnteTestSuite) TestSenderPaysUpfrontPrepaidTax() {
+	s.SetupTest(true) // setup
+
+	mfd := ante.NewFeeDecorator(
+		s.app.AccountKeeper,
+		s.app.BankKeeper,
+		s.app.FeeGrantKeeper,
+		s.app.TreasuryKeeper,
+		s.app.DistrKeeper,
+		s.app.TaxKeeper,
+	)
+	antehandler := sdk.ChainAnteDecorators(mfd)
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, contract := testdata.KeyTestPubAddr()
+	testutil.FundAccount(s.app.BankKeeper, s.ctx, addr1, sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 1_000_000_000)))
+
+	sendCoins := sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 1_000_000))
+	expectedTax := s.app.TaxKeeper.GetBurnTaxRate(s.ctx).MulInt64(1_000_000).TruncateInt()
+	if taxCap := s.app.TreasuryKeeper.GetTaxCap(s.ctx, core.MicroSDRDenom); expectedTax.GT(taxCap) {
+		expectedTax = taxCap
+	}
+	taxes := sdk.NewCoins(sdk.NewCoin(core.MicroSDRDenom, expectedTax))
+
+	msg := &wasmtypes.MsgExecuteContract{
+		Sender:   addr1.String(),
+		Contract: contract.String(),
+		Msg:      []byte("{}"),
+		Funds:    sendCoins,
+	}
+
+	// set zero gas prices
+	s.ctx = s.ctx.WithMinGasPrices(sdk.NewDecCoins()).WithIsCheckTx(true)
+
+	runAnte := func(fee sdk.Coins, seq uint64) sdk.Context {
+		s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+		s.Require().NoError(s.txBuilder.SetMsgs(msg))
+		s.txBuilder.SetFeeAmount(fee)
+		s.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+		tx, err := s.CreateTestTx([]cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{seq}, s.ctx.ChainID())
+		s.Require().NoError(err)
+
+		newCtx, err := antehandler(s.ctx, tx, false)
+		s.Require().NoError(err)
+		return newCtx
+	}
+
+	// without a policy the funds are not taxed and nothing is prepaid
+	newCtx := runAnte(taxes, 0)
+	s.Require().False(newCtx.Value(taxtypes.ContextKeyTaxReverseCharge).(bool))
+	s.Require().Empty(newCtx.Value(taxtypes.ContextKeyTaxPrepaid).(*taxtypes.PrepaidTax).Get(contract.String()))
+
+	s.Require().NoError(s.app.TaxKeeper.SetContractTaxPolicy(s.ctx,
+		taxtypes.NewContractTaxPolicy(contract.String(), taxtypes.TaxPolicyModeSenderPaysUpfront, nil)))
+
+	// the tax on the funds is prepaid with the fee
+	newCtx = runAnte(taxes, 0)
+	s.Require().False(newCtx.Value(taxtypes.ContextKeyTaxReverseCharge).(bool))
+	s.Require().Equal(taxes, newCtx.Value(taxtypes.ContextKeyTaxDue))
+	s.Require().Equal(taxes, newCtx.Value(taxtypes.ContextKeyTaxPrepaid).(*taxtypes.PrepaidTax).Get(contract.String()))
+
+	// nothing is prepaid if the fee does not cover the tax
+	newCtx = runAnte(sdk.NewCoins(sdk.NewCoin(core.MicroSDRDenom, expectedTax.QuoRaw(2))), 0)
+	s.Require().True(newCtx.Value(taxtypes.ContextKeyTaxReverseCharge).(bool))
+	s.Require().Empty(newCtx.Value(taxtypes.ContextKeyTaxPrepaid).(*taxtypes.PrepaidTax).Get(contract.String()))
+}
+
 // go test -v -run ^TestAnteTestSuite/TestOracleZeroFee$ github.com/classic-terra/core/v3/custom/auth/ante
 func (s *AnteTestSuite) TestOracleZeroFee() {
 	s.SetupTest(true) // setup
@@ -5,14 +5,21 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/exported"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	customsim "github.com/classic-terra/core/v3/custom/staking/simulation"
 	customtypes "github.com/classic-terra/core/v3/custom/staking/types"
 	core "github.com/classic-terra/core/v3/types"
 )
 
-var _ module.AppModuleBasic = AppModuleBasic{}
+var (
+	_ module.AppModuleBasic      = AppModuleBasic{}
+	_ module.AppModuleSimulation = AppModule{}
+)
 
 // AppModuleBasic defines the basic application module used by the staking module.
 type AppModuleBasic struct {
@@ -34,3 +41,29 @@ func (am AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
 
 	return cdc.MustMarshalJSON(defaultGenesisState)
 }
+
+// AppModule implements an application module for the staking module.
+type AppModule struct {
+	staking.AppModule
+	keeper        *keeper.Keeper
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
+}
+
+// NewAppModule creates a new AppModule object
+func NewAppModule(cdc codec.Codec, keeper *keeper.Keeper, accountKeeper types.AccountKeeper, bankKeeper types.BankKeeper, subspace exported.Subspace) AppModule {
+	return AppModule{
+		AppModule:     staking.NewAppModule(cdc, keeper, accountKeeper, bankKeeper, subspace),
+		keeper:        keeper,
+		accountKeeper: accountKeeper,
+		bankKeeper:    bankKeeper,
+	}
+}
+
+// WeightedOperations returns the all the staking module operations with their respective weights.
+func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	// MsgEditValidator is left to the dyncomm module to respect the min commission rate
+	return customsim.WeightedOperations(
+		simState.AppParams, simState.Cdc, am.accountKeeper, am.bankKeeper, am.keeper,
+	)
+}
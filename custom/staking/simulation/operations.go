@@ -0,0 +1,83 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingsim "github.com/cosmos/cosmos-sdk/x/staking/simulation"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights.
+// MsgEditValidator is simulated by the dyncomm module, which enforces the min commission
+// rate on the edited commission rates.
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec, ak types.AccountKeeper,
+	bk types.BankKeeper, k *keeper.Keeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgCreateValidator           int
+		weightMsgDelegate                  int
+		weightMsgUndelegate                int
+		weightMsgBeginRedelegate           int
+		weightMsgCancelUnbondingDelegation int
+	)
+
+	appParams.GetOrGenerate(cdc, stakingsim.OpWeightMsgCreateValidator, &weightMsgCreateValidator, nil,
+		func(*rand.Rand) {
+			weightMsgCreateValidator = stakingsim.DefaultWeightMsgCreateValidator
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, stakingsim.OpWeightMsgDelegate, &weightMsgDelegate, nil,
+		func(*rand.Rand) {
+			weightMsgDelegate = stakingsim.DefaultWeightMsgDelegate
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, stakingsim.OpWeightMsgUndelegate, &weightMsgUndelegate, nil,
+		func(*rand.Rand) {
+			weightMsgUndelegate = stakingsim.DefaultWeightMsgUndelegate
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, stakingsim.OpWeightMsgBeginRedelegate, &weightMsgBeginRedelegate, nil,
+		func(*rand.Rand) {
+			weightMsgBeginRedelegate = stakingsim.DefaultWeightMsgBeginRedelegate
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, stakingsim.OpWeightMsgCancelUnbondingDelegation, &weightMsgCancelUnbondingDelegation, nil,
+		func(*rand.Rand) {
+			weightMsgCancelUnbondingDelegation = stakingsim.DefaultWeightMsgCancelUnbondingDelegation
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgCreateValidator,
+			stakingsim.SimulateMsgCreateValidator(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgDelegate,
+			stakingsim.SimulateMsgDelegate(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgUndelegate,
+			stakingsim.SimulateMsgUndelegate(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgBeginRedelegate,
+			stakingsim.SimulateMsgBeginRedelegate(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgCancelUnbondingDelegation,
+			stakingsim.SimulateMsgCancelUnbondingDelegate(ak, bk, k),
+		),
+	}
+}
@@ -14,7 +14,6 @@ import (
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	taxkeeper "github.com/classic-terra/core/v3/x/tax/keeper"
-	taxtypes "github.com/classic-terra/core/v3/x/tax/types"
 )
 
 // msgEncoder is an extension point to customize encodings
@@ -35,7 +34,7 @@ type SDKMessageHandler struct {
 	treasuryKeeper treasurykeeper.Keeper
 	accountKeeper  authkeeper.AccountKeeper
 	bankKeeper     bankKeeper.Keeper
-	taxKeeper      taxkeeper.Keeper
+	taxKeeper      *taxkeeper.Keeper
 }
 
 func NewMessageHandler(
@@ -46,7 +45,7 @@ func NewMessageHandler(
 	bankKeeper bankKeeper.Keeper,
 	treasuryKeeper treasurykeeper.Keeper,
 	accountKeeper authkeeper.AccountKeeper,
-	taxKeeper taxkeeper.Keeper,
+	taxKeeper *taxkeeper.Keeper,
 	unpacker codectypes.AnyUnpacker,
 	portSource wasmtypes.ICS20TransferPortSource,
 	customEncoders ...*wasmkeeper.MessageEncoders,
@@ -62,7 +61,7 @@ func NewMessageHandler(
 	)
 }
 
-func NewSDKMessageHandler(router MessageRouter, encoders msgEncoder, treasuryKeeper treasurykeeper.Keeper, accountKeeper authkeeper.AccountKeeper, bankKeeper bankKeeper.Keeper, taxKeeper taxkeeper.Keeper) SDKMessageHandler {
+func NewSDKMessageHandler(router MessageRouter, encoders msgEncoder, treasuryKeeper treasurykeeper.Keeper, accountKeeper authkeeper.AccountKeeper, bankKeeper bankKeeper.Keeper, taxKeeper *taxkeeper.Keeper) SDKMessageHandler {
 	return SDKMessageHandler{
 		router:         router,
 		encoders:       encoders,
@@ -79,8 +78,8 @@ func (h SDKMessageHandler) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddr
 		return nil, nil, err
 	}
 
-	// contract handling is ALWAYS reverse charged
-	ctx = ctx.WithValue(taxtypes.ContextKeyTaxReverseCharge, true)
+	// contract handling is reverse charged unless the contract tax policy says otherwise
+	ctx = h.taxKeeper.WithContractTaxPolicy(ctx, contractAddr)
 
 	for _, sdkMsg := range sdkMsgs {
 		// Charge tax on result msg
@@ -0,0 +1,390 @@
+package interchaintest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/strangelove-ventures/interchaintest/v7"
+	"github.com/strangelove-ventures/interchaintest/v7/chain/cosmos"
+	"github.com/strangelove-ventures/interchaintest/v7/ibc"
+	"github.com/strangelove-ventures/interchaintest/v7/testreporter"
+	"github.com/strangelove-ventures/interchaintest/v7/testutil"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+)
+
+// TestTerraPFM spins up three Terra Classic networks A, B and C, connected as A <-> B <-> C,
+// and sends an ICS20 transfer from A to C which is forwarded by the packet-forward middleware on B.
+// The forwarded amount must arrive in full, so the intermediate hop on B must not be taxed,
+// and the tokens must unwind back to A the same way.
+func TestTerraPFM(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	t.Parallel()
+
+	// Create chain factory with Terra Classic
+	numVals := 1
+	numFullNodes := 0
+
+	client, network := interchaintest.DockerSetup(t)
+
+	ctx := context.Background()
+
+	configA, err := createConfig()
+	require.NoError(t, err)
+
+	configB := configA.Clone()
+	configB.Name = "core-b"
+	configB.ChainID = "core-b-1"
+
+	configC := configA.Clone()
+	configC.Name = "core-c"
+	configC.ChainID = "core-c-1"
+
+	cf := interchaintest.NewBuiltinChainFactory(zaptest.NewLogger(t), []*interchaintest.ChainSpec{
+		{
+			Name:          "terra",
+			ChainConfig:   configA,
+			NumValidators: &numVals,
+			NumFullNodes:  &numFullNodes,
+		},
+		{
+			Name:          "terra",
+			ChainConfig:   configB,
+			NumValidators: &numVals,
+			NumFullNodes:  &numFullNodes,
+		},
+		{
+			Name:          "terra",
+			ChainConfig:   configC,
+			NumValidators: &numVals,
+			NumFullNodes:  &numFullNodes,
+		},
+	})
+
+	const (
+		pathAB = "ab"
+		pathBC = "bc"
+	)
+
+	// Get chains from the chain factory
+	chains, err := cf.Chains(t.Name())
+	require.NoError(t, err)
+
+	chainA, chainB, chainC := chains[0].(*cosmos.CosmosChain), chains[1].(*cosmos.CosmosChain), chains[2].(*cosmos.CosmosChain)
+
+	// Create relayer factory to utilize the go-relayer
+	r := interchaintest.NewBuiltinRelayerFactory(ibc.CosmosRly, zaptest.NewLogger(t)).
+		Build(t, client, network)
+
+	// Create a new Interchain object which describes the chains, relayers, and IBC connections we want to use
+	ic := interchaintest.NewInterchain().
+		AddChain(chainA).
+		AddChain(chainB).
+		AddChain(chainC).
+		AddRelayer(r, "relayer").
+		AddLink(interchaintest.InterchainLink{
+			Chain1:  chainA,
+			Chain2:  chainB,
+			Relayer: r,
+			Path:    pathAB,
+		}).
+		AddLink(interchaintest.InterchainLink{
+			Chain1:  chainB,
+			Chain2:  chainC,
+			Relayer: r,
+			Path:    pathBC,
+		})
+
+	// Build interchain
+	rep := testreporter.NewNopReporter()
+	eRep := rep.RelayerExecReporter(t)
+	err = ic.Build(ctx, eRep, interchaintest.InterchainBuildOptions{
+		TestName:  t.Name(),
+		Client:    client,
+		NetworkID: network,
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = ic.Close()
+	})
+
+	// Start the relayer and set the cleanup function.
+	require.NoError(t, r.StartRelayer(ctx, eRep, pathAB, pathBC))
+	t.Cleanup(
+		func() {
+			err := r.StopRelayer(ctx, eRep)
+			if err != nil {
+				panic(fmt.Errorf("an error occurred while stopping the relayer: %s", err))
+			}
+		},
+	)
+
+	// Create and Fund User Wallets
+	users := interchaintest.GetAndFundTestUsers(t, ctx, "default", genesisWalletAmount, chainA, chainB, chainC)
+	userA, userB, userC := users[0], users[1], users[2]
+
+	userAAddr := userA.FormattedAddress()
+	userBAddr := userB.FormattedAddress()
+	userCAddr := userC.FormattedAddress()
+
+	// Wait a few blocks for relayer to start and for user accounts to be created
+	err = testutil.WaitForBlocks(ctx, 5, chainA, chainB, chainC)
+	require.NoError(t, err)
+
+	userAInitialBal, err := chainA.GetBalance(ctx, userAAddr, chainA.Config().Denom)
+	require.NoError(t, err)
+	require.Equal(t, genesisWalletBalance, userAInitialBal)
+
+	abChannel, err := ibc.GetTransferChannel(ctx, r, eRep, chainA.Config().ChainID, chainB.Config().ChainID)
+	require.NoError(t, err)
+
+	bcChannel, err := ibc.GetTransferChannel(ctx, r, eRep, chainB.Config().ChainID, chainC.Config().ChainID)
+	require.NoError(t, err)
+
+	// Get the IBC denoms for uluna of A on B and on C
+	denomOnB := transfertypes.GetPrefixedDenom(abChannel.Counterparty.PortID, abChannel.Counterparty.ChannelID, chainA.Config().Denom)
+	ibcDenomOnB := transfertypes.ParseDenomTrace(denomOnB).IBCDenom()
+
+	denomOnC := transfertypes.GetPrefixedDenom(bcChannel.Counterparty.PortID, bcChannel.Counterparty.ChannelID, denomOnB)
+	ibcDenomOnC := transfertypes.ParseDenomTrace(denomOnC).IBCDenom()
+
+	// Compose an IBC transfer from A to C, forwarded by B
+	transferAmount := math.NewInt(100000)
+	transfer := ibc.WalletAmount{
+		Address: userBAddr,
+		Denom:   chainA.Config().Denom,
+		Amount:  transferAmount,
+	}
+
+	forward := ibc.TransferOptions{
+		Memo: fmt.Sprintf(`{"forward":{"receiver":"%s","port":"%s","channel":"%s"}}`, userCAddr, bcChannel.PortID, bcChannel.ChannelID),
+	}
+
+	transferTx, err := chainA.SendIBCTransfer(ctx, abChannel.ChannelID, userA.KeyName(), transfer, forward)
+	require.NoError(t, err)
+
+	heightA, err := chainA.Height(ctx)
+	require.NoError(t, err)
+
+	// The ack on A is only written once the forwarded packet is acknowledged by C
+	_, err = testutil.PollForAck(ctx, chainA, heightA-5, heightA+30, transferTx.Packet)
+	require.NoError(t, err)
+
+	// the transfer is using 200000 gas, gas price is 28.325uluna
+	gasFee := math.LegacyNewDec(200000).Mul(math.LegacyNewDecWithPrec(28325, 3))
+
+	userAUpdateBal, err := chainA.GetBalance(ctx, userAAddr, chainA.Config().Denom)
+	require.NoError(t, err)
+	require.Equal(t, userAInitialBal.Sub(transferAmount).Sub(gasFee.RoundInt()), userAUpdateBal)
+
+	// Nothing is left behind on the intermediate chain
+	userBBal, err := chainB.GetBalance(ctx, userBAddr, ibcDenomOnB)
+	require.NoError(t, err)
+	require.Equal(t, math.ZeroInt(), userBBal)
+
+	// The full amount arrived on C, the hop on B was not taxed
+	userCBal, err := chainC.GetBalance(ctx, userCAddr, ibcDenomOnC)
+	require.NoError(t, err)
+	require.Equal(t, transferAmount, userCBal)
+
+	// Unwind the tokens from C back to A, again forwarded by B
+	transfer = ibc.WalletAmount{
+		Address: userBAddr,
+		Denom:   ibcDenomOnC,
+		Amount:  transferAmount,
+	}
+
+	forward = ibc.TransferOptions{
+		Memo: fmt.Sprintf(`{"forward":{"receiver":"%s","port":"%s","channel":"%s"}}`, userAAddr, abChannel.Counterparty.PortID, abChannel.Counterparty.ChannelID),
+	}
+
+	transferTx, err = chainC.SendIBCTransfer(ctx, bcChannel.Counterparty.ChannelID, userC.KeyName(), transfer, forward)
+	require.NoError(t, err)
+
+	heightC, err := chainC.Height(ctx)
+	require.NoError(t, err)
+
+	_, err = testutil.PollForAck(ctx, chainC, heightC-5, heightC+30, transferTx.Packet)
+	require.NoError(t, err)
+
+	userCBal, err = chainC.GetBalance(ctx, userCAddr, ibcDenomOnC)
+	require.NoError(t, err)
+	require.Equal(t, math.ZeroInt(), userCBal)
+
+	userBBal, err = chainB.GetBalance(ctx, userBAddr, ibcDenomOnB)
+	require.NoError(t, err)
+	require.Equal(t, math.ZeroInt(), userBBal)
+
+	// Everything but the gas fee is back on A
+	userAUpdateBal, err = chainA.GetBalance(ctx, userAAddr, chainA.Config().Denom)
+	require.NoError(t, err)
+	require.Equal(t, userAInitialBal.Sub(gasFee.RoundInt()), userAUpdateBal)
+}
+
+// TestTerraGaiaOsmoPFM spins up a Gaia, Terra Classic and Osmosis network, connected as Gaia <-> Terra Classic <-> Osmosis,
+// and sends an ICS20 transfer from Gaia to Osmosis which is forwarded by the packet-forward middleware on Terra Classic.
+func TestTerraGaiaOsmoPFM(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	t.Parallel()
+
+	// Create chain factory with Terra Classic
+	numVals := 1
+	numFullNodes := 0
+
+	client, network := interchaintest.DockerSetup(t)
+
+	ctx := context.Background()
+
+	config, err := createConfig()
+	require.NoError(t, err)
+
+	cf := interchaintest.NewBuiltinChainFactory(zaptest.NewLogger(t), []*interchaintest.ChainSpec{
+		{
+			Name:          "terra",
+			ChainConfig:   config,
+			NumValidators: &numVals,
+			NumFullNodes:  &numFullNodes,
+		},
+		{
+			Name:          "gaia",
+			Version:       "v12.0.0",
+			NumValidators: &numVals,
+			NumFullNodes:  &numFullNodes,
+		},
+		{
+			Name:          "osmosis",
+			Version:       "v25.0.0",
+			NumValidators: &numVals,
+			NumFullNodes:  &numFullNodes,
+		},
+	})
+
+	// Get chains from the chain factory
+	chains, err := cf.Chains(t.Name())
+	require.NoError(t, err)
+
+	terra, gaia, osmo := chains[0].(*cosmos.CosmosChain), chains[1].(*cosmos.CosmosChain), chains[2].(*cosmos.CosmosChain)
+
+	// Create relayer factory to utilize the go-relayer
+	r := interchaintest.NewBuiltinRelayerFactory(ibc.CosmosRly, zaptest.NewLogger(t)).
+		Build(t, client, network)
+
+	// Create a new Interchain object which describes the chains, relayers, and IBC connections we want to use
+	ic := interchaintest.NewInterchain().
+		AddChain(terra).
+		AddChain(gaia).
+		AddChain(osmo).
+		AddRelayer(r, "relayer").
+		AddLink(interchaintest.InterchainLink{
+			Chain1:  terra,
+			Chain2:  gaia,
+			Relayer: r,
+			Path:    pathTerraGaia,
+		}).
+		AddLink(interchaintest.InterchainLink{
+			Chain1:  terra,
+			Chain2:  osmo,
+			Relayer: r,
+			Path:    pathTerraOsmo,
+		})
+
+	// Build interchain
+	rep := testreporter.NewNopReporter()
+	eRep := rep.RelayerExecReporter(t)
+	err = ic.Build(ctx, eRep, interchaintest.InterchainBuildOptions{
+		TestName:  t.Name(),
+		Client:    client,
+		NetworkID: network,
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = ic.Close()
+	})
+
+	// Start the relayer and set the cleanup function.
+	require.NoError(t, r.StartRelayer(ctx, eRep, pathTerraGaia, pathTerraOsmo))
+	t.Cleanup(
+		func() {
+			err := r.StopRelayer(ctx, eRep)
+			if err != nil {
+				panic(fmt.Errorf("an error occurred while stopping the relayer: %s", err))
+			}
+		},
+	)
+
+	// Create and Fund User Wallets
+	users := interchaintest.GetAndFundTestUsers(t, ctx, "default", genesisWalletAmount, terra, gaia, osmo)
+	terraUser, gaiaUser, osmoUser := users[0], users[1], users[2]
+
+	terraUserAddr := terraUser.FormattedAddress()
+	gaiaUserAddr := gaiaUser.FormattedAddress()
+	osmoUserAddr := osmoUser.FormattedAddress()
+
+	// Wait a few blocks for relayer to start and for user accounts to be created
+	err = testutil.WaitForBlocks(ctx, 5, terra, gaia, osmo)
+	require.NoError(t, err)
+
+	gaiaUserInitialBal, err := gaia.GetBalance(ctx, gaiaUserAddr, gaia.Config().Denom)
+	require.NoError(t, err)
+
+	gaiaTerraChannel, err := ibc.GetTransferChannel(ctx, r, eRep, gaia.Config().ChainID, terra.Config().ChainID)
+	require.NoError(t, err)
+
+	terraOsmoChannel, err := ibc.GetTransferChannel(ctx, r, eRep, terra.Config().ChainID, osmo.Config().ChainID)
+	require.NoError(t, err)
+
+	// Get the IBC denoms for uatom on Terra Classic and on Osmosis
+	gaiaOnTerraDenom := transfertypes.GetPrefixedDenom(gaiaTerraChannel.Counterparty.PortID, gaiaTerraChannel.Counterparty.ChannelID, gaia.Config().Denom)
+	gaiaOnTerraIBCDenom := transfertypes.ParseDenomTrace(gaiaOnTerraDenom).IBCDenom()
+
+	gaiaOnOsmoDenom := transfertypes.GetPrefixedDenom(terraOsmoChannel.Counterparty.PortID, terraOsmoChannel.Counterparty.ChannelID, gaiaOnTerraDenom)
+	gaiaOnOsmoIBCDenom := transfertypes.ParseDenomTrace(gaiaOnOsmoDenom).IBCDenom()
+
+	// Compose an IBC transfer from Gaia to Osmosis, forwarded by Terra Classic
+	transferAmount := math.NewInt(100000)
+	transfer := ibc.WalletAmount{
+		Address: terraUserAddr,
+		Denom:   gaia.Config().Denom,
+		Amount:  transferAmount,
+	}
+
+	forward := ibc.TransferOptions{
+		Memo: fmt.Sprintf(`{"forward":{"receiver":"%s","port":"%s","channel":"%s"}}`, osmoUserAddr, terraOsmoChannel.PortID, terraOsmoChannel.ChannelID),
+	}
+
+	transferTx, err := gaia.SendIBCTransfer(ctx, gaiaTerraChannel.ChannelID, gaiaUser.KeyName(), transfer, forward)
+	require.NoError(t, err)
+
+	gaiaHeight, err := gaia.Height(ctx)
+	require.NoError(t, err)
+
+	// The ack on Gaia is only written once the forwarded packet is acknowledged by Osmosis
+	_, err = testutil.PollForAck(ctx, gaia, gaiaHeight-5, gaiaHeight+30, transferTx.Packet)
+	require.NoError(t, err)
+
+	gaiaUserUpdateBal, err := gaia.GetBalance(ctx, gaiaUserAddr, gaia.Config().Denom)
+	require.NoError(t, err)
+	require.True(t, gaiaUserUpdateBal.LTE(gaiaUserInitialBal.Sub(transferAmount)))
+
+	// Nothing is left behind on Terra Classic
+	terraUserBal, err := terra.GetBalance(ctx, terraUserAddr, gaiaOnTerraIBCDenom)
+	require.NoError(t, err)
+	require.Equal(t, math.ZeroInt(), terraUserBal)
+
+	// The full amount arrived on Osmosis, the hop on Terra Classic was not taxed
+	osmoUserBal, err := osmo.GetBalance(ctx, osmoUserAddr, gaiaOnOsmoIBCDenom)
+	require.NoError(t, err)
+	require.Equal(t, transferAmount, osmoUserBal)
+}
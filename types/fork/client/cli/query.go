@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"github.com/classic-terra/core/v3/types/fork"
+)
+
+// GetQueryCmd returns the commands to query the soft-fork features.
+func GetQueryCmd() *cobra.Command {
+	forkQueryCmd := &cobra.Command{
+		Use:                        "fork",
+		Short:                      "Querying commands for the soft-fork features",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	forkQueryCmd.AddCommand(
+		GetCmdQueryActiveFeatures(),
+	)
+
+	return forkQueryCmd
+}
+
+// GetCmdQueryActiveFeatures implements a command to list the active soft-fork features.
+func GetCmdQueryActiveFeatures() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "active-features",
+		Short: "Query the soft-fork features active at the current height",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := fork.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ActiveFeatures(context.Background(), &fork.QueryActiveFeaturesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,118 @@
+package fork
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/classic-terra/core/v3/types"
+)
+
+// Soft-fork feature flag names
+const (
+	FeatureLunaSwapFee      = "luna-swap-fee"
+	FeatureOracleFix        = "oracle-fix"
+	FeatureSwapDisable      = "swap-disable"
+	FeatureBurnTaxUpgrade   = "burn-tax-upgrade"
+	FeatureIbcEnable        = "ibc-enable"
+	FeatureVersionMapEnable = "version-map-enable"
+)
+
+// Feature is a soft-fork feature flag with its activation height per chain-id.
+// Networks without an activation height, like rebel and local networks, run
+// the feature from genesis.
+type Feature struct {
+	Name    string
+	Heights map[string]int64
+}
+
+// ActivationHeight returns the height the feature activates at on the given chain,
+// false if the feature is active from genesis.
+func (f Feature) ActivationHeight(chainID string) (int64, bool) {
+	height, found := f.Heights[chainID]
+	return height, found
+}
+
+// IsActive returns true if the feature is active at the given chain and height
+func (f Feature) IsActive(chainID string, height int64) bool {
+	activationHeight, found := f.ActivationHeight(chainID)
+	return !found || height >= activationHeight
+}
+
+// Features is the registry of the soft-fork features of the network
+var Features = []Feature{
+	{
+		Name: FeatureLunaSwapFee,
+		Heights: map[string]int64{
+			core.ColumbusChainID: ColumbusLunaSwapFeeHeight,
+			core.BombayChainID:   BombayLunaSwapFeeHeight,
+		},
+	},
+	{
+		Name: FeatureOracleFix,
+		Heights: map[string]int64{
+			core.ColumbusChainID: ColumbusOracleFixHeight,
+			core.BombayChainID:   BombayOracleFixHeight,
+		},
+	},
+	{
+		Name:    FeatureSwapDisable,
+		Heights: map[string]int64{core.ColumbusChainID: SwapDisableHeight},
+	},
+	{
+		Name:    FeatureBurnTaxUpgrade,
+		Heights: map[string]int64{core.ColumbusChainID: BurnTaxUpgradeHeight},
+	},
+	{
+		Name:    FeatureIbcEnable,
+		Heights: map[string]int64{core.ColumbusChainID: IbcEnableHeight},
+	},
+	{
+		Name:    FeatureVersionMapEnable,
+		Heights: map[string]int64{core.ColumbusChainID: VersionMapEnableHeight},
+	},
+}
+
+// GetFeature returns the registered feature with the given name
+func GetFeature(name string) (Feature, bool) {
+	for _, feature := range Features {
+		if feature.Name == name {
+			return feature, true
+		}
+	}
+
+	return Feature{}, false
+}
+
+// MustGetFeature returns the registered feature with the given name and panics
+// if the feature is not registered.
+func MustGetFeature(name string) Feature {
+	feature, found := GetFeature(name)
+	if !found {
+		panic(fmt.Sprintf("soft-fork feature %s is not registered", name))
+	}
+
+	return feature
+}
+
+// IsFeatureActive returns true if the registered feature is active at the
+// chain-id and height of the context. It panics on unregistered features.
+func IsFeatureActive(ctx sdk.Context, name string) bool {
+	return MustGetFeature(name).IsActive(ctx.ChainID(), ctx.BlockHeight())
+}
+
+// ActiveFeatures returns the registered features active at the chain-id and
+// height of the context.
+func ActiveFeatures(ctx sdk.Context) []ActiveFeature {
+	features := []ActiveFeature{}
+	for _, feature := range Features {
+		if !feature.IsActive(ctx.ChainID(), ctx.BlockHeight()) {
+			continue
+		}
+
+		height, _ := feature.ActivationHeight(ctx.ChainID())
+		features = append(features, ActiveFeature{Name: feature.Name, ActivationHeight: height})
+	}
+
+	return features
+}
@@ -0,0 +1,86 @@
+package fork_test
+
+import (
+	"testing"
+
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/classic-terra/core/v3/types"
+	"github.com/classic-terra/core/v3/types/fork"
+)
+
+func newContext(chainID string, height int64) sdk.Context {
+	return sdk.NewContext(nil, tmproto.Header{ChainID: chainID, Height: height}, false, log.NewNopLogger())
+}
+
+func TestFeatureActivationReplay(t *testing.T) {
+	for _, tc := range []struct {
+		chainID          string
+		feature          string
+		activationHeight int64
+	}{
+		{core.ColumbusChainID, fork.FeatureLunaSwapFee, fork.ColumbusLunaSwapFeeHeight},
+		{core.BombayChainID, fork.FeatureLunaSwapFee, fork.BombayLunaSwapFeeHeight},
+		{core.ColumbusChainID, fork.FeatureOracleFix, fork.ColumbusOracleFixHeight},
+		{core.BombayChainID, fork.FeatureOracleFix, fork.BombayOracleFixHeight},
+		{core.ColumbusChainID, fork.FeatureSwapDisable, fork.SwapDisableHeight},
+		{core.ColumbusChainID, fork.FeatureBurnTaxUpgrade, fork.BurnTaxUpgradeHeight},
+		{core.ColumbusChainID, fork.FeatureIbcEnable, fork.IbcEnableHeight},
+		{core.ColumbusChainID, fork.FeatureVersionMapEnable, fork.VersionMapEnableHeight},
+	} {
+		for height := tc.activationHeight - 2; height <= tc.activationHeight+2; height++ {
+			active := fork.IsFeatureActive(newContext(tc.chainID, height), tc.feature)
+			require.Equal(t, height >= tc.activationHeight, active, "%s on %s at %d", tc.feature, tc.chainID, height)
+		}
+	}
+}
+
+func TestFeatureActiveFromGenesis(t *testing.T) {
+	for _, chainID := range []string{core.RebelChainID, "localterra"} {
+		for _, feature := range fork.Features {
+			require.True(t, fork.IsFeatureActive(newContext(chainID, 1), feature.Name), "%s on %s", feature.Name, chainID)
+		}
+	}
+
+	// swap-disable has no activation height on bombay
+	require.True(t, fork.IsFeatureActive(newContext(core.BombayChainID, 1), fork.FeatureSwapDisable))
+}
+
+func TestUnregisteredFeature(t *testing.T) {
+	_, found := fork.GetFeature("unknown")
+	require.False(t, found)
+	require.Panics(t, func() { fork.IsFeatureActive(newContext(core.ColumbusChainID, 1), "unknown") })
+}
+
+func TestFeaturesUnique(t *testing.T) {
+	seen := map[string]bool{}
+	for _, feature := range fork.Features {
+		require.False(t, seen[feature.Name], feature.Name)
+		seen[feature.Name] = true
+	}
+}
+
+func TestActiveFeatures(t *testing.T) {
+	require.Empty(t, fork.ActiveFeatures(newContext(core.ColumbusChainID, 1)))
+
+	require.Equal(t, []fork.ActiveFeature{
+		{Name: fork.FeatureLunaSwapFee, ActivationHeight: fork.ColumbusLunaSwapFeeHeight},
+		{Name: fork.FeatureOracleFix, ActivationHeight: fork.ColumbusOracleFixHeight},
+	}, fork.ActiveFeatures(newContext(core.ColumbusChainID, fork.ColumbusOracleFixHeight)))
+
+	require.Len(t, fork.ActiveFeatures(newContext(core.ColumbusChainID, fork.VersionMapEnableHeight)), len(fork.Features))
+
+	res, err := fork.NewQueryServer().ActiveFeatures(sdk.WrapSDKContext(newContext(core.BombayChainID, fork.BombayLunaSwapFeeHeight)), &fork.QueryActiveFeaturesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []fork.ActiveFeature{
+		{Name: fork.FeatureLunaSwapFee, ActivationHeight: fork.BombayLunaSwapFeeHeight},
+		{Name: fork.FeatureSwapDisable},
+		{Name: fork.FeatureBurnTaxUpgrade},
+		{Name: fork.FeatureIbcEnable},
+		{Name: fork.FeatureVersionMapEnable},
+	}, res.Features)
+}
@@ -0,0 +1,756 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: terra/fork/v1beta1/query.proto
+
+package fork
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryActiveFeaturesRequest is the request type for the Query/ActiveFeatures RPC method.
+type QueryActiveFeaturesRequest struct {
+}
+
+func (m *QueryActiveFeaturesRequest) Reset()         { *m = QueryActiveFeaturesRequest{} }
+func (m *QueryActiveFeaturesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryActiveFeaturesRequest) ProtoMessage()    {}
+func (*QueryActiveFeaturesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16b7a98bf092f434, []int{0}
+}
+func (m *QueryActiveFeaturesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryActiveFeaturesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryActiveFeaturesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryActiveFeaturesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryActiveFeaturesRequest.Merge(m, src)
+}
+func (m *QueryActiveFeaturesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryActiveFeaturesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryActiveFeaturesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryActiveFeaturesRequest proto.InternalMessageInfo
+
+// QueryActiveFeaturesResponse is the response type for the Query/ActiveFeatures RPC method.
+type QueryActiveFeaturesResponse struct {
+	Features []ActiveFeature `protobuf:"bytes,1,rep,name=features,proto3" json:"features"`
+}
+
+func (m *QueryActiveFeaturesResponse) Reset()         { *m = QueryActiveFeaturesResponse{} }
+func (m *QueryActiveFeaturesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryActiveFeaturesResponse) ProtoMessage()    {}
+func (*QueryActiveFeaturesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16b7a98bf092f434, []int{1}
+}
+func (m *QueryActiveFeaturesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryActiveFeaturesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryActiveFeaturesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryActiveFeaturesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryActiveFeaturesResponse.Merge(m, src)
+}
+func (m *QueryActiveFeaturesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryActiveFeaturesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryActiveFeaturesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryActiveFeaturesResponse proto.InternalMessageInfo
+
+func (m *QueryActiveFeaturesResponse) GetFeatures() []ActiveFeature {
+	if m != nil {
+		return m.Features
+	}
+	return nil
+}
+
+// ActiveFeature describes a soft-fork feature active on the chain.
+type ActiveFeature struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// activation_height is the height the feature was activated at, zero when
+	// the feature is active from genesis.
+	ActivationHeight int64 `protobuf:"varint,2,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+}
+
+func (m *ActiveFeature) Reset()         { *m = ActiveFeature{} }
+func (m *ActiveFeature) String() string { return proto.CompactTextString(m) }
+func (*ActiveFeature) ProtoMessage()    {}
+func (*ActiveFeature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16b7a98bf092f434, []int{2}
+}
+func (m *ActiveFeature) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActiveFeature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActiveFeature.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActiveFeature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActiveFeature.Merge(m, src)
+}
+func (m *ActiveFeature) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActiveFeature) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActiveFeature.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActiveFeature proto.InternalMessageInfo
+
+func (m *ActiveFeature) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ActiveFeature) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*QueryActiveFeaturesRequest)(nil), "terra.fork.v1beta1.QueryActiveFeaturesRequest")
+	proto.RegisterType((*QueryActiveFeaturesResponse)(nil), "terra.fork.v1beta1.QueryActiveFeaturesResponse")
+	proto.RegisterType((*ActiveFeature)(nil), "terra.fork.v1beta1.ActiveFeature")
+}
+
+func init() { proto.RegisterFile("terra/fork/v1beta1/query.proto", fileDescriptor_16b7a98bf092f434) }
+
+var fileDescriptor_16b7a98bf092f434 = []byte{
+	// 338 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0x41, 0x4b, 0x02, 0x41,
+	0x14, 0xc7, 0x77, 0xd4, 0xa2, 0x26, 0x8a, 0x1a, 0x3a, 0xc8, 0x26, 0x9b, 0x6d, 0x04, 0x82, 0x34,
+	0x83, 0xfa, 0x09, 0x32, 0x8a, 0x8e, 0xb5, 0xc7, 0x2e, 0x32, 0xbb, 0x3c, 0xd7, 0x25, 0xdd, 0x59,
+	0x67, 0x66, 0x05, 0xaf, 0x7d, 0x82, 0xa0, 0x7b, 0xe7, 0x3e, 0x8a, 0x47, 0xa1, 0x4b, 0xa7, 0x08,
+	0xed, 0x83, 0xc4, 0xce, 0x5a, 0x21, 0x19, 0x74, 0x1b, 0xde, 0xef, 0xff, 0x1e, 0xbf, 0x79, 0x0f,
+	0x3b, 0x1a, 0xa4, 0xe4, 0xac, 0x2b, 0xe4, 0x1d, 0x1b, 0x35, 0x7c, 0xd0, 0xbc, 0xc1, 0x86, 0x29,
+	0xc8, 0x31, 0x4d, 0xa4, 0xd0, 0x82, 0x10, 0xc3, 0x69, 0xc6, 0xe9, 0x82, 0xdb, 0xfb, 0xa1, 0x08,
+	0x85, 0xc1, 0x2c, 0x7b, 0xe5, 0x49, 0xbb, 0x12, 0x0a, 0x11, 0xf6, 0x81, 0xf1, 0x24, 0x62, 0x3c,
+	0x8e, 0x85, 0xe6, 0x3a, 0x12, 0xb1, 0xca, 0xa9, 0x5b, 0xc1, 0xf6, 0x4d, 0x36, 0xf6, 0x2c, 0xd0,
+	0xd1, 0x08, 0x2e, 0x81, 0xeb, 0x54, 0x82, 0xf2, 0x60, 0x98, 0x82, 0xd2, 0xae, 0x8f, 0x0f, 0x56,
+	0x52, 0x95, 0x88, 0x58, 0x01, 0x39, 0xc7, 0x1b, 0xdd, 0x45, 0xad, 0x8c, 0xaa, 0xc5, 0xda, 0x56,
+	0xf3, 0x88, 0xfe, 0xf6, 0xa2, 0x4b, 0xdd, 0xed, 0xd2, 0xe4, 0xed, 0xd0, 0xf2, 0xbe, 0x1b, 0xdd,
+	0x6b, 0xbc, 0xbd, 0x14, 0x20, 0x04, 0x97, 0x62, 0x3e, 0x80, 0x32, 0xaa, 0xa2, 0xda, 0xa6, 0x67,
+	0xde, 0xa4, 0x8e, 0xf7, 0x78, 0x16, 0x32, 0xee, 0x9d, 0x1e, 0x44, 0x61, 0x4f, 0x97, 0x0b, 0x55,
+	0x54, 0x2b, 0x7a, 0xbb, 0x3f, 0xe0, 0xca, 0xd4, 0x9b, 0xcf, 0x08, 0xaf, 0x19, 0x6d, 0xf2, 0x84,
+	0xf0, 0xce, 0xb2, 0x3b, 0xa1, 0xab, 0x0c, 0xff, 0x5e, 0x81, 0xcd, 0xfe, 0x9d, 0xcf, 0x97, 0xe2,
+	0xd6, 0xef, 0x5f, 0x3e, 0x1e, 0x0b, 0x27, 0xe4, 0x98, 0xad, 0x38, 0xa1, 0x71, 0x85, 0xce, 0xd7,
+	0xe7, 0xdb, 0x17, 0x93, 0x99, 0x83, 0xa6, 0x33, 0x07, 0xbd, 0xcf, 0x1c, 0xf4, 0x30, 0x77, 0xac,
+	0xe9, 0xdc, 0xb1, 0x5e, 0xe7, 0x8e, 0x75, 0x5b, 0x0f, 0x23, 0xdd, 0x4b, 0x7d, 0x1a, 0x88, 0x01,
+	0x0b, 0xfa, 0x5c, 0xa9, 0x28, 0x38, 0xcd, 0x07, 0x06, 0x42, 0x02, 0x1b, 0xb5, 0x98, 0x1e, 0x27,
+	0xa0, 0xcc, 0x78, 0x7f, 0xdd, 0x1c, 0xb3, 0xf5, 0x39, 0x00, 0x1a, 0x6e, 0xda, 0x77, 0x36, 0x02,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// ActiveFeatures returns the soft-fork features active at the queried height.
+	ActiveFeatures(ctx context.Context, in *QueryActiveFeaturesRequest, opts ...grpc.CallOption) (*QueryActiveFeaturesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) ActiveFeatures(ctx context.Context, in *QueryActiveFeaturesRequest, opts ...grpc.CallOption) (*QueryActiveFeaturesResponse, error) {
+	out := new(QueryActiveFeaturesResponse)
+	err := c.cc.Invoke(ctx, "/terra.fork.v1beta1.Query/ActiveFeatures", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// ActiveFeatures returns the soft-fork features active at the queried height.
+	ActiveFeatures(context.Context, *QueryActiveFeaturesRequest) (*QueryActiveFeaturesResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) ActiveFeatures(ctx context.Context, req *QueryActiveFeaturesRequest) (*QueryActiveFeaturesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActiveFeatures not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_ActiveFeatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryActiveFeaturesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ActiveFeatures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.fork.v1beta1.Query/ActiveFeatures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ActiveFeatures(ctx, req.(*QueryActiveFeaturesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "terra.fork.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ActiveFeatures",
+			Handler:    _Query_ActiveFeatures_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "terra/fork/v1beta1/query.proto",
+}
+
+func (m *QueryActiveFeaturesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActiveFeaturesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActiveFeaturesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActiveFeaturesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActiveFeaturesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActiveFeaturesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Features) > 0 {
+		for iNdEx := len(m.Features) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Features[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ActiveFeature) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActiveFeature) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActiveFeature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActivationHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryActiveFeaturesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryActiveFeaturesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Features) > 0 {
+		for _, e := range m.Features {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ActiveFeature) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ActivationHeight))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryActiveFeaturesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActiveFeaturesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActiveFeaturesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActiveFeaturesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActiveFeaturesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActiveFeaturesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Features = append(m.Features, ActiveFeature{})
+			if err := m.Features[len(m.Features)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActiveFeature) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActiveFeature: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActiveFeature: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,37 @@
+package fork
+
+import (
+	"context"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ QueryServer = queryServer{}
+
+// queryServer is the server of the soft-fork feature Query service.
+type queryServer struct{}
+
+// NewQueryServer creates a new soft-fork feature Query service server.
+func NewQueryServer() QueryServer {
+	return queryServer{}
+}
+
+// ActiveFeatures implements the QueryServer.ActiveFeatures RPC method.
+func (queryServer) ActiveFeatures(c context.Context, _ *QueryActiveFeaturesRequest) (*QueryActiveFeaturesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &QueryActiveFeaturesResponse{Features: ActiveFeatures(ctx)}, nil
+}
+
+// RegisterQueryService registers the soft-fork feature Query service on the gRPC router.
+func RegisterQueryService(qrt gogogrpc.Server) {
+	RegisterQueryServer(qrt, NewQueryServer())
+}
+
+// RegisterGRPCGatewayRoutes mounts the soft-fork feature Query service's GRPC-gateway
+// routes on the given Mux.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	_ = RegisterQueryHandlerClient(context.Background(), mux, NewQueryClient(clientConn))
+}
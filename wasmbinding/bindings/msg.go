@@ -5,8 +5,14 @@ import (
 )
 
 type TerraMsg struct {
-	Swap     *Swap     `json:"swap,omitempty"`
-	SwapSend *SwapSend `json:"swap_send,omitempty"`
+	Swap                         *Swap                         `json:"swap,omitempty"`
+	SwapSend                     *SwapSend                     `json:"swap_send,omitempty"`
+	SwapWithSlippage             *SwapWithSlippage             `json:"swap_with_slippage,omitempty"`
+	AggregateExchangeRatePrevote *AggregateExchangeRatePrevote `json:"aggregate_exchange_rate_prevote,omitempty"`
+	AggregateExchangeRateVote    *AggregateExchangeRateVote    `json:"aggregate_exchange_rate_vote,omitempty"`
+	DelegateFeedConsent          *DelegateFeedConsent          `json:"delegate_feed_consent,omitempty"`
+	RegisterInterchainAccount    *RegisterInterchainAccount    `json:"register_interchain_account,omitempty"`
+	SubmitInterchainTx           *SubmitInterchainTx           `json:"submit_interchain_tx,omitempty"`
 }
 
 type Swap struct {
@@ -19,3 +25,57 @@ type SwapSend struct {
 	OfferCoin sdk.Coin `json:"offer_coin"`
 	AskDenom  string   `json:"ask_denom"`
 }
+
+// SwapWithSlippage swaps offer_coin into ask_denom and fails if less than
+// min_ask_amount is received. The swapped coins are sent to to_address if set,
+// otherwise to the contract.
+type SwapWithSlippage struct {
+	OfferCoin    sdk.Coin `json:"offer_coin"`
+	AskDenom     string   `json:"ask_denom"`
+	MinAskAmount sdk.Int  `json:"min_ask_amount"`
+	ToAddress    string   `json:"to_address,omitempty"`
+}
+
+// AggregateExchangeRatePrevote submits a prevote for validator, the contract
+// must be the delegated feeder of the validator.
+type AggregateExchangeRatePrevote struct {
+	Hash      string `json:"hash"`
+	Validator string `json:"validator"`
+}
+
+// AggregateExchangeRateVote submits a vote for validator, the contract must be
+// the delegated feeder of the validator.
+type AggregateExchangeRateVote struct {
+	Salt          string `json:"salt"`
+	ExchangeRates string `json:"exchange_rates"`
+	Validator     string `json:"validator"`
+}
+
+// DelegateFeedConsent delegates the oracle feeding of the validator operated by
+// the contract to delegate.
+type DelegateFeedConsent struct {
+	Delegate string `json:"delegate"`
+}
+
+// RegisterInterchainAccount registers an interchain account of the contract on
+// the host chain of the connection.
+type RegisterInterchainAccount struct {
+	ConnectionID string `json:"connection_id"`
+	Version      string `json:"version,omitempty"`
+}
+
+// SubmitInterchainTx submits msgs to be executed by the interchain account of
+// the contract. The contract is notified of the acknowledgement or timeout of
+// the packet with the ibc-hooks ibc_lifecycle_complete sudo message.
+type SubmitInterchainTx struct {
+	ConnectionID    string     `json:"connection_id"`
+	Msgs            []ProtoMsg `json:"msgs"`
+	Memo            string     `json:"memo,omitempty"`
+	RelativeTimeout uint64     `json:"relative_timeout,omitempty"`
+}
+
+// ProtoMsg is a msg of the host chain in its protobuf encoding.
+type ProtoMsg struct {
+	TypeURL string `json:"type_url"`
+	Value   []byte `json:"value"`
+}
@@ -12,12 +12,36 @@ type ExchangeRateQueryParams struct {
 	QuoteDenoms []string `json:"quote_denoms"`
 }
 
+// ValidatorQueryParams query request params for validator specific queries
+type ValidatorQueryParams struct {
+	ValidatorAddr string `json:"validator_addr"`
+}
+
+// BurnTaxExemptionQueryParams query request params for the burn tax exemption check
+type BurnTaxExemptionQueryParams struct {
+	Addresses []string `json:"addresses"`
+}
+
 // TerraQuery contains terra custom queries.
 type TerraQuery struct {
 	Swap          *markettypes.QuerySwapParams     `json:"swap,omitempty"`
 	ExchangeRates *ExchangeRateQueryParams         `json:"exchange_rates,omitempty"`
 	TaxRate       *struct{}                        `json:"tax_rate,omitempty"`
 	TaxCap        *treasurytypes.QueryTaxCapParams `json:"tax_cap,omitempty"`
+
+	BurnTaxRate        *struct{}                    `json:"burn_tax_rate,omitempty"`
+	GasPrices          *struct{}                    `json:"gas_prices,omitempty"`
+	OracleParams       *struct{}                    `json:"oracle_params,omitempty"`
+	Actives            *struct{}                    `json:"actives,omitempty"`
+	VoteTargets        *struct{}                    `json:"vote_targets,omitempty"`
+	TobinTaxes         *struct{}                    `json:"tobin_taxes,omitempty"`
+	FeederDelegation   *ValidatorQueryParams        `json:"feeder_delegation,omitempty"`
+	MissCounter        *ValidatorQueryParams        `json:"miss_counter,omitempty"`
+	MarketParams       *struct{}                    `json:"market_params,omitempty"`
+	TerraPoolDelta     *struct{}                    `json:"terra_pool_delta,omitempty"`
+	TreasuryIndicators *struct{}                    `json:"treasury_indicators,omitempty"`
+	BurnTaxExemption   *BurnTaxExemptionQueryParams `json:"burn_tax_exemption,omitempty"`
+	DyncommRate        *ValidatorQueryParams        `json:"dyncomm_rate,omitempty"`
 }
 
 // SwapQueryResponse - swap simulation query response for wasm module
@@ -48,3 +72,89 @@ type TaxCapQueryResponse struct {
 	// uint64 string, eg "1000000"
 	Cap string `json:"cap"`
 }
+
+// BurnTaxRateQueryResponse - burn tax rate query response for wasm module
+type BurnTaxRateQueryResponse struct {
+	// decimal string, eg "0.005"
+	Rate string `json:"rate"`
+}
+
+// GasPricesQueryResponse - gas prices query response for wasm module
+type GasPricesQueryResponse struct {
+	GasPrices []wasmvmtypes.DecCoin `json:"gas_prices"`
+}
+
+// DenomItem - oracle whitelist and tobin tax item
+type DenomItem struct {
+	Name string `json:"name"`
+	// decimal string, eg "0.0025"
+	TobinTax string `json:"tobin_tax"`
+}
+
+// OracleParamsQueryResponse - oracle params query response for wasm module
+type OracleParamsQueryResponse struct {
+	VotePeriod               uint64      `json:"vote_period"`
+	VoteThreshold            string      `json:"vote_threshold"`
+	RewardBand               string      `json:"reward_band"`
+	RewardDistributionWindow uint64      `json:"reward_distribution_window"`
+	Whitelist                []DenomItem `json:"whitelist"`
+	SlashFraction            string      `json:"slash_fraction"`
+	SlashWindow              uint64      `json:"slash_window"`
+	MinValidPerWindow        string      `json:"min_valid_per_window"`
+}
+
+// ActivesQueryResponse - denoms with an exchange rate, query response for wasm module
+type ActivesQueryResponse struct {
+	Actives []string `json:"actives"`
+}
+
+// VoteTargetsQueryResponse - vote targets query response for wasm module
+type VoteTargetsQueryResponse struct {
+	VoteTargets []string `json:"vote_targets"`
+}
+
+// TobinTaxesQueryResponse - tobin taxes query response for wasm module
+type TobinTaxesQueryResponse struct {
+	TobinTaxes []DenomItem `json:"tobin_taxes"`
+}
+
+// FeederDelegationQueryResponse - feeder delegation query response for wasm module
+type FeederDelegationQueryResponse struct {
+	FeederAddr string `json:"feeder_addr"`
+}
+
+// MissCounterQueryResponse - miss counter query response for wasm module
+type MissCounterQueryResponse struct {
+	MissCounter uint64 `json:"miss_counter"`
+}
+
+// MarketParamsQueryResponse - market params query response for wasm module
+type MarketParamsQueryResponse struct {
+	BasePool           string `json:"base_pool"`
+	PoolRecoveryPeriod uint64 `json:"pool_recovery_period"`
+	MinStabilitySpread string `json:"min_stability_spread"`
+}
+
+// TerraPoolDeltaQueryResponse - terra pool delta query response for wasm module
+type TerraPoolDeltaQueryResponse struct {
+	// decimal string, eg "-1000.5"
+	TerraPoolDelta string `json:"terra_pool_delta"`
+}
+
+// TreasuryIndicatorsQueryResponse - treasury indicators query response for wasm module
+type TreasuryIndicatorsQueryResponse struct {
+	TRLYear  string `json:"trl_year"`
+	TRLMonth string `json:"trl_month"`
+}
+
+// BurnTaxExemptionQueryResponse - burn tax exemption query response for wasm module
+type BurnTaxExemptionQueryResponse struct {
+	// true if all addresses are exempted from the burn tax
+	Exempt bool `json:"exempt"`
+}
+
+// DyncommRateQueryResponse - dyncomm rate query response for wasm module
+type DyncommRateQueryResponse struct {
+	Rate   string `json:"rate"`
+	Target string `json:"target"`
+}
@@ -4,29 +4,39 @@ import (
 	"encoding/json"
 
 	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 
 	"github.com/classic-terra/core/v3/wasmbinding/bindings"
+	icaauthtypes "github.com/classic-terra/core/v3/x/icaauth/types"
 	marketkeeper "github.com/classic-terra/core/v3/x/market/keeper"
 	markettypes "github.com/classic-terra/core/v3/x/market/types"
+	oracletypes "github.com/classic-terra/core/v3/x/oracle/types"
+	taxkeeper "github.com/classic-terra/core/v3/x/tax/keeper"
 )
 
 // CustomMessageDecorator returns decorator for custom CosmWasm bindings messages
-func CustomMessageDecorator(market *marketkeeper.Keeper) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
+func CustomMessageDecorator(router wasmkeeper.MessageRouter, market *marketkeeper.Keeper, tax *taxkeeper.Keeper) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
 	return func(old wasmkeeper.Messenger) wasmkeeper.Messenger {
 		return &CustomMessenger{
 			wrapped:      old,
+			router:       router,
 			marketKeeper: market,
+			taxKeeper:    tax,
 		}
 	}
 }
 
 type CustomMessenger struct {
 	wrapped      wasmkeeper.Messenger
+	router       wasmkeeper.MessageRouter
 	marketKeeper *marketkeeper.Keeper
+	taxKeeper    *taxkeeper.Keeper
 }
 
 var _ wasmkeeper.Messenger = (*CustomMessenger)(nil)
@@ -54,6 +64,48 @@ func (m *CustomMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddre
 			}
 			return nil, bz, nil
 
+		case contractMsg.SwapWithSlippage != nil:
+			events, bz, err := m.swapWithSlippage(ctx, contractAddr, contractMsg.SwapWithSlippage)
+			if err != nil {
+				return nil, nil, errorsmod.Wrap(err, "swap with slippage msg failed")
+			}
+			return events, bz, nil
+
+		case contractMsg.AggregateExchangeRatePrevote != nil:
+			events, bz, err := m.aggregateExchangeRatePrevote(ctx, contractAddr, contractMsg.AggregateExchangeRatePrevote)
+			if err != nil {
+				return nil, nil, errorsmod.Wrap(err, "aggregate exchange rate prevote msg failed")
+			}
+			return events, bz, nil
+
+		case contractMsg.AggregateExchangeRateVote != nil:
+			events, bz, err := m.aggregateExchangeRateVote(ctx, contractAddr, contractMsg.AggregateExchangeRateVote)
+			if err != nil {
+				return nil, nil, errorsmod.Wrap(err, "aggregate exchange rate vote msg failed")
+			}
+			return events, bz, nil
+
+		case contractMsg.DelegateFeedConsent != nil:
+			events, bz, err := m.delegateFeedConsent(ctx, contractAddr, contractMsg.DelegateFeedConsent)
+			if err != nil {
+				return nil, nil, errorsmod.Wrap(err, "delegate feed consent msg failed")
+			}
+			return events, bz, nil
+
+		case contractMsg.RegisterInterchainAccount != nil:
+			events, bz, err := m.registerInterchainAccount(ctx, contractAddr, contractMsg.RegisterInterchainAccount)
+			if err != nil {
+				return nil, nil, errorsmod.Wrap(err, "register interchain account msg failed")
+			}
+			return events, bz, nil
+
+		case contractMsg.SubmitInterchainTx != nil:
+			events, bz, err := m.submitInterchainTx(ctx, contractAddr, contractMsg.SubmitInterchainTx)
+			if err != nil {
+				return nil, nil, errorsmod.Wrap(err, "submit interchain tx msg failed")
+			}
+			return events, bz, nil
+
 		default:
 			return nil, nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown terra msg variant"}
 		}
@@ -145,3 +197,179 @@ func PerformSwapSend(f *marketkeeper.Keeper, ctx sdk.Context, contractAddr sdk.A
 	}
 	return res, nil
 }
+
+// swapWithSlippage performs a market swap and fails if less than the minimum
+// ask amount is received
+func (m *CustomMessenger) swapWithSlippage(ctx sdk.Context, contractAddr sdk.AccAddress, contractMsg *bindings.SwapWithSlippage) ([]sdk.Event, [][]byte, error) {
+	if contractMsg.MinAskAmount.IsNil() || contractMsg.MinAskAmount.IsNegative() {
+		return nil, nil, wasmvmtypes.InvalidRequest{Err: "min ask amount must not be negative"}
+	}
+
+	var (
+		events []sdk.Event
+		res    *markettypes.MsgSwapResponse
+	)
+	if contractMsg.ToAddress == "" {
+		// swapping to the contract itself is not taxed
+		swapRes, err := PerformSwap(m.marketKeeper, ctx, contractAddr, &bindings.Swap{
+			OfferCoin: contractMsg.OfferCoin,
+			AskDenom:  contractMsg.AskDenom,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		res = swapRes
+	} else {
+		toAddr, err := sdk.AccAddressFromBech32(contractMsg.ToAddress)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var sendRes markettypes.MsgSwapSendResponse
+		events, err = m.dispatchSdkMsg(ctx, contractAddr, markettypes.NewMsgSwapSend(contractAddr, toAddr, contractMsg.OfferCoin, contractMsg.AskDenom), &sendRes)
+		if err != nil {
+			return nil, nil, err
+		}
+		res = &markettypes.MsgSwapResponse{SwapCoin: sendRes.SwapCoin, SwapFee: sendRes.SwapFee}
+	}
+
+	if res.SwapCoin.Amount.LT(contractMsg.MinAskAmount) {
+		return nil, nil, errorsmod.Wrapf(markettypes.ErrSlippageExceeded, "swap returned %s, less than the minimum of %s%s", res.SwapCoin, contractMsg.MinAskAmount, contractMsg.AskDenom)
+	}
+
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "error marshal swap response")
+	}
+
+	return events, [][]byte{bz}, nil
+}
+
+// aggregateExchangeRatePrevote submits an oracle prevote with the contract as feeder
+func (m *CustomMessenger) aggregateExchangeRatePrevote(ctx sdk.Context, contractAddr sdk.AccAddress, contractMsg *bindings.AggregateExchangeRatePrevote) ([]sdk.Event, [][]byte, error) {
+	msg := &oracletypes.MsgAggregateExchangeRatePrevote{
+		Hash:      contractMsg.Hash,
+		Feeder:    contractAddr.String(),
+		Validator: contractMsg.Validator,
+	}
+
+	events, err := m.dispatchSdkMsg(ctx, contractAddr, msg, &oracletypes.MsgAggregateExchangeRatePrevoteResponse{})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return events, nil, nil
+}
+
+// aggregateExchangeRateVote submits an oracle vote with the contract as feeder
+func (m *CustomMessenger) aggregateExchangeRateVote(ctx sdk.Context, contractAddr sdk.AccAddress, contractMsg *bindings.AggregateExchangeRateVote) ([]sdk.Event, [][]byte, error) {
+	msg := &oracletypes.MsgAggregateExchangeRateVote{
+		Salt:          contractMsg.Salt,
+		ExchangeRates: contractMsg.ExchangeRates,
+		Feeder:        contractAddr.String(),
+		Validator:     contractMsg.Validator,
+	}
+
+	events, err := m.dispatchSdkMsg(ctx, contractAddr, msg, &oracletypes.MsgAggregateExchangeRateVoteResponse{})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return events, nil, nil
+}
+
+// delegateFeedConsent delegates the oracle feeding of the validator operated by the contract
+func (m *CustomMessenger) delegateFeedConsent(ctx sdk.Context, contractAddr sdk.AccAddress, contractMsg *bindings.DelegateFeedConsent) ([]sdk.Event, [][]byte, error) {
+	msg := &oracletypes.MsgDelegateFeedConsent{
+		Operator: sdk.ValAddress(contractAddr).String(),
+		Delegate: contractMsg.Delegate,
+	}
+
+	events, err := m.dispatchSdkMsg(ctx, contractAddr, msg, &oracletypes.MsgDelegateFeedConsentResponse{})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return events, nil, nil
+}
+
+// registerInterchainAccount registers an interchain account owned by the contract
+func (m *CustomMessenger) registerInterchainAccount(ctx sdk.Context, contractAddr sdk.AccAddress, contractMsg *bindings.RegisterInterchainAccount) ([]sdk.Event, [][]byte, error) {
+	msg := icaauthtypes.NewMsgRegisterInterchainAccount(contractAddr, contractMsg.ConnectionID, contractMsg.Version)
+
+	var res icaauthtypes.MsgRegisterInterchainAccountResponse
+	events, err := m.dispatchSdkMsg(ctx, contractAddr, msg, &res)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "error marshal register interchain account response")
+	}
+
+	return events, [][]byte{bz}, nil
+}
+
+// submitInterchainTx submits msgs to be executed by the interchain account of
+// the contract, the response holds the channel and sequence of the packet the
+// sudo callback will refer to
+func (m *CustomMessenger) submitInterchainTx(ctx sdk.Context, contractAddr sdk.AccAddress, contractMsg *bindings.SubmitInterchainTx) ([]sdk.Event, [][]byte, error) {
+	msgs := make([]*codectypes.Any, len(contractMsg.Msgs))
+	for i, msg := range contractMsg.Msgs {
+		msgs[i] = &codectypes.Any{TypeUrl: msg.TypeURL, Value: msg.Value}
+	}
+
+	msg := icaauthtypes.NewMsgSubmitTx(contractAddr, contractMsg.ConnectionID, msgs, contractMsg.Memo, contractMsg.RelativeTimeout)
+
+	var res icaauthtypes.MsgSubmitTxResponse
+	events, err := m.dispatchSdkMsg(ctx, contractAddr, msg, &res)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "error marshal submit interchain tx response")
+	}
+
+	return events, [][]byte{bz}, nil
+}
+
+// dispatchSdkMsg routes msg like the SDKMessageHandler does: it is taxed
+// according to the tax policy of the contract and the contract must be the
+// only signer. The result data is unmarshaled into res.
+func (m *CustomMessenger) dispatchSdkMsg(ctx sdk.Context, contractAddr sdk.AccAddress, msg sdk.Msg, res codec.ProtoMarshaler) ([]sdk.Event, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	for _, acct := range msg.GetSigners() {
+		if !acct.Equals(contractAddr) {
+			return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "contract doesn't have permission")
+		}
+	}
+
+	handler := m.router.Handler(msg)
+	if handler == nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "can't route message %s", sdk.MsgTypeURL(msg))
+	}
+
+	// messages are taxed the same way as the ones dispatched by the sdk message handler
+	ctx = m.taxKeeper.WithContractTaxPolicy(ctx, contractAddr)
+	msgResult, err := handler(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := res.Unmarshal(msgResult.Data); err != nil {
+		return nil, errorsmod.Wrap(err, "unmarshal msg response")
+	}
+
+	events := make([]sdk.Event, len(msgResult.Events))
+	for i := range msgResult.Events {
+		events[i] = sdk.Event(msgResult.Events[i])
+	}
+
+	return events, nil
+}
@@ -1,27 +1,191 @@
 package wasmbinding
 
 import (
-	//	"fmt"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
-	//	sdk "github.com/cosmos/cosmos-sdk/types"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 
-	//	"github.com/classic-terra/core/v3/wasmbinding/bindings"
+	"github.com/classic-terra/core/v3/wasmbinding/bindings"
+	dyncommkeeper "github.com/classic-terra/core/v3/x/dyncomm/keeper"
 	marketkeeper "github.com/classic-terra/core/v3/x/market/keeper"
 	oraclekeeper "github.com/classic-terra/core/v3/x/oracle/keeper"
+	taxkeeper "github.com/classic-terra/core/v3/x/tax/keeper"
 	treasurykeeper "github.com/classic-terra/core/v3/x/treasury/keeper"
+	treasurytypes "github.com/classic-terra/core/v3/x/treasury/types"
 )
 
 type QueryPlugin struct {
 	marketKeeper   *marketkeeper.Keeper
 	oracleKeeper   *oraclekeeper.Keeper
 	treasuryKeeper *treasurykeeper.Keeper
+	taxKeeper      *taxkeeper.Keeper
+	dyncommKeeper  *dyncommkeeper.Keeper
 }
 
 // NewQueryPlugin returns a reference to a new QueryPlugin.
-func NewQueryPlugin(tmk *marketkeeper.Keeper, tok *oraclekeeper.Keeper, ttk *treasurykeeper.Keeper) *QueryPlugin {
+func NewQueryPlugin(
+	tmk *marketkeeper.Keeper,
+	tok *oraclekeeper.Keeper,
+	ttk *treasurykeeper.Keeper,
+	tax *taxkeeper.Keeper,
+	tdk *dyncommkeeper.Keeper,
+) *QueryPlugin {
 	return &QueryPlugin{
 		marketKeeper:   tmk,
 		oracleKeeper:   tok,
 		treasuryKeeper: ttk,
+		taxKeeper:      tax,
+		dyncommKeeper:  tdk,
 	}
 }
+
+// BurnTaxRate returns the burn tax rate of the tax module.
+func (qp QueryPlugin) BurnTaxRate(ctx sdk.Context) bindings.BurnTaxRateQueryResponse {
+	return bindings.BurnTaxRateQueryResponse{Rate: qp.taxKeeper.GetBurnTaxRate(ctx).String()}
+}
+
+// GasPrices returns the gas prices of the tax module.
+func (qp QueryPlugin) GasPrices(ctx sdk.Context) bindings.GasPricesQueryResponse {
+	gasPrices := qp.taxKeeper.GetGasPrices(ctx)
+	res := bindings.GasPricesQueryResponse{GasPrices: make([]wasmvmtypes.DecCoin, len(gasPrices))}
+	for i, gasPrice := range gasPrices {
+		res.GasPrices[i] = wasmvmtypes.DecCoin{Denom: gasPrice.Denom, Amount: gasPrice.Amount.String()}
+	}
+
+	return res
+}
+
+// OracleParams returns the oracle module parameters.
+func (qp QueryPlugin) OracleParams(ctx sdk.Context) bindings.OracleParamsQueryResponse {
+	params := qp.oracleKeeper.GetParams(ctx)
+	whitelist := make([]bindings.DenomItem, len(params.Whitelist))
+	for i, denom := range params.Whitelist {
+		whitelist[i] = bindings.DenomItem{Name: denom.Name, TobinTax: denom.TobinTax.String()}
+	}
+
+	return bindings.OracleParamsQueryResponse{
+		VotePeriod:               params.VotePeriod,
+		VoteThreshold:            params.VoteThreshold.String(),
+		RewardBand:               params.RewardBand.String(),
+		RewardDistributionWindow: params.RewardDistributionWindow,
+		Whitelist:                whitelist,
+		SlashFraction:            params.SlashFraction.String(),
+		SlashWindow:              params.SlashWindow,
+		MinValidPerWindow:        params.MinValidPerWindow.String(),
+	}
+}
+
+// Actives returns the denoms with an exchange rate.
+func (qp QueryPlugin) Actives(ctx sdk.Context) bindings.ActivesQueryResponse {
+	actives := []string{}
+	qp.oracleKeeper.IterateLunaExchangeRates(ctx, func(denom string, _ sdk.Dec) (stop bool) {
+		actives = append(actives, denom)
+		return false
+	})
+
+	return bindings.ActivesQueryResponse{Actives: actives}
+}
+
+// VoteTargets returns the denoms to vote on in the current vote period.
+func (qp QueryPlugin) VoteTargets(ctx sdk.Context) bindings.VoteTargetsQueryResponse {
+	voteTargets := qp.oracleKeeper.GetVoteTargets(ctx)
+	if voteTargets == nil {
+		voteTargets = []string{}
+	}
+
+	return bindings.VoteTargetsQueryResponse{VoteTargets: voteTargets}
+}
+
+// TobinTaxes returns the tobin taxes of all denoms.
+func (qp QueryPlugin) TobinTaxes(ctx sdk.Context) bindings.TobinTaxesQueryResponse {
+	tobinTaxes := []bindings.DenomItem{}
+	qp.oracleKeeper.IterateTobinTaxes(ctx, func(denom string, rate sdk.Dec) (stop bool) {
+		tobinTaxes = append(tobinTaxes, bindings.DenomItem{Name: denom, TobinTax: rate.String()})
+		return false
+	})
+
+	return bindings.TobinTaxesQueryResponse{TobinTaxes: tobinTaxes}
+}
+
+// FeederDelegation returns the feeder a validator delegated its oracle votes to.
+func (qp QueryPlugin) FeederDelegation(ctx sdk.Context, params *bindings.ValidatorQueryParams) (bindings.FeederDelegationQueryResponse, error) {
+	valAddr, err := sdk.ValAddressFromBech32(params.ValidatorAddr)
+	if err != nil {
+		return bindings.FeederDelegationQueryResponse{}, errorsmod.Wrap(err, "validator address")
+	}
+
+	return bindings.FeederDelegationQueryResponse{
+		FeederAddr: qp.oracleKeeper.GetFeederDelegation(ctx, valAddr).String(),
+	}, nil
+}
+
+// MissCounter returns the oracle miss counter of a validator.
+func (qp QueryPlugin) MissCounter(ctx sdk.Context, params *bindings.ValidatorQueryParams) (bindings.MissCounterQueryResponse, error) {
+	valAddr, err := sdk.ValAddressFromBech32(params.ValidatorAddr)
+	if err != nil {
+		return bindings.MissCounterQueryResponse{}, errorsmod.Wrap(err, "validator address")
+	}
+
+	return bindings.MissCounterQueryResponse{
+		MissCounter: qp.oracleKeeper.GetMissCounter(ctx, valAddr),
+	}, nil
+}
+
+// MarketParams returns the market module parameters.
+func (qp QueryPlugin) MarketParams(ctx sdk.Context) bindings.MarketParamsQueryResponse {
+	params := qp.marketKeeper.GetParams(ctx)
+	return bindings.MarketParamsQueryResponse{
+		BasePool:           params.BasePool.String(),
+		PoolRecoveryPeriod: params.PoolRecoveryPeriod,
+		MinStabilitySpread: params.MinStabilitySpread.String(),
+	}
+}
+
+// TerraPoolDelta returns the terra pool delta of the market module.
+func (qp QueryPlugin) TerraPoolDelta(ctx sdk.Context) bindings.TerraPoolDeltaQueryResponse {
+	return bindings.TerraPoolDeltaQueryResponse{TerraPoolDelta: qp.marketKeeper.GetTerraPoolDelta(ctx).String()}
+}
+
+// TreasuryIndicators returns the treasury tax reward indicators.
+func (qp QueryPlugin) TreasuryIndicators(ctx sdk.Context) (bindings.TreasuryIndicatorsQueryResponse, error) {
+	q := treasurykeeper.NewQuerier(*qp.treasuryKeeper)
+	res, err := q.Indicators(sdk.WrapSDKContext(ctx), &treasurytypes.QueryIndicatorsRequest{})
+	if err != nil {
+		return bindings.TreasuryIndicatorsQueryResponse{}, err
+	}
+
+	return bindings.TreasuryIndicatorsQueryResponse{
+		TRLYear:  res.TRLYear.String(),
+		TRLMonth: res.TRLMonth.String(),
+	}, nil
+}
+
+// BurnTaxExemption checks if all given addresses are exempted from the burn tax.
+func (qp QueryPlugin) BurnTaxExemption(ctx sdk.Context, params *bindings.BurnTaxExemptionQueryParams) (bindings.BurnTaxExemptionQueryResponse, error) {
+	if len(params.Addresses) == 0 {
+		return bindings.BurnTaxExemptionQueryResponse{}, wasmvmtypes.InvalidRequest{Err: "no addresses given"}
+	}
+
+	for _, address := range params.Addresses {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return bindings.BurnTaxExemptionQueryResponse{}, errorsmod.Wrap(err, "address")
+		}
+	}
+
+	return bindings.BurnTaxExemptionQueryResponse{
+		Exempt: qp.treasuryKeeper.HasBurnTaxExemptionAddress(ctx, params.Addresses...),
+	}, nil
+}
+
+// DyncommRate returns the dynamic and target commission rate of a validator.
+func (qp QueryPlugin) DyncommRate(ctx sdk.Context, params *bindings.ValidatorQueryParams) (bindings.DyncommRateQueryResponse, error) {
+	if _, err := sdk.ValAddressFromBech32(params.ValidatorAddr); err != nil {
+		return bindings.DyncommRateQueryResponse{}, errorsmod.Wrap(err, "validator address")
+	}
+
+	return bindings.DyncommRateQueryResponse{
+		Rate:   qp.dyncommKeeper.GetDynCommissionRate(ctx, params.ValidatorAddr).String(),
+		Target: qp.dyncommKeeper.GetTargetCommissionRate(ctx, params.ValidatorAddr).String(),
+	}, nil
+}
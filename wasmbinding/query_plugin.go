@@ -26,9 +26,9 @@ type TaxCapQueryResponse struct {
 }
 
 // StargateQuerier dispatches whitelisted stargate queries
-func StargateQuerier(queryRouter baseapp.GRPCQueryRouter, cdc codec.Codec) func(ctx sdk.Context, request *wasmvmtypes.StargateQuery) ([]byte, error) {
+func StargateQuerier(queryRouter baseapp.GRPCQueryRouter, cdc codec.Codec, whitelist StargateWhitelist) func(ctx sdk.Context, request *wasmvmtypes.StargateQuery) ([]byte, error) {
 	return func(ctx sdk.Context, request *wasmvmtypes.StargateQuery) ([]byte, error) {
-		protoResponseType, err := GetWhitelistedQuery(request.Path)
+		protoResponseType, err := GetWhitelistedQuery(ctx, whitelist, request.Path)
 		if err != nil {
 			return nil, err
 		}
@@ -131,8 +131,61 @@ func CustomQuerier(qp *QueryPlugin) func(ctx sdk.Context, request json.RawMessag
 
 			return bz, nil
 
+		case contractQuery.BurnTaxRate != nil:
+			return marshalQueryResponse(qp.BurnTaxRate(ctx), nil)
+
+		case contractQuery.GasPrices != nil:
+			return marshalQueryResponse(qp.GasPrices(ctx), nil)
+
+		case contractQuery.OracleParams != nil:
+			return marshalQueryResponse(qp.OracleParams(ctx), nil)
+
+		case contractQuery.Actives != nil:
+			return marshalQueryResponse(qp.Actives(ctx), nil)
+
+		case contractQuery.VoteTargets != nil:
+			return marshalQueryResponse(qp.VoteTargets(ctx), nil)
+
+		case contractQuery.TobinTaxes != nil:
+			return marshalQueryResponse(qp.TobinTaxes(ctx), nil)
+
+		case contractQuery.FeederDelegation != nil:
+			return marshalQueryResponse(qp.FeederDelegation(ctx, contractQuery.FeederDelegation))
+
+		case contractQuery.MissCounter != nil:
+			return marshalQueryResponse(qp.MissCounter(ctx, contractQuery.MissCounter))
+
+		case contractQuery.MarketParams != nil:
+			return marshalQueryResponse(qp.MarketParams(ctx), nil)
+
+		case contractQuery.TerraPoolDelta != nil:
+			return marshalQueryResponse(qp.TerraPoolDelta(ctx), nil)
+
+		case contractQuery.TreasuryIndicators != nil:
+			return marshalQueryResponse(qp.TreasuryIndicators(ctx))
+
+		case contractQuery.BurnTaxExemption != nil:
+			return marshalQueryResponse(qp.BurnTaxExemption(ctx, contractQuery.BurnTaxExemption))
+
+		case contractQuery.DyncommRate != nil:
+			return marshalQueryResponse(qp.DyncommRate(ctx, contractQuery.DyncommRate))
+
 		default:
 			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown terra query variant"}
 		}
 	}
 }
+
+// marshalQueryResponse marshals the response of a custom query unless the query failed.
+func marshalQueryResponse(res interface{}, err error) ([]byte, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
@@ -2,48 +2,24 @@ package wasmbinding
 
 import (
 	"fmt"
-	"sync"
 
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
-	markettypes "github.com/classic-terra/core/v3/x/market/types"
-	oracletypes "github.com/classic-terra/core/v3/x/oracle/types"
-	treasurytypes "github.com/classic-terra/core/v3/x/treasury/types"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// stargateWhitelist keeps whitelist and its deterministic
-// response binding for stargate queries.
-//
-// The query can be multi-thread, so we have to use
-// thread safe sync.Map.
-var stargateWhitelist sync.Map
-
-func init() {
-	// market
-	setWhitelistedQuery("/terra.market.v1beta1.Query/Swap", &markettypes.QuerySwapResponse{})
-
-	// treasury
-	setWhitelistedQuery("/terra.treasury.v1beta1.Query/TaxCap", &treasurytypes.QueryTaxCapResponse{})
-	setWhitelistedQuery("/terra.treasury.v1beta1.Query/TaxRate", &treasurytypes.QueryTaxRateResponse{})
-
-	// oracle
-	setWhitelistedQuery("/terra.oracle.v1beta1.Query/ExchangeRate", &oracletypes.QueryExchangeRateResponse{})
+// StargateWhitelist provides the governance managed whitelist of stargate
+// queries and the deterministic response binding of each query.
+type StargateWhitelist interface {
+	GetWhitelistedQueryResponse(ctx sdk.Context, path string) (codec.ProtoMarshaler, error)
 }
 
-// GetWhitelistedQuery returns the whitelisted query at the provided path.
+// GetWhitelistedQuery returns the response type of the whitelisted query at the provided path.
 // If the query does not exist, or it was setup wrong by the chain, this returns an error.
-func GetWhitelistedQuery(queryPath string) (codec.ProtoMarshaler, error) {
-	protoResponseAny, isWhitelisted := stargateWhitelist.Load(queryPath)
-	if !isWhitelisted {
+func GetWhitelistedQuery(ctx sdk.Context, whitelist StargateWhitelist, queryPath string) (codec.ProtoMarshaler, error) {
+	protoResponseType, err := whitelist.GetWhitelistedQueryResponse(ctx, queryPath)
+	if err != nil {
 		return nil, wasmvmtypes.UnsupportedRequest{Kind: fmt.Sprintf("'%s' path is not allowed from the contract", queryPath)}
 	}
-	protoResponseType, ok := protoResponseAny.(codec.ProtoMarshaler)
-	if !ok {
-		return nil, wasmvmtypes.Unknown{}
-	}
 	return protoResponseType, nil
 }
-
-func setWhitelistedQuery(queryPath string, protoType codec.ProtoMarshaler) {
-	stargateWhitelist.Store(queryPath, protoType)
-}
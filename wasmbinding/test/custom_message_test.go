@@ -4,12 +4,16 @@ import (
 	"encoding/json"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	connectiontypes "github.com/cosmos/ibc-go/v7/modules/core/03-connection/types"
 
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 	core "github.com/classic-terra/core/v3/types"
+	"github.com/classic-terra/core/v3/wasmbinding"
 	"github.com/classic-terra/core/v3/wasmbinding/bindings"
+	icaauthtypes "github.com/classic-terra/core/v3/x/icaauth/types"
 	markettypes "github.com/classic-terra/core/v3/x/market/types"
+	oracletypes "github.com/classic-terra/core/v3/x/oracle/types"
 )
 
 // go test -v -run ^TestSwap$ github.com/classic-terra/core/v3/wasmbinding/test
@@ -181,3 +185,125 @@ func (s *WasmTestSuite) executeOldBindings(contract sdk.AccAddress, sender sdk.A
 	_, err := contractKeeper.Execute(s.Ctx, contract, sender, reflectBz, coins)
 	return err
 }
+
+func (s *WasmTestSuite) customMessenger() wasmkeeper.Messenger {
+	return wasmbinding.CustomMessageDecorator(s.App.MsgServiceRouter(), &s.App.MarketKeeper, &s.App.TaxKeeper)(nil)
+}
+
+func (s *WasmTestSuite) dispatchCustom(ctx sdk.Context, contract sdk.AccAddress, msg bindings.TerraMsg) ([][]byte, error) {
+	bz, err := json.Marshal(msg)
+	s.Require().NoError(err)
+
+	_, data, err := s.customMessenger().DispatchMsg(ctx, contract, "", wasmvmtypes.CosmosMsg{Custom: bz})
+	return data, err
+}
+
+// go test -v -run ^TestWasmTestSuite/TestOracleFeederMsgs$ github.com/classic-terra/core/v3/wasmbinding/test
+func (s *WasmTestSuite) TestOracleFeederMsgs() {
+	s.SetupTest()
+	contract := s.RandomAccountAddresses(1)[0]
+	val := s.App.StakingKeeper.GetAllValidators(s.Ctx)[0].GetOperator()
+	s.App.OracleKeeper.SetTobinTax(s.Ctx, core.MicroSDRDenom, sdk.ZeroDec())
+
+	salt := "1"
+	exchangeRates := "1.7" + core.MicroSDRDenom
+	hash := oracletypes.GetAggregateVoteHash(salt, exchangeRates, val)
+	prevote := bindings.TerraMsg{
+		AggregateExchangeRatePrevote: &bindings.AggregateExchangeRatePrevote{Hash: hash.String(), Validator: val.String()},
+	}
+
+	// the contract is not the feeder yet
+	_, err := s.dispatchCustom(s.Ctx, contract, prevote)
+	s.Require().ErrorIs(err, oracletypes.ErrNoVotingPermission)
+
+	// a contract operating the validator delegates the feeding
+	_, err = s.dispatchCustom(s.Ctx, sdk.AccAddress(val), bindings.TerraMsg{
+		DelegateFeedConsent: &bindings.DelegateFeedConsent{Delegate: contract.String()},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(contract, s.App.OracleKeeper.GetFeederDelegation(s.Ctx, val))
+
+	// only the operator can delegate
+	_, err = s.dispatchCustom(s.Ctx, contract, bindings.TerraMsg{
+		DelegateFeedConsent: &bindings.DelegateFeedConsent{Delegate: contract.String()},
+	})
+	s.Require().Error(err)
+
+	_, err = s.dispatchCustom(s.Ctx, contract, prevote)
+	s.Require().NoError(err)
+
+	// reveal in the next vote period
+	ctx := s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + int64(s.App.OracleKeeper.VotePeriod(s.Ctx)))
+	_, err = s.dispatchCustom(ctx, contract, bindings.TerraMsg{
+		AggregateExchangeRateVote: &bindings.AggregateExchangeRateVote{Salt: salt, ExchangeRates: exchangeRates, Validator: val.String()},
+	})
+	s.Require().NoError(err)
+
+	vote, err := s.App.OracleKeeper.GetAggregateExchangeRateVote(ctx, val)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDecWithPrec(17, 1), vote.ExchangeRateTuples[0].ExchangeRate)
+}
+
+// go test -v -run ^TestWasmTestSuite/TestSwapWithSlippage$ github.com/classic-terra/core/v3/wasmbinding/test
+func (s *WasmTestSuite) TestSwapWithSlippage() {
+	s.SetupTest()
+	accs := s.RandomAccountAddresses(2)
+	contract, recipient := accs[0], accs[1]
+	offerCoin := sdk.NewInt64Coin(core.MicroLunaDenom, 1000)
+	s.FundAcc(contract, sdk.NewCoins(offerCoin))
+	s.App.OracleKeeper.SetLunaExchangeRate(s.Ctx, core.MicroSDRDenom, sdk.NewDecWithPrec(17, 1))
+
+	// the burn tax is deducted from the offer coin when swapping to another address
+	netOfferCoin := offerCoin.Sub(s.App.TaxKeeper.ComputeTax(s.Ctx, sdk.NewCoins(offerCoin))[0])
+	swapCoin, spread, err := s.App.MarketKeeper.ComputeSwap(s.Ctx, netOfferCoin, core.MicroSDRDenom)
+	s.Require().NoError(err)
+	expected := swapCoin.Amount.Sub(spread.Mul(swapCoin.Amount)).TruncateInt()
+
+	msg := bindings.TerraMsg{
+		SwapWithSlippage: &bindings.SwapWithSlippage{
+			OfferCoin:    offerCoin,
+			AskDenom:     core.MicroSDRDenom,
+			MinAskAmount: expected.AddRaw(1),
+			ToAddress:    recipient.String(),
+		},
+	}
+
+	cacheCtx, _ := s.Ctx.CacheContext()
+	_, err = s.dispatchCustom(cacheCtx, contract, msg)
+	s.Require().ErrorIs(err, markettypes.ErrSlippageExceeded)
+
+	msg.SwapWithSlippage.MinAskAmount = expected
+	data, err := s.dispatchCustom(s.Ctx, contract, msg)
+	s.Require().NoError(err)
+
+	var res markettypes.MsgSwapResponse
+	s.Require().NoError(json.Unmarshal(data[0], &res))
+	s.Require().Equal(expected, res.SwapCoin.Amount)
+	s.Require().Equal(expected, s.App.BankKeeper.GetBalance(s.Ctx, recipient, core.MicroSDRDenom).Amount)
+	s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, contract).IsZero())
+}
+
+// go test -v -run ^TestWasmTestSuite/TestInterchainAccountMsgs$ github.com/classic-terra/core/v3/wasmbinding/test
+func (s *WasmTestSuite) TestInterchainAccountMsgs() {
+	s.SetupTest()
+	contract := s.RandomAccountAddresses(1)[0]
+
+	// the connection does not exist
+	_, err := s.dispatchCustom(s.Ctx, contract, bindings.TerraMsg{
+		RegisterInterchainAccount: &bindings.RegisterInterchainAccount{ConnectionID: "connection-0"},
+	})
+	s.Require().ErrorIs(err, connectiontypes.ErrConnectionNotFound)
+
+	_, err = s.dispatchCustom(s.Ctx, contract, bindings.TerraMsg{
+		SubmitInterchainTx: &bindings.SubmitInterchainTx{
+			ConnectionID: "connection-0",
+			Msgs:         []bindings.ProtoMsg{{TypeURL: "/cosmos.bank.v1beta1.MsgSend", Value: []byte{}}},
+		},
+	})
+	s.Require().ErrorIs(err, icaauthtypes.ErrInterchainAccountMissing)
+
+	_, err = s.dispatchCustom(s.Ctx, contract, bindings.TerraMsg{
+		SubmitInterchainTx: &bindings.SubmitInterchainTx{ConnectionID: "connection-0"},
+	})
+	s.Require().ErrorIs(err, icaauthtypes.ErrEmptyMsgs)
+}
@@ -6,6 +6,7 @@ import (
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 
 	core "github.com/classic-terra/core/v3/types"
+	"github.com/classic-terra/core/v3/wasmbinding"
 	"github.com/classic-terra/core/v3/wasmbinding/bindings"
 	markettypes "github.com/classic-terra/core/v3/x/market/types"
 	treasurytypes "github.com/classic-terra/core/v3/x/treasury/types"
@@ -231,3 +232,99 @@ func (s *WasmTestSuite) queryStargate(contract sdk.AccAddress, request bindings.
 	err = json.Unmarshal(resBz, response)
 	s.Require().NoError(err)
 }
+
+// go test -v -run ^TestWasmTestSuite/TestQueryModules$ github.com/classic-terra/core/v3/wasmbinding/test
+func (s *WasmTestSuite) TestQueryModules() {
+	s.SetupTest()
+	actor := s.RandomAccountAddresses(1)[0]
+
+	valAddr := sdk.ValAddress(s.RandomAccountAddresses(1)[0])
+	feeder := s.RandomAccountAddresses(1)[0]
+	validator := &bindings.ValidatorQueryParams{ValidatorAddr: valAddr.String()}
+
+	s.App.OracleKeeper.SetLunaExchangeRate(s.Ctx, core.MicroSDRDenom, sdk.NewDecWithPrec(17, 1))
+	s.App.OracleKeeper.SetTobinTax(s.Ctx, core.MicroSDRDenom, sdk.NewDecWithPrec(25, 4))
+	s.App.OracleKeeper.SetFeederDelegation(s.Ctx, valAddr, feeder)
+	s.App.OracleKeeper.SetMissCounter(s.Ctx, valAddr, 3)
+	s.App.MarketKeeper.SetTerraPoolDelta(s.Ctx, sdk.NewDec(-1000))
+	s.App.TreasuryKeeper.AddBurnTaxExemptionAddress(s.Ctx, actor.String())
+	s.App.DyncommKeeper.SetDynCommissionRate(s.Ctx, valAddr.String(), sdk.NewDecWithPrec(5, 2))
+	s.App.DyncommKeeper.SetTargetCommissionRate(s.Ctx, valAddr.String(), sdk.NewDecWithPrec(1, 1))
+
+	burnTaxRate := bindings.BurnTaxRateQueryResponse{}
+	s.queryPlugin(bindings.TerraQuery{BurnTaxRate: &struct{}{}}, &burnTaxRate)
+	s.Require().Equal(s.App.TaxKeeper.GetBurnTaxRate(s.Ctx).String(), burnTaxRate.Rate)
+
+	gasPrices := bindings.GasPricesQueryResponse{}
+	s.queryPlugin(bindings.TerraQuery{GasPrices: &struct{}{}}, &gasPrices)
+	s.Require().Len(gasPrices.GasPrices, len(s.App.TaxKeeper.GetGasPrices(s.Ctx)))
+
+	oracleParams := bindings.OracleParamsQueryResponse{}
+	s.queryPlugin(bindings.TerraQuery{OracleParams: &struct{}{}}, &oracleParams)
+	s.Require().Equal(s.App.OracleKeeper.VotePeriod(s.Ctx), oracleParams.VotePeriod)
+	s.Require().Len(oracleParams.Whitelist, len(s.App.OracleKeeper.Whitelist(s.Ctx)))
+
+	actives := bindings.ActivesQueryResponse{}
+	s.queryPlugin(bindings.TerraQuery{Actives: &struct{}{}}, &actives)
+	s.Require().Equal([]string{core.MicroSDRDenom}, actives.Actives)
+
+	voteTargets := bindings.VoteTargetsQueryResponse{}
+	s.queryPlugin(bindings.TerraQuery{VoteTargets: &struct{}{}}, &voteTargets)
+	s.Require().Contains(voteTargets.VoteTargets, core.MicroSDRDenom)
+
+	tobinTaxes := bindings.TobinTaxesQueryResponse{}
+	s.queryPlugin(bindings.TerraQuery{TobinTaxes: &struct{}{}}, &tobinTaxes)
+	s.Require().Contains(tobinTaxes.TobinTaxes, bindings.DenomItem{Name: core.MicroSDRDenom, TobinTax: sdk.NewDecWithPrec(25, 4).String()})
+
+	feederDelegation := bindings.FeederDelegationQueryResponse{}
+	s.queryPlugin(bindings.TerraQuery{FeederDelegation: validator}, &feederDelegation)
+	s.Require().Equal(feeder.String(), feederDelegation.FeederAddr)
+
+	missCounter := bindings.MissCounterQueryResponse{}
+	s.queryPlugin(bindings.TerraQuery{MissCounter: validator}, &missCounter)
+	s.Require().Equal(uint64(3), missCounter.MissCounter)
+
+	marketParams := bindings.MarketParamsQueryResponse{}
+	s.queryPlugin(bindings.TerraQuery{MarketParams: &struct{}{}}, &marketParams)
+	s.Require().Equal(markettypes.DefaultMinStabilitySpread.String(), marketParams.MinStabilitySpread)
+
+	terraPoolDelta := bindings.TerraPoolDeltaQueryResponse{}
+	s.queryPlugin(bindings.TerraQuery{TerraPoolDelta: &struct{}{}}, &terraPoolDelta)
+	s.Require().Equal(sdk.NewDec(-1000).String(), terraPoolDelta.TerraPoolDelta)
+
+	indicators := bindings.TreasuryIndicatorsQueryResponse{}
+	s.queryPlugin(bindings.TerraQuery{TreasuryIndicators: &struct{}{}}, &indicators)
+	s.Require().NotEmpty(indicators.TRLYear)
+
+	exemption := bindings.BurnTaxExemptionQueryResponse{}
+	s.queryPlugin(bindings.TerraQuery{BurnTaxExemption: &bindings.BurnTaxExemptionQueryParams{Addresses: []string{actor.String()}}}, &exemption)
+	s.Require().True(exemption.Exempt)
+	s.queryPlugin(bindings.TerraQuery{BurnTaxExemption: &bindings.BurnTaxExemptionQueryParams{Addresses: []string{actor.String(), feeder.String()}}}, &exemption)
+	s.Require().False(exemption.Exempt)
+
+	dyncommRate := bindings.DyncommRateQueryResponse{}
+	s.queryPlugin(bindings.TerraQuery{DyncommRate: validator}, &dyncommRate)
+	s.Require().Equal(bindings.DyncommRateQueryResponse{
+		Rate:   sdk.NewDecWithPrec(5, 2).String(),
+		Target: sdk.NewDecWithPrec(1, 1).String(),
+	}, dyncommRate)
+}
+
+// queryPlugin runs a custom query without a contract, the reflect contract only
+// forwards the original query variants.
+func (s *WasmTestSuite) queryPlugin(request bindings.TerraQuery, response interface{}) {
+	querier := wasmbinding.CustomQuerier(wasmbinding.NewQueryPlugin(
+		&s.App.MarketKeeper,
+		&s.App.OracleKeeper,
+		&s.App.TreasuryKeeper,
+		&s.App.TaxKeeper,
+		&s.App.DyncommKeeper,
+	))
+
+	requestBz, err := json.Marshal(request)
+	s.Require().NoError(err)
+
+	resBz, err := querier(s.Ctx, requestBz)
+	s.Require().NoError(err)
+	s.Require().NoError(json.Unmarshal(resBz, response))
+}
@@ -0,0 +1,41 @@
+package wasmbinding_test
+
+import (
+	"encoding/json"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/classic-terra/core/v3/wasmbinding/bindings"
+)
+
+// go test -v -run ^TestTerraQuerySchema$ github.com/classic-terra/core/v3/wasmbinding/test
+func TestTerraQuerySchema(t *testing.T) {
+	bz, err := os.ReadFile("../schema/v1/terra_query.json")
+	require.NoError(t, err)
+
+	var schema struct {
+		OneOf []struct {
+			Required []string `json:"required"`
+		} `json:"oneOf"`
+		Responses map[string]json.RawMessage `json:"responses"`
+	}
+	require.NoError(t, json.Unmarshal(bz, &schema))
+
+	variants := map[string]bool{}
+	for _, variant := range schema.OneOf {
+		require.Len(t, variant.Required, 1)
+		variants[variant.Required[0]] = true
+	}
+
+	queryType := reflect.TypeOf(bindings.TerraQuery{})
+	for i := 0; i < queryType.NumField(); i++ {
+		name := strings.Split(queryType.Field(i).Tag.Get("json"), ",")[0]
+		require.True(t, variants[name], "query %s is missing in the schema", name)
+		require.Contains(t, schema.Responses, name)
+	}
+	require.Len(t, variants, queryType.NumField())
+}
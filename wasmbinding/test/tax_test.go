@@ -3,9 +3,12 @@ package wasmbinding_test
 import (
 	"encoding/json"
 
+	sdkmath "cosmossdk.io/math"
+
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 	core "github.com/classic-terra/core/v3/types"
+	taxtypes "github.com/classic-terra/core/v3/x/tax/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -55,3 +58,123 @@ func (s *WasmTestSuite) TestTax() {
 	res := s.App.BankKeeper.GetAllBalances(s.Ctx, payer)
 	s.Require().Equal(sdk.NewInt(1000000000).Sub(updateAmt), res.AmountOf(core.MicroLunaDenom))
 }
+
+// go test -v -run ^TestWasmTestSuite/TestContractTaxPolicy$ github.com/classic-terra/core/v3/wasmbinding/test
+func (s *WasmTestSuite) TestContractTaxPolicy() {
+	s.SetupTest()
+	coin := sdk.NewInt64Coin(core.MicroLunaDenom, 10000)
+	taxAmount := sdk.NewDecFromInt(coin.Amount).Mul(s.App.TaxKeeper.GetBurnTaxRate(s.Ctx)).TruncateInt()
+
+	testCases := []struct {
+		name       string
+		policy     func(contract sdk.AccAddress, codeID uint64) taxtypes.ContractTaxPolicy
+		prepaid    sdkmath.Int
+		executions int
+		charged    sdkmath.Int
+	}{
+		{
+			name: "reverse charge by default",
+			policy: func(sdk.AccAddress, uint64) taxtypes.ContractTaxPolicy {
+				return taxtypes.ContractTaxPolicy{}
+			},
+			executions: 2,
+			charged:    taxAmount.MulRaw(2),
+		},
+		{
+			name: "exempt contract",
+			policy: func(contract sdk.AccAddress, _ uint64) taxtypes.ContractTaxPolicy {
+				return taxtypes.NewContractTaxPolicy(contract.String(), taxtypes.TaxPolicyModeExempt, nil)
+			},
+			executions: 2,
+			charged:    sdkmath.ZeroInt(),
+		},
+		{
+			name: "exempt code",
+			policy: func(_ sdk.AccAddress, codeID uint64) taxtypes.ContractTaxPolicy {
+				return taxtypes.NewCodeTaxPolicy(codeID, taxtypes.TaxPolicyModeExempt, nil)
+			},
+			executions: 2,
+			charged:    sdkmath.ZeroInt(),
+		},
+		{
+			name: "sender pays upfront without prepaid tax",
+			policy: func(contract sdk.AccAddress, _ uint64) taxtypes.ContractTaxPolicy {
+				return taxtypes.NewContractTaxPolicy(contract.String(), taxtypes.TaxPolicyModeSenderPaysUpfront, nil)
+			},
+			executions: 2,
+			charged:    taxAmount.MulRaw(2),
+		},
+		{
+			name: "sender pays upfront with prepaid tax",
+			policy: func(contract sdk.AccAddress, _ uint64) taxtypes.ContractTaxPolicy {
+				return taxtypes.NewContractTaxPolicy(contract.String(), taxtypes.TaxPolicyModeSenderPaysUpfront, nil)
+			},
+			prepaid:    taxAmount,
+			executions: 2,
+			charged:    taxAmount,
+		},
+		{
+			name: "capped per block",
+			policy: func(contract sdk.AccAddress, _ uint64) taxtypes.ContractTaxPolicy {
+				capPerBlock := sdk.NewCoins(sdk.NewCoin(core.MicroLunaDenom, taxAmount.AddRaw(10)))
+				return taxtypes.NewContractTaxPolicy(contract.String(), taxtypes.TaxPolicyModeCappedPerBlock, capPerBlock)
+			},
+			executions: 2,
+			charged:    taxAmount.AddRaw(10),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			payer := s.TestAccs[0]
+			toAddress := s.TestAccs[1]
+			s.FundAcc(payer, sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 1000000000)))
+
+			contractAddr := s.InstantiateContract(payer, TerraBindingsPath)
+			policy := tc.policy(contractAddr, s.App.WasmKeeper.GetContractInfo(s.Ctx, contractAddr).CodeID)
+			if policy.ContractAddress != "" || policy.CodeId != 0 {
+				s.Require().NoError(s.App.TaxKeeper.SetContractTaxPolicy(s.Ctx, policy))
+			}
+
+			sent := coin.Amount.MulRaw(int64(tc.executions))
+			s.FundAcc(contractAddr, sdk.NewCoins(sdk.NewCoin(core.MicroLunaDenom, sent)))
+			before := s.App.BankKeeper.GetBalance(s.Ctx, toAddress, core.MicroLunaDenom).Amount
+
+			reflectBz, err := json.Marshal(ReflectExec{
+				ReflectMsg: &ReflectMsgs{
+					Msgs: []wasmvmtypes.CosmosMsg{{
+						Bank: &wasmvmtypes.BankMsg{
+							Send: &wasmvmtypes.SendMsg{
+								ToAddress: toAddress.String(),
+								Amount: []wasmvmtypes.Coin{{
+									Denom:  coin.Denom,
+									Amount: coin.Amount.String(),
+								}},
+							},
+						},
+					}},
+				},
+			})
+			s.Require().NoError(err)
+
+			// the prepaid tax covers the taxes of all executions
+			if !tc.prepaid.IsNil() {
+				prepaid := taxtypes.NewPrepaidTax()
+				prepaid.Add(contractAddr.String(), sdk.NewCoins(sdk.NewCoin(core.MicroLunaDenom, tc.prepaid)))
+				s.Ctx = s.Ctx.WithValue(taxtypes.ContextKeyTaxPrepaid, prepaid)
+			}
+
+			contractKeeper := wasmkeeper.NewDefaultPermissionKeeper(s.App.WasmKeeper)
+			for i := 0; i < tc.executions; i++ {
+				_, err = contractKeeper.Execute(s.Ctx, contractAddr, payer, reflectBz, nil)
+				s.Require().NoError(err)
+			}
+
+			// the tax is deducted from the amount the recipient receives
+			received := s.App.BankKeeper.GetBalance(s.Ctx, toAddress, core.MicroLunaDenom).Amount.Sub(before)
+			s.Require().Equal(sent.Sub(tc.charged), received)
+		})
+	}
+}
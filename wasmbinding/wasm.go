@@ -6,27 +6,34 @@ import (
 
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 
+	dyncommkeeper "github.com/classic-terra/core/v3/x/dyncomm/keeper"
 	marketkeeper "github.com/classic-terra/core/v3/x/market/keeper"
 	oraclekeeper "github.com/classic-terra/core/v3/x/oracle/keeper"
+	taxkeeper "github.com/classic-terra/core/v3/x/tax/keeper"
 	treasurykeeper "github.com/classic-terra/core/v3/x/treasury/keeper"
 )
 
 func RegisterCustomPlugins(
+	router wasmkeeper.MessageRouter,
 	marketKeeper *marketkeeper.Keeper,
 	oracleKeeper *oraclekeeper.Keeper,
 	treasuryKeeper *treasurykeeper.Keeper,
+	taxKeeper *taxkeeper.Keeper,
+	dyncommKeeper *dyncommkeeper.Keeper,
 ) []wasmkeeper.Option {
 	wasmQueryPlugin := NewQueryPlugin(
 		marketKeeper,
 		oracleKeeper,
 		treasuryKeeper,
+		taxKeeper,
+		dyncommKeeper,
 	)
 
 	queryPluginOpt := wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{
 		Custom: CustomQuerier(wasmQueryPlugin),
 	})
 	messengerDecoratorOpt := wasmkeeper.WithMessageHandlerDecorator(
-		CustomMessageDecorator(marketKeeper),
+		CustomMessageDecorator(router, marketKeeper, taxKeeper),
 	)
 
 	return []wasmkeeper.Option{
@@ -35,9 +42,9 @@ func RegisterCustomPlugins(
 	}
 }
 
-func RegisterStargateQueries(queryRouter baseapp.GRPCQueryRouter, codec codec.Codec) []wasmkeeper.Option {
+func RegisterStargateQueries(queryRouter baseapp.GRPCQueryRouter, codec codec.Codec, whitelist StargateWhitelist) []wasmkeeper.Option {
 	queryPluginOpt := wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{
-		Stargate: StargateQuerier(queryRouter, codec),
+		Stargate: StargateQuerier(queryRouter, codec, whitelist),
 	})
 
 	return []wasmkeeper.Option{
@@ -0,0 +1,26 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	circuitbreakerkeeper "github.com/classic-terra/core/v3/x/circuitbreaker/keeper"
+)
+
+// CircuitBreakerDecorator rejects transactions with messages of tripped
+// breakers. Contract only breakers are enforced when contracts dispatch
+// messages, see keeper.MsgRouter.
+type CircuitBreakerDecorator struct {
+	keeper circuitbreakerkeeper.Keeper
+}
+
+func NewCircuitBreakerDecorator(keeper circuitbreakerkeeper.Keeper) CircuitBreakerDecorator {
+	return CircuitBreakerDecorator{keeper: keeper}
+}
+
+func (cbd CircuitBreakerDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if err := cbd.keeper.CheckMsgs(ctx, tx.GetMsgs(), false); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,95 @@
+package cli
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+
+	"github.com/classic-terra/core/v3/x/circuitbreaker/types"
+)
+
+const flagContractOnly = "contract-only"
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	circuitbreakerTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Circuit breaker transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	circuitbreakerTxCmd.AddCommand(
+		GetTripCmd(),
+		GetResetCmd(),
+	)
+
+	return circuitbreakerTxCmd
+}
+
+// GetTripCmd will create and send a MsgTripCircuitBreaker
+func GetTripCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trip [type-url]...",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "Trip the circuit breakers of message type urls or proto packages",
+		Long: strings.TrimSpace(`
+Trip the circuit breakers of message type urls or proto packages, only the
+emergency authority before its expiry can sign it outside of governance.
+
+$ terrad tx circuitbreaker trip /cosmos.bank.v1beta1.MsgSend /terra.market.v1beta1 --from emergency-multisig --generate-only
+$ terrad tx circuitbreaker trip /cosmwasm.wasm.v1.MsgExecuteContract --contract-only --from emergency-multisig --generate-only
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractOnly, err := cmd.Flags().GetBool(flagContractOnly)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTripCircuitBreaker(clientCtx.GetFromAddress().String(), args, contractOnly)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(flagContractOnly, false, "Reject the messages only when they are dispatched from contracts")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetResetCmd will create and send a MsgResetCircuitBreaker
+func GetResetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reset [type-url]...",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "Reset the tripped circuit breakers of message type urls or proto packages",
+		Long: strings.TrimSpace(`
+Reset the tripped circuit breakers of message type urls or proto packages.
+
+$ terrad tx circuitbreaker reset /cosmos.bank.v1beta1.MsgSend --from emergency-multisig --generate-only
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgResetCircuitBreaker(clientCtx.GetFromAddress().String(), args)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
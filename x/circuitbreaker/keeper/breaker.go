@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	"github.com/classic-terra/core/v3/x/circuitbreaker/types"
+)
+
+// SetBreaker stores a tripped breaker.
+func (k Keeper) SetBreaker(ctx sdk.Context, breaker types.Breaker) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetBreakerKey(breaker.TypeUrl), k.cdc.MustMarshal(&breaker))
+}
+
+// GetBreaker returns the tripped breaker of a type url.
+func (k Keeper) GetBreaker(ctx sdk.Context, typeURL string) (breaker types.Breaker, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetBreakerKey(typeURL))
+	if bz == nil {
+		return breaker, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &breaker)
+	return breaker, true
+}
+
+// DeleteBreaker removes the breaker of a type url.
+func (k Keeper) DeleteBreaker(ctx sdk.Context, typeURL string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetBreakerKey(typeURL))
+}
+
+// IterateBreakers iterates over all tripped breakers.
+func (k Keeper) IterateBreakers(ctx sdk.Context, cb func(breaker types.Breaker) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BreakerKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var breaker types.Breaker
+		k.cdc.MustUnmarshal(iterator.Value(), &breaker)
+		if cb(breaker) {
+			break
+		}
+	}
+}
+
+// GetAllBreakers returns all tripped breakers.
+func (k Keeper) GetAllBreakers(ctx sdk.Context) []types.Breaker {
+	breakers := []types.Breaker{}
+	k.IterateBreakers(ctx, func(breaker types.Breaker) bool {
+		breakers = append(breakers, breaker)
+		return false
+	})
+
+	return breakers
+}
+
+// TripBreakers trips the breakers of the type urls, replacing the ones already
+// tripped. Breakers tripped by the module authority can only be replaced by it.
+func (k Keeper) TripBreakers(ctx sdk.Context, authority string, typeURLs []string, contractOnly bool) error {
+	if err := types.ValidateTypeURLs(typeURLs); err != nil {
+		return err
+	}
+
+	for _, typeURL := range typeURLs {
+		if breaker, found := k.GetBreaker(ctx, typeURL); found {
+			if err := k.checkBreakerOwner(breaker, authority); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, typeURL := range typeURLs {
+		k.SetBreaker(ctx, types.NewBreaker(typeURL, contractOnly, authority, ctx.BlockHeight()))
+	}
+
+	return nil
+}
+
+// ResetBreakers resets the tripped breakers of the type urls. Breakers tripped
+// by the module authority can only be reset by it.
+func (k Keeper) ResetBreakers(ctx sdk.Context, authority string, typeURLs []string) error {
+	for _, typeURL := range typeURLs {
+		breaker, found := k.GetBreaker(ctx, typeURL)
+		if !found {
+			return errorsmod.Wrap(types.ErrBreakerNotFound, typeURL)
+		}
+
+		if err := k.checkBreakerOwner(breaker, authority); err != nil {
+			return err
+		}
+	}
+
+	for _, typeURL := range typeURLs {
+		k.DeleteBreaker(ctx, typeURL)
+	}
+
+	return nil
+}
+
+// CheckMsgs returns an error when a message, or a message nested in an authz
+// MsgExec, is rejected by a tripped breaker. Contract only breakers reject
+// messages only when they are dispatched from contracts.
+func (k Keeper) CheckMsgs(ctx sdk.Context, msgs []sdk.Msg, fromContract bool) error {
+	for _, msg := range msgs {
+		typeURL := sdk.MsgTypeURL(msg)
+		for _, match := range types.MatchingTypeURLs(typeURL) {
+			if breaker, found := k.GetBreaker(ctx, match); found && breaker.Rejects(fromContract) {
+				return errorsmod.Wrapf(types.ErrBreakerTripped, "%s by breaker of %s", typeURL, match)
+			}
+		}
+
+		if exec, ok := msg.(*authz.MsgExec); ok {
+			nested, err := exec.GetMessages()
+			if err != nil {
+				return err
+			}
+
+			if err := k.CheckMsgs(ctx, nested, fromContract); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkBreakerOwner returns an error when the emergency authority changes a
+// breaker tripped by the module authority.
+func (k Keeper) checkBreakerOwner(breaker types.Breaker, authority string) error {
+	if breaker.TrippedBy == k.authority && authority != k.authority {
+		return errorsmod.Wrapf(types.ErrUnauthorized, "breaker of %s was tripped by %s", breaker.TypeUrl, k.authority)
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/classic-terra/core/v3/x/circuitbreaker/types"
+)
+
+type Keeper struct {
+	storeKey storetypes.StoreKey
+	cdc      codec.BinaryCodec
+
+	// the address capable of managing the parameters and breakers. Typically,
+	// this should be the x/gov module account.
+	authority string
+}
+
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey storetypes.StoreKey,
+	authority string,
+) Keeper {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(fmt.Errorf("invalid circuitbreaker authority address: %w", err))
+	}
+
+	return Keeper{cdc: cdc, storeKey: storeKey, authority: authority}
+}
+
+// InitGenesis initializes the circuitbreaker module's state from a provided
+// genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	if err := genState.Validate(); err != nil {
+		panic(err)
+	}
+
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		panic(err)
+	}
+
+	for _, breaker := range genState.Breakers {
+		k.SetBreaker(ctx, breaker)
+	}
+}
+
+// ExportGenesis returns the circuitbreaker module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return types.NewGenesisState(k.GetParams(ctx), k.GetAllBreakers(ctx))
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetAuthority returns the x/circuitbreaker module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// IsBreakerAuthority returns true when addr can trip and reset breakers, which
+// is the module authority or the emergency authority before its expiry.
+func (k Keeper) IsBreakerAuthority(ctx sdk.Context, addr string) bool {
+	return addr == k.authority || k.GetParams(ctx).IsEmergencyAuthority(addr, ctx.BlockTime())
+}
+
+// SetParams sets the circuitbreaker module's parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(types.ParamsKey, bz)
+	return nil
+}
+
+// GetParams gets the circuitbreaker module's parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return types.DefaultParams()
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
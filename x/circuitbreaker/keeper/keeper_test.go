@@ -0,0 +1,196 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	apptesting "github.com/classic-terra/core/v3/app/testing"
+	core "github.com/classic-terra/core/v3/types"
+	"github.com/classic-terra/core/v3/x/circuitbreaker/keeper"
+	"github.com/classic-terra/core/v3/x/circuitbreaker/types"
+	markettypes "github.com/classic-terra/core/v3/x/market/types"
+	taxtypes "github.com/classic-terra/core/v3/x/tax/types"
+)
+
+const (
+	msgSendTypeURL = "/cosmos.bank.v1beta1.MsgSend"
+	marketPackage  = "/terra.market.v1beta1"
+)
+
+type KeeperTestSuite struct {
+	apptesting.KeeperTestHelper
+
+	keeper    keeper.Keeper
+	msgServer types.MsgServer
+	authority string
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.Setup(s.T(), apptesting.SimAppChainID)
+	s.authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	s.keeper = keeper.NewKeeper(s.App.AppCodec(), s.App.GetKey(types.StoreKey), s.authority)
+	s.msgServer = keeper.NewMsgServerImpl(s.keeper)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) msgSend() *banktypes.MsgSend {
+	return banktypes.NewMsgSend(s.TestAccs[0], s.TestAccs[1], sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 1)))
+}
+
+func (s *KeeperTestSuite) TestCheckMsgs() {
+	msgSend := s.msgSend()
+	msgSwap := markettypes.NewMsgSwap(s.TestAccs[0], sdk.NewInt64Coin(core.MicroLunaDenom, 1), core.MicroSDRDenom)
+	msgExecute := &wasmtypes.MsgExecuteContract{Sender: s.TestAccs[0].String(), Contract: s.TestAccs[1].String(), Msg: []byte("{}")}
+	msgExec := authz.NewMsgExec(s.TestAccs[1], []sdk.Msg{msgSend})
+
+	s.Require().NoError(s.keeper.CheckMsgs(s.Ctx, []sdk.Msg{msgSend, msgSwap, msgExecute, &msgExec}, true))
+
+	// message type url
+	s.Require().NoError(s.keeper.TripBreakers(s.Ctx, s.authority, []string{msgSendTypeURL}, false))
+	s.Require().ErrorIs(s.keeper.CheckMsgs(s.Ctx, []sdk.Msg{msgSend}, false), types.ErrBreakerTripped)
+	s.Require().ErrorIs(s.keeper.CheckMsgs(s.Ctx, []sdk.Msg{msgSend}, true), types.ErrBreakerTripped)
+
+	// nested in authz exec
+	s.Require().ErrorIs(s.keeper.CheckMsgs(s.Ctx, []sdk.Msg{&msgExec}, false), types.ErrBreakerTripped)
+
+	// proto package of a module
+	s.Require().NoError(s.keeper.CheckMsgs(s.Ctx, []sdk.Msg{msgSwap}, false))
+	s.Require().NoError(s.keeper.TripBreakers(s.Ctx, s.authority, []string{marketPackage}, false))
+	s.Require().ErrorIs(s.keeper.CheckMsgs(s.Ctx, []sdk.Msg{msgSwap}, false), types.ErrBreakerTripped)
+
+	// contract only
+	s.Require().NoError(s.keeper.TripBreakers(s.Ctx, s.authority, []string{sdk.MsgTypeURL(msgExecute)}, true))
+	s.Require().NoError(s.keeper.CheckMsgs(s.Ctx, []sdk.Msg{msgExecute}, false))
+	s.Require().ErrorIs(s.keeper.CheckMsgs(s.Ctx, []sdk.Msg{msgExecute}, true), types.ErrBreakerTripped)
+
+	s.Require().Len(s.keeper.GetAllBreakers(s.Ctx), 3)
+
+	// reset
+	s.Require().ErrorIs(s.keeper.ResetBreakers(s.Ctx, s.authority, []string{msgSendTypeURL, "/cosmos.bank.v1beta1.MsgMultiSend"}), types.ErrBreakerNotFound)
+	s.Require().NoError(s.keeper.ResetBreakers(s.Ctx, s.authority, []string{msgSendTypeURL, marketPackage}))
+	s.Require().NoError(s.keeper.CheckMsgs(s.Ctx, []sdk.Msg{msgSend, msgSwap, &msgExec}, true))
+	s.Require().Len(s.keeper.GetAllBreakers(s.Ctx), 1)
+}
+
+func (s *KeeperTestSuite) TestProtectedTypeURLs() {
+	for _, typeURL := range []string{
+		sdk.MsgTypeURL(&govv1.MsgSubmitProposal{}),
+		"/cosmos.gov.v1beta1",
+		sdk.MsgTypeURL(&types.MsgResetCircuitBreaker{}),
+		"/terra.circuitbreaker.v1beta1",
+	} {
+		s.Require().ErrorIs(s.keeper.TripBreakers(s.Ctx, s.authority, []string{typeURL}, false), types.ErrProtectedTypeURL, typeURL)
+	}
+
+	for _, typeURL := range []string{"", "/", "cosmos.bank.v1beta1.MsgSend", "/cosmos.bank.", "/cosmos bank"} {
+		s.Require().ErrorIs(s.keeper.TripBreakers(s.Ctx, s.authority, []string{typeURL}, false), types.ErrInvalidTypeURL, typeURL)
+	}
+
+	s.Require().ErrorIs(s.keeper.TripBreakers(s.Ctx, s.authority, []string{msgSendTypeURL, msgSendTypeURL}, false), types.ErrInvalidTypeURL)
+}
+
+func (s *KeeperTestSuite) TestEmergencyAuthority() {
+	emergency := s.TestAccs[2].String()
+	ctx := sdk.WrapSDKContext(s.Ctx)
+
+	// unknown authority
+	_, err := s.msgServer.TripCircuitBreaker(ctx, types.NewMsgTripCircuitBreaker(emergency, []string{msgSendTypeURL}, false))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// only governance updates the emergency authority
+	params := types.Params{EmergencyAuthority: emergency, EmergencyAuthorityExpiry: s.Ctx.BlockTime().Add(time.Hour)}
+	_, err = s.msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: emergency, Params: params})
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+	_, err = s.msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: s.authority, Params: params})
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.TripCircuitBreaker(ctx, types.NewMsgTripCircuitBreaker(emergency, []string{msgSendTypeURL}, false))
+	s.Require().NoError(err)
+
+	breaker, found := s.keeper.GetBreaker(s.Ctx, msgSendTypeURL)
+	s.Require().True(found)
+	s.Require().Equal(types.NewBreaker(msgSendTypeURL, false, emergency, s.Ctx.BlockHeight()), breaker)
+
+	_, err = s.msgServer.ResetCircuitBreaker(ctx, types.NewMsgResetCircuitBreaker(emergency, []string{msgSendTypeURL}))
+	s.Require().NoError(err)
+
+	// breakers tripped by governance are kept from the emergency authority
+	_, err = s.msgServer.TripCircuitBreaker(ctx, types.NewMsgTripCircuitBreaker(s.authority, []string{msgSendTypeURL}, false))
+	s.Require().NoError(err)
+	_, err = s.msgServer.TripCircuitBreaker(ctx, types.NewMsgTripCircuitBreaker(emergency, []string{msgSendTypeURL}, true))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = s.msgServer.ResetCircuitBreaker(ctx, types.NewMsgResetCircuitBreaker(emergency, []string{msgSendTypeURL}))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	breaker, found = s.keeper.GetBreaker(s.Ctx, msgSendTypeURL)
+	s.Require().True(found)
+	s.Require().Equal(types.NewBreaker(msgSendTypeURL, false, s.authority, s.Ctx.BlockHeight()), breaker)
+
+	// governance replaces the breakers of the emergency authority
+	_, err = s.msgServer.ResetCircuitBreaker(ctx, types.NewMsgResetCircuitBreaker(s.authority, []string{msgSendTypeURL}))
+	s.Require().NoError(err)
+	_, err = s.msgServer.TripCircuitBreaker(ctx, types.NewMsgTripCircuitBreaker(emergency, []string{msgSendTypeURL}, false))
+	s.Require().NoError(err)
+	_, err = s.msgServer.TripCircuitBreaker(ctx, types.NewMsgTripCircuitBreaker(s.authority, []string{msgSendTypeURL}, true))
+	s.Require().NoError(err)
+
+	// the emergency authority expired, governance keeps its authority
+	expiredCtx := sdk.WrapSDKContext(s.Ctx.WithBlockTime(params.EmergencyAuthorityExpiry))
+	_, err = s.msgServer.TripCircuitBreaker(expiredCtx, types.NewMsgTripCircuitBreaker(emergency, []string{msgSendTypeURL}, false))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = s.msgServer.TripCircuitBreaker(expiredCtx, types.NewMsgTripCircuitBreaker(s.authority, []string{msgSendTypeURL}, false))
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestMsgRouter() {
+	s.FundAcc(s.TestAccs[0], sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 10)))
+	msgSend := s.msgSend()
+
+	// set by the tax fee decorator of the ante handler
+	s.Ctx = s.Ctx.WithValue(taxtypes.ContextKeyTaxReverseCharge, false)
+
+	txRouter := keeper.NewMsgRouter(s.keeper, s.App.MsgServiceRouter(), false)
+	contractRouter := keeper.NewMsgRouter(s.keeper, s.App.MsgServiceRouter(), true)
+	s.Require().NoError(s.keeper.TripBreakers(s.Ctx, s.authority, []string{msgSendTypeURL}, true))
+
+	_, err := contractRouter.Handler(msgSend)(s.Ctx, msgSend)
+	s.Require().ErrorIs(err, types.ErrBreakerTripped)
+
+	_, err = txRouter.Handler(msgSend)(s.Ctx, msgSend)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin(core.MicroLunaDenom, 1), s.App.BankKeeper.GetBalance(s.Ctx, s.TestAccs[1], core.MicroLunaDenom))
+
+	s.Require().NoError(s.keeper.ResetBreakers(s.Ctx, s.authority, []string{msgSendTypeURL}))
+	_, err = contractRouter.Handler(msgSend)(s.Ctx, msgSend)
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	params := types.Params{EmergencyAuthority: s.TestAccs[2].String(), EmergencyAuthorityExpiry: time.Unix(1700000000, 0).UTC()}
+	genState := types.NewGenesisState(params, []types.Breaker{
+		types.NewBreaker(msgSendTypeURL, false, s.authority, 1),
+		types.NewBreaker(marketPackage, true, s.TestAccs[2].String(), 2),
+	})
+	s.keeper.InitGenesis(s.Ctx, genState)
+
+	exported := s.keeper.ExportGenesis(s.Ctx)
+	s.Require().Equal(genState.Params, exported.Params)
+	s.Require().ElementsMatch(genState.Breakers, exported.Breakers)
+
+	// duplicated breakers
+	genState.Breakers = append(genState.Breakers, genState.Breakers[0])
+	s.Require().Error(genState.Validate())
+}
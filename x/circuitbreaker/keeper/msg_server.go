@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/classic-terra/core/v3/x/circuitbreaker/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the circuitbreaker MsgServer
+// interface for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+func (k msgServer) TripCircuitBreaker(goCtx context.Context, req *types.MsgTripCircuitBreaker) (*types.MsgTripCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.IsBreakerAuthority(ctx, req.Authority) {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, req.Authority)
+	}
+
+	if err := k.TripBreakers(ctx, req.Authority, req.TypeUrls, req.ContractOnly); err != nil {
+		return nil, err
+	}
+
+	for _, typeURL := range req.TypeUrls {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeTripCircuitBreaker,
+				sdk.NewAttribute(types.AttributeKeyAuthority, req.Authority),
+				sdk.NewAttribute(types.AttributeKeyTypeURL, typeURL),
+				sdk.NewAttribute(types.AttributeKeyContractOnly, strconv.FormatBool(req.ContractOnly)),
+			),
+		)
+	}
+
+	return &types.MsgTripCircuitBreakerResponse{}, nil
+}
+
+func (k msgServer) ResetCircuitBreaker(goCtx context.Context, req *types.MsgResetCircuitBreaker) (*types.MsgResetCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.IsBreakerAuthority(ctx, req.Authority) {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, req.Authority)
+	}
+
+	if err := k.ResetBreakers(ctx, req.Authority, req.TypeUrls); err != nil {
+		return nil, err
+	}
+
+	for _, typeURL := range req.TypeUrls {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeResetCircuitBreaker,
+				sdk.NewAttribute(types.AttributeKeyAuthority, req.Authority),
+				sdk.NewAttribute(types.AttributeKeyTypeURL, typeURL),
+			),
+		)
+	}
+
+	return &types.MsgResetCircuitBreakerResponse{}, nil
+}
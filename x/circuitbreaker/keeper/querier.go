@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/classic-terra/core/v3/x/circuitbreaker/types"
+)
+
+// querier is used as Keeper will have duplicate methods if used directly, and gRPC names take precedence over q
+type querier struct {
+	Keeper
+}
+
+// NewQuerier returns an implementation of the circuitbreaker QueryServer
+// interface for the provided Keeper.
+func NewQuerier(keeper Keeper) types.QueryServer {
+	return &querier{Keeper: keeper}
+}
+
+var _ types.QueryServer = querier{}
+
+// Params queries the circuit breaker parameters
+func (q querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryParamsResponse{Params: q.GetParams(ctx)}, nil
+}
+
+// Breakers queries all tripped breakers
+func (q querier) Breakers(c context.Context, _ *types.QueryBreakersRequest) (*types.QueryBreakersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryBreakersResponse{Breakers: q.GetAllBreakers(ctx)}, nil
+}
+
+// Breaker queries the tripped breaker of a type url
+func (q querier) Breaker(c context.Context, req *types.QueryBreakerRequest) (*types.QueryBreakerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	breaker, found := q.GetBreaker(ctx, req.TypeUrl)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no breaker of %s", req.TypeUrl)
+	}
+
+	return &types.QueryBreakerResponse{Breaker: breaker}, nil
+}
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/classic-terra/core/v3/x/circuitbreaker/types"
+)
+
+// MsgRouter wraps a message router to reject the messages of tripped breakers
+// which do not go through the ante handler, such as the ones dispatched by
+// contracts or executed by interchain accounts.
+type MsgRouter struct {
+	keeper       Keeper
+	router       types.MessageRouter
+	fromContract bool
+}
+
+var _ types.MessageRouter = MsgRouter{}
+
+// NewMsgRouter returns a MsgRouter wrapping router. fromContract must be set
+// when the routed messages are dispatched by contracts.
+func NewMsgRouter(keeper Keeper, router types.MessageRouter, fromContract bool) MsgRouter {
+	return MsgRouter{keeper: keeper, router: router, fromContract: fromContract}
+}
+
+// Handler returns the handler of msg, which checks the breakers before
+// handling the message.
+func (r MsgRouter) Handler(msg sdk.Msg) baseapp.MsgServiceHandler {
+	handler := r.router.Handler(msg)
+	if handler == nil {
+		return nil
+	}
+
+	return func(ctx sdk.Context, req sdk.Msg) (*sdk.Result, error) {
+		if err := r.keeper.CheckMsgs(ctx, []sdk.Msg{req}, r.fromContract); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
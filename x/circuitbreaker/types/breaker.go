@@ -0,0 +1,92 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// protectedPackages are the proto packages whose messages cannot be tripped,
+// so that breakers can always be reset and governance keeps working.
+var protectedPackages = []string{
+	"/terra.circuitbreaker.",
+	"/cosmos.gov.",
+}
+
+// NewBreaker returns a new Breaker object
+func NewBreaker(typeURL string, contractOnly bool, trippedBy string, trippedHeight int64) Breaker {
+	return Breaker{
+		TypeUrl:       typeURL,
+		ContractOnly:  contractOnly,
+		TrippedBy:     trippedBy,
+		TrippedHeight: trippedHeight,
+	}
+}
+
+// ValidateTypeURL checks that a type url is a message type url or a proto
+// package which is allowed to be tripped.
+func ValidateTypeURL(typeURL string) error {
+	if !strings.HasPrefix(typeURL, "/") || len(typeURL) == 1 ||
+		strings.ContainsAny(typeURL, " \t\n") || strings.HasSuffix(typeURL, ".") {
+		return errorsmod.Wrap(ErrInvalidTypeURL, typeURL)
+	}
+
+	for _, pkg := range protectedPackages {
+		if strings.HasPrefix(typeURL+".", pkg) {
+			return errorsmod.Wrap(ErrProtectedTypeURL, typeURL)
+		}
+	}
+
+	return nil
+}
+
+// ValidateTypeURLs checks every type url and rejects an empty or duplicated list.
+func ValidateTypeURLs(typeURLs []string) error {
+	if len(typeURLs) == 0 {
+		return errorsmod.Wrap(ErrInvalidTypeURL, "empty type urls")
+	}
+
+	seen := make(map[string]bool, len(typeURLs))
+	for _, typeURL := range typeURLs {
+		if err := ValidateTypeURL(typeURL); err != nil {
+			return err
+		}
+		if seen[typeURL] {
+			return errorsmod.Wrapf(ErrInvalidTypeURL, "duplicated type url %s", typeURL)
+		}
+		seen[typeURL] = true
+	}
+
+	return nil
+}
+
+// MatchingTypeURLs returns the type urls a breaker can be tripped with to reject
+// the message of typeURL, the message type url and its proto package.
+func MatchingTypeURLs(typeURL string) []string {
+	idx := strings.LastIndex(typeURL, ".")
+	if idx <= 0 {
+		return []string{typeURL}
+	}
+
+	return []string{typeURL, typeURL[:idx]}
+}
+
+// Validate performs a basic validation of the breaker.
+func (b Breaker) Validate() error {
+	if err := ValidateTypeURL(b.TypeUrl); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(b.TrippedBy); err != nil {
+		return fmt.Errorf("invalid breaker authority: %w", err)
+	}
+
+	return nil
+}
+
+// Rejects returns true when the breaker rejects messages of the given origin.
+func (b Breaker) Rejects(fromContract bool) bool {
+	return !b.ContractOnly || fromContract
+}
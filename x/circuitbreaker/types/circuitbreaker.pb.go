@@ -0,0 +1,698 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: terra/circuitbreaker/v1beta1/circuitbreaker.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "github.com/gogo/protobuf/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the circuit breaker module.
+type Params struct {
+	// emergency_authority is an address, typically a small multisig, which can
+	// trip and reset breakers besides governance until emergency_authority_expiry.
+	EmergencyAuthority string `protobuf:"bytes,1,opt,name=emergency_authority,json=emergencyAuthority,proto3" json:"emergency_authority,omitempty"`
+	// emergency_authority_expiry is the time after which the emergency authority
+	// loses its permissions.
+	EmergencyAuthorityExpiry time.Time `protobuf:"bytes,2,opt,name=emergency_authority_expiry,json=emergencyAuthorityExpiry,proto3,stdtime" json:"emergency_authority_expiry"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7a9eab00ebb5dd1, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetEmergencyAuthority() string {
+	if m != nil {
+		return m.EmergencyAuthority
+	}
+	return ""
+}
+
+func (m *Params) GetEmergencyAuthorityExpiry() time.Time {
+	if m != nil {
+		return m.EmergencyAuthorityExpiry
+	}
+	return time.Time{}
+}
+
+// Breaker is a tripped circuit breaker which rejects the messages matching its
+// type url.
+type Breaker struct {
+	// type_url is either a message type url, e.g. /cosmos.bank.v1beta1.MsgSend,
+	// or a proto package, e.g. /terra.market.v1beta1, which matches every
+	// message of a module.
+	TypeUrl string `protobuf:"bytes,1,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty"`
+	// contract_only restricts the breaker to the messages dispatched by contracts.
+	ContractOnly bool `protobuf:"varint,2,opt,name=contract_only,json=contractOnly,proto3" json:"contract_only,omitempty"`
+	// tripped_by is the authority which tripped the breaker.
+	TrippedBy string `protobuf:"bytes,3,opt,name=tripped_by,json=trippedBy,proto3" json:"tripped_by,omitempty"`
+	// tripped_height is the block height at which the breaker was tripped.
+	TrippedHeight int64 `protobuf:"varint,4,opt,name=tripped_height,json=trippedHeight,proto3" json:"tripped_height,omitempty"`
+}
+
+func (m *Breaker) Reset()         { *m = Breaker{} }
+func (m *Breaker) String() string { return proto.CompactTextString(m) }
+func (*Breaker) ProtoMessage()    {}
+func (*Breaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7a9eab00ebb5dd1, []int{1}
+}
+func (m *Breaker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Breaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Breaker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Breaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Breaker.Merge(m, src)
+}
+func (m *Breaker) XXX_Size() int {
+	return m.Size()
+}
+func (m *Breaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_Breaker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Breaker proto.InternalMessageInfo
+
+func (m *Breaker) GetTypeUrl() string {
+	if m != nil {
+		return m.TypeUrl
+	}
+	return ""
+}
+
+func (m *Breaker) GetContractOnly() bool {
+	if m != nil {
+		return m.ContractOnly
+	}
+	return false
+}
+
+func (m *Breaker) GetTrippedBy() string {
+	if m != nil {
+		return m.TrippedBy
+	}
+	return ""
+}
+
+func (m *Breaker) GetTrippedHeight() int64 {
+	if m != nil {
+		return m.TrippedHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "terra.circuitbreaker.v1beta1.Params")
+	proto.RegisterType((*Breaker)(nil), "terra.circuitbreaker.v1beta1.Breaker")
+}
+
+func init() {
+	proto.RegisterFile("terra/circuitbreaker/v1beta1/circuitbreaker.proto", fileDescriptor_e7a9eab00ebb5dd1)
+}
+
+var fileDescriptor_e7a9eab00ebb5dd1 = []byte{
+	// 398 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xc1, 0x8e, 0xd3, 0x30,
+	0x10, 0x86, 0x63, 0x16, 0xed, 0x76, 0x0d, 0xcb, 0xc1, 0xec, 0x21, 0x1b, 0xa1, 0xb4, 0x5a, 0x84,
+	0xd4, 0xcb, 0xc6, 0x2a, 0x7b, 0xd8, 0xf3, 0x46, 0x42, 0x82, 0x13, 0x10, 0xe0, 0xc2, 0x25, 0x72,
+	0x5c, 0xe3, 0x58, 0x24, 0x71, 0x34, 0x76, 0xaa, 0xe6, 0x2d, 0xfa, 0x1a, 0xdc, 0x91, 0x78, 0x85,
+	0x1e, 0x2b, 0x4e, 0x9c, 0x00, 0xb5, 0x2f, 0x82, 0x1a, 0x27, 0x1c, 0x0a, 0xd2, 0xde, 0x3c, 0xbf,
+	0xe7, 0x9b, 0xf9, 0x67, 0x34, 0x78, 0x66, 0x05, 0x00, 0xa3, 0x5c, 0x01, 0x6f, 0x94, 0xcd, 0x40,
+	0xb0, 0xcf, 0x02, 0xe8, 0x62, 0x96, 0x09, 0xcb, 0x66, 0x07, 0x72, 0x54, 0x83, 0xb6, 0x9a, 0x3c,
+	0xe9, 0x90, 0xe8, 0xe0, 0xaf, 0x47, 0x82, 0x0b, 0xae, 0x4d, 0xa9, 0x4d, 0xda, 0xe5, 0x52, 0x17,
+	0x38, 0x30, 0x38, 0x97, 0x5a, 0x6a, 0xa7, 0xef, 0x5f, 0xbd, 0x3a, 0x96, 0x5a, 0xcb, 0x42, 0xd0,
+	0x2e, 0xca, 0x9a, 0x4f, 0xd4, 0xaa, 0x52, 0x18, 0xcb, 0xca, 0xda, 0x25, 0x5c, 0x7e, 0x43, 0xf8,
+	0xf8, 0x0d, 0x03, 0x56, 0x1a, 0xf2, 0x0a, 0x3f, 0x16, 0xa5, 0x00, 0x29, 0x2a, 0xde, 0xa6, 0xac,
+	0xb1, 0xb9, 0x06, 0x65, 0x5b, 0x1f, 0x4d, 0xd0, 0xf4, 0x34, 0xf6, 0xbf, 0x7f, 0xbd, 0x3a, 0xef,
+	0x1b, 0xde, 0xce, 0xe7, 0x20, 0x8c, 0x79, 0x67, 0x41, 0x55, 0x32, 0x21, 0x7f, 0xa1, 0xdb, 0x81,
+	0x21, 0x19, 0x0e, 0xfe, 0x53, 0x2a, 0x15, 0xcb, 0x5a, 0x41, 0xeb, 0xdf, 0x9b, 0xa0, 0xe9, 0x83,
+	0xe7, 0x41, 0xe4, 0xbc, 0x45, 0x83, 0xb7, 0xe8, 0xfd, 0xe0, 0x2d, 0x1e, 0xad, 0x7f, 0x8e, 0xbd,
+	0xd5, 0xaf, 0x31, 0x4a, 0xfc, 0x7f, 0xab, 0xbf, 0xe8, 0xaa, 0x5c, 0x7e, 0x41, 0xf8, 0x24, 0x76,
+	0xfb, 0x21, 0x17, 0x78, 0x64, 0xdb, 0x5a, 0xa4, 0x0d, 0x14, 0xce, 0x6f, 0x72, 0xb2, 0x8f, 0x3f,
+	0x40, 0x41, 0x9e, 0xe2, 0x33, 0xae, 0x2b, 0x0b, 0x8c, 0xdb, 0x54, 0x57, 0x85, 0xeb, 0x3e, 0x4a,
+	0x1e, 0x0e, 0xe2, 0xeb, 0xaa, 0x68, 0xc9, 0x0d, 0xc6, 0x16, 0x54, 0x5d, 0x8b, 0x79, 0x9a, 0xb5,
+	0xfe, 0xd1, 0x1d, 0x13, 0x9f, 0xf6, 0xb9, 0x71, 0x4b, 0x9e, 0xe1, 0x47, 0x03, 0x98, 0x0b, 0x25,
+	0x73, 0xeb, 0xdf, 0x9f, 0xa0, 0xe9, 0x51, 0x72, 0xd6, 0xab, 0x2f, 0x3b, 0x31, 0x7e, 0xbb, 0xde,
+	0x86, 0x68, 0xb3, 0x0d, 0xd1, 0xef, 0x6d, 0x88, 0x56, 0xbb, 0xd0, 0xdb, 0xec, 0x42, 0xef, 0xc7,
+	0x2e, 0xf4, 0x3e, 0xde, 0x48, 0x65, 0xf3, 0x26, 0x8b, 0xb8, 0x2e, 0x29, 0x2f, 0x98, 0x31, 0x8a,
+	0x5f, 0xf5, 0x57, 0xa3, 0x41, 0xd0, 0xc5, 0x35, 0x5d, 0x1e, 0xde, 0xcf, 0x7e, 0x32, 0x93, 0x1d,
+	0x77, 0x6b, 0xbb, 0xfe, 0x33, 0x00, 0x3d, 0x7f, 0x39, 0x47, 0x64, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EmergencyAuthorityExpiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EmergencyAuthorityExpiry):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintCircuitbreaker(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if len(m.EmergencyAuthority) > 0 {
+		i -= len(m.EmergencyAuthority)
+		copy(dAtA[i:], m.EmergencyAuthority)
+		i = encodeVarintCircuitbreaker(dAtA, i, uint64(len(m.EmergencyAuthority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Breaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Breaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Breaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TrippedHeight != 0 {
+		i = encodeVarintCircuitbreaker(dAtA, i, uint64(m.TrippedHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.TrippedBy) > 0 {
+		i -= len(m.TrippedBy)
+		copy(dAtA[i:], m.TrippedBy)
+		i = encodeVarintCircuitbreaker(dAtA, i, uint64(len(m.TrippedBy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ContractOnly {
+		i--
+		if m.ContractOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TypeUrl) > 0 {
+		i -= len(m.TypeUrl)
+		copy(dAtA[i:], m.TypeUrl)
+		i = encodeVarintCircuitbreaker(dAtA, i, uint64(len(m.TypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCircuitbreaker(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCircuitbreaker(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EmergencyAuthority)
+	if l > 0 {
+		n += 1 + l + sovCircuitbreaker(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EmergencyAuthorityExpiry)
+	n += 1 + l + sovCircuitbreaker(uint64(l))
+	return n
+}
+
+func (m *Breaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TypeUrl)
+	if l > 0 {
+		n += 1 + l + sovCircuitbreaker(uint64(l))
+	}
+	if m.ContractOnly {
+		n += 2
+	}
+	l = len(m.TrippedBy)
+	if l > 0 {
+		n += 1 + l + sovCircuitbreaker(uint64(l))
+	}
+	if m.TrippedHeight != 0 {
+		n += 1 + sovCircuitbreaker(uint64(m.TrippedHeight))
+	}
+	return n
+}
+
+func sovCircuitbreaker(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCircuitbreaker(x uint64) (n int) {
+	return sovCircuitbreaker(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCircuitbreaker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmergencyAuthority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitbreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCircuitbreaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuitbreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EmergencyAuthority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmergencyAuthorityExpiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitbreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCircuitbreaker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuitbreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EmergencyAuthorityExpiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCircuitbreaker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCircuitbreaker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Breaker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCircuitbreaker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Breaker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Breaker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitbreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCircuitbreaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuitbreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitbreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ContractOnly = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrippedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitbreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCircuitbreaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuitbreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrippedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrippedHeight", wireType)
+			}
+			m.TrippedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitbreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrippedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCircuitbreaker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCircuitbreaker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCircuitbreaker(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCircuitbreaker
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCircuitbreaker
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCircuitbreaker
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCircuitbreaker
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCircuitbreaker
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCircuitbreaker
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCircuitbreaker        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCircuitbreaker          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCircuitbreaker = fmt.Errorf("proto: unexpected end of group")
+)
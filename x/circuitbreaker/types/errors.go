@@ -0,0 +1,14 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// Circuitbreaker module sentinel errors
+var (
+	ErrInvalidTypeURL   = errorsmod.Register(ModuleName, 2, "invalid type url")
+	ErrProtectedTypeURL = errorsmod.Register(ModuleName, 3, "type url cannot be tripped")
+	ErrBreakerNotFound  = errorsmod.Register(ModuleName, 4, "breaker not found")
+	ErrBreakerTripped   = errorsmod.Register(ModuleName, 5, "message rejected by tripped circuit breaker")
+	ErrUnauthorized     = errorsmod.Register(ModuleName, 6, "unauthorized circuit breaker authority")
+)
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"fmt"
+)
+
+// DefaultGenesisState returns the default circuitbreaker genesis state.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params:   DefaultParams(),
+		Breakers: []Breaker{},
+	}
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, breakers []Breaker) *GenesisState {
+	return &GenesisState{
+		Params:   params,
+		Breakers: breakers,
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	typeURLs := make(map[string]bool, len(gs.Breakers))
+	for _, breaker := range gs.Breakers {
+		if err := breaker.Validate(); err != nil {
+			return err
+		}
+
+		if typeURLs[breaker.TypeUrl] {
+			return fmt.Errorf("duplicate breaker of %s", breaker.TypeUrl)
+		}
+		typeURLs[breaker.TypeUrl] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,25 @@
+package types
+
+const (
+	ModuleName = "circuitbreaker"
+	StoreKey   = ModuleName
+
+	RouterKey = ModuleName
+
+	EventTypeTripCircuitBreaker  = "trip_circuit_breaker"
+	EventTypeResetCircuitBreaker = "reset_circuit_breaker"
+	AttributeKeyAuthority        = "authority"
+	AttributeKeyTypeURL          = "type_url"
+	AttributeKeyContractOnly     = "contract_only"
+)
+
+// Key defines the store key for circuitbreaker.
+var (
+	ParamsKey        = []byte{0x1}
+	BreakerKeyPrefix = []byte{0x2}
+)
+
+// GetBreakerKey returns the store key of the breaker of a type url.
+func GetBreakerKey(typeURL string) []byte {
+	return append(BreakerKeyPrefix, []byte(typeURL)...)
+}
@@ -0,0 +1,91 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	TypeMsgUpdateParams        = "update_params"
+	TypeMsgTripCircuitBreaker  = "trip_circuit_breaker"
+	TypeMsgResetCircuitBreaker = "reset_circuit_breaker"
+)
+
+var (
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgTripCircuitBreaker{}
+	_ sdk.Msg = &MsgResetCircuitBreaker{}
+)
+
+// NewMsgTripCircuitBreaker returns a new MsgTripCircuitBreaker instance
+func NewMsgTripCircuitBreaker(authority string, typeURLs []string, contractOnly bool) *MsgTripCircuitBreaker {
+	return &MsgTripCircuitBreaker{
+		Authority:    authority,
+		TypeUrls:     typeURLs,
+		ContractOnly: contractOnly,
+	}
+}
+
+// NewMsgResetCircuitBreaker returns a new MsgResetCircuitBreaker instance
+func NewMsgResetCircuitBreaker(authority string, typeURLs []string) *MsgResetCircuitBreaker {
+	return &MsgResetCircuitBreaker{
+		Authority: authority,
+		TypeUrls:  typeURLs,
+	}
+}
+
+func (msg MsgUpdateParams) Route() string { return ModuleName }
+func (msg MsgUpdateParams) Type() string  { return TypeMsgUpdateParams }
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return err
+	}
+
+	return msg.Params.Validate()
+}
+
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+func (msg MsgTripCircuitBreaker) Route() string { return ModuleName }
+func (msg MsgTripCircuitBreaker) Type() string  { return TypeMsgTripCircuitBreaker }
+func (msg MsgTripCircuitBreaker) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return err
+	}
+
+	return ValidateTypeURLs(msg.TypeUrls)
+}
+
+func (msg MsgTripCircuitBreaker) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgTripCircuitBreaker) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+func (msg MsgResetCircuitBreaker) Route() string { return ModuleName }
+func (msg MsgResetCircuitBreaker) Type() string  { return TypeMsgResetCircuitBreaker }
+func (msg MsgResetCircuitBreaker) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return err
+	}
+
+	return ValidateTypeURLs(msg.TypeUrls)
+}
+
+func (msg MsgResetCircuitBreaker) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgResetCircuitBreaker) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
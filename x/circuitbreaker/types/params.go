@@ -0,0 +1,38 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultParams returns the default circuit breaker parameters, which have no
+// emergency authority.
+func DefaultParams() Params {
+	return Params{
+		EmergencyAuthority:       "",
+		EmergencyAuthorityExpiry: time.Unix(0, 0).UTC(),
+	}
+}
+
+// Validate performs basic validation on circuit breaker parameters.
+func (p Params) Validate() error {
+	if len(p.EmergencyAuthority) == 0 {
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(p.EmergencyAuthority); err != nil {
+		return fmt.Errorf("invalid emergency authority: %w", err)
+	}
+
+	return nil
+}
+
+// IsEmergencyAuthority returns true when addr is the emergency authority and
+// its permissions have not expired at blockTime.
+func (p Params) IsEmergencyAuthority(addr string, blockTime time.Time) bool {
+	return len(p.EmergencyAuthority) != 0 &&
+		p.EmergencyAuthority == addr &&
+		blockTime.Before(p.EmergencyAuthorityExpiry)
+}
@@ -17,9 +17,12 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 
 	if !core.IsPeriodLastBlock(ctx, core.BlocksPerWeek) {
+		k.UpdateDirtyValidatorRates(ctx)
 		return
 	}
 
 	ctx.Logger().Info("End Epoch - Calculation of Dyncomm is due")
 	k.UpdateAllBondedValidatorRates(ctx)
+	k.PruneCommissionHistory(ctx, k.GetEpoch(ctx))
+	k.ClearDirtyValidators(ctx)
 }
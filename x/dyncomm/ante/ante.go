@@ -93,7 +93,7 @@ func (dd DyncommDecorator) ProcessEditValidator(ctx sdk.Context, msg sdk.Msg) (e
 
 	operator := msgEditValidator.ValidatorAddress
 	newIntendedRate := msgEditValidator.CommissionRate
-	dynMinRate := dd.dyncommKeeper.GetDynCommissionRate(ctx, operator)
+	dynMinRate := dd.dyncommKeeper.GetEffectiveMinCommissionRate(ctx, operator)
 
 	if newIntendedRate.LT(dynMinRate) {
 		return fmt.Errorf("commission for %s must be at least %f", operator, dynMinRate.MustFloat64())
@@ -12,6 +12,11 @@ import (
 	"github.com/classic-terra/core/v3/x/dyncomm/types"
 )
 
+const (
+	flagVotingPower      = "voting-power"
+	flagDelegationChange = "delegation-change"
+)
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd() *cobra.Command {
 	dyncommQueryCmd := &cobra.Command{
@@ -24,6 +29,8 @@ func GetQueryCmd() *cobra.Command {
 
 	dyncommQueryCmd.AddCommand(
 		GetCmdQueryRate(),
+		GetCmdQueryRateHistory(),
+		GetCmdQueryProjectedRate(),
 		GetCmdQueryParams(),
 	)
 
@@ -64,6 +71,103 @@ func GetCmdQueryRate() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryRateHistory implements the query per-epoch commission history command.
+func GetCmdQueryRateHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rate-history [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the per-epoch voting power and commission rates of a validator",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// parse validator address
+			addr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.RateHistory(context.Background(),
+				&types.QueryRateHistoryRequest{ValidatorAddr: addr.String(), Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "rate-history")
+	return cmd
+}
+
+// GetCmdQueryProjectedRate implements the query projected commission rate command.
+func GetCmdQueryProjectedRate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "projected-rate [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the commission rates of a validator after a hypothetical voting power or delegation change",
+		Long: `Query the commission rates of a validator after a hypothetical voting power or delegation change.
+
+Example:
+$ terrad query dyncomm projected-rate terravaloper1... --delegation-change 1000000000
+$ terrad query dyncomm projected-rate terravaloper1... --delegation-change=-1000000000
+$ terrad query dyncomm projected-rate terravaloper1... --voting-power 5.5
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// parse validator address
+			addr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			votingPower, err := cmd.Flags().GetString(flagVotingPower)
+			if err != nil {
+				return err
+			}
+
+			delegationChange, err := cmd.Flags().GetString(flagDelegationChange)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ProjectedRate(context.Background(),
+				&types.QueryProjectedRateRequest{
+					ValidatorAddr:    addr.String(),
+					VotingPower:      votingPower,
+					DelegationChange: delegationChange,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagVotingPower, "", "Hypothetical voting power of the validator in percent")
+	cmd.Flags().String(flagDelegationChange, "", "Hypothetical change of the validator tokens, negative for an undelegation")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQueryParams implements the query params command.
 func GetCmdQueryParams() *cobra.Command {
 	cmd := &cobra.Command{
@@ -1,6 +1,8 @@
 package dyncomm
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/classic-terra/core/v3/x/dyncomm/keeper"
@@ -13,16 +15,44 @@ import (
 func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState) {
 	keeper.SetParams(ctx, data.Params)
 
-	// iterate validators and set target rates
+	// an exported chain restores the rates of its validators, the commission
+	// rates become the target rates of all other validators
+	restored := make(map[string]bool, len(data.ValidatorCommissionRates))
+	for _, rate := range data.ValidatorCommissionRates {
+		if rate.MinCommissionRate == nil || rate.TargetCommissionRate == nil {
+			panic(fmt.Sprintf("incomplete commission rates of validator %s", rate.ValidatorAddress))
+		}
+
+		keeper.SetValidatorCommissionRate(ctx, rate)
+		restored[rate.ValidatorAddress] = true
+	}
+
 	keeper.StakingKeeper.IterateValidators(ctx, func(index int64, validator stakingtypes.ValidatorI) (stop bool) {
 		val := validator.(stakingtypes.Validator)
-		keeper.SetTargetCommissionRate(ctx, val.OperatorAddress, val.Commission.Rate)
+		if !restored[val.OperatorAddress] {
+			keeper.SetTargetCommissionRate(ctx, val.OperatorAddress, val.Commission.Rate)
+		}
 		return false
 	})
 
-	err := keeper.UpdateAllBondedValidatorRates(ctx)
-	if err != nil {
-		panic("could not initialize genesis")
+	if len(restored) == 0 {
+		err := keeper.UpdateAllBondedValidatorRates(ctx)
+		if err != nil {
+			panic("could not initialize genesis")
+		}
+	} else {
+		// the history of an exported chain is only restored from the genesis
+		keeper.StakingKeeper.IterateValidators(ctx, func(index int64, validator stakingtypes.ValidatorI) (stop bool) {
+			if val := validator.(stakingtypes.Validator); val.IsBonded() {
+				keeper.UpdateValidatorMinRates(ctx, val)
+			}
+			return false
+		})
+	}
+
+	// imported history takes precedence over the entry recorded above
+	for _, entry := range data.ValidatorCommissionHistory {
+		keeper.SetCommissionHistory(ctx, entry)
 	}
 }
 
@@ -39,5 +69,11 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) (data *types.GenesisSt
 		return false
 	})
 
-	return types.NewGenesisState(params, rates)
+	var history []types.ValidatorCommissionHistory
+	keeper.IterateCommissionHistory(ctx, func(entry types.ValidatorCommissionHistory) (stop bool) {
+		history = append(history, entry)
+		return false
+	})
+
+	return types.NewGenesisState(params, rates, history)
 }
@@ -1,6 +1,10 @@
 package keeper
 
 import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
 	types "github.com/classic-terra/core/v3/x/dyncomm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -16,29 +20,56 @@ func (k Keeper) CalculateVotingPower(ctx sdk.Context, validator stakingtypes.Val
 	return sdk.NewDec(validatorPower).QuoInt64(totalPower).MulInt64(100)
 }
 
+// CalculateProjectedVotingPower calculates the voting power of a validator in
+// percent after its tokens changed by delta
+func (k Keeper) CalculateProjectedVotingPower(ctx sdk.Context, validator stakingtypes.Validator, delta math.Int) (sdk.Dec, error) {
+	tokens := validator.Tokens.Add(delta)
+	if tokens.IsNegative() {
+		return sdk.Dec{}, fmt.Errorf("delegation change %s exceeds the validator tokens %s", delta, validator.Tokens)
+	}
+
+	powerReduction := k.StakingKeeper.PowerReduction(ctx)
+	totalPower := k.StakingKeeper.GetLastTotalPower(ctx).Int64()
+	if validator.IsBonded() {
+		totalPower -= validator.ConsensusPower(powerReduction)
+	}
+
+	validatorPower := sdk.TokensToConsensusPower(tokens, powerReduction)
+	totalPower += validatorPower
+	if totalPower <= 0 {
+		return sdk.ZeroDec(), nil
+	}
+
+	return sdk.NewDec(validatorPower).QuoInt64(totalPower).MulInt64(100), nil
+}
+
 // CalculateDynCommission calculates the min commission according
-// to StrathColes formula
+// to the formula selected for the validator
 func (k Keeper) CalculateDynCommission(ctx sdk.Context, validator stakingtypes.Validator) (ret sdk.Dec) {
-	// The original parameters as defined
-	// by Strath
-	A := k.GetMaxZero(ctx)
-	B := k.GetSlopeBase(ctx)
-	C := k.GetSlopeVpImpact(ctx)
-	D := k.GetCap(ctx).MulInt64(100)
-	x := k.CalculateVotingPower(ctx, validator)
-	factorA := x.Sub(A)
-	quotient := x.Quo(C)
-	factorB := quotient.Add(B)
-	minComm := k.StakingKeeper.MinCommissionRate(ctx).MulInt64(100)
-
-	y := factorA.Mul(factorB)
-	if y.GT(D) {
-		y = D
-	}
+	return k.CalculateDynCommissionForVotingPower(ctx, validator.OperatorAddress, k.CalculateVotingPower(ctx, validator))
+}
+
+// CalculateDynCommissionForVotingPower calculates the min commission of a
+// validator with the voting power x in percent
+func (k Keeper) CalculateDynCommissionForVotingPower(ctx sdk.Context, validator string, x sdk.Dec) (ret sdk.Dec) {
+	y := k.GetParams(ctx).GetFormula(validator).MinRate(x)
+
+	minComm := k.StakingKeeper.MinCommissionRate(ctx)
 	if minComm.GT(y) {
 		y = minComm
 	}
-	return y.QuoInt64(100)
+	return y
+}
+
+// GetEffectiveMinCommissionRate returns the min commission rate of a validator
+// with the voting power of its last calculation under the current formula
+func (k Keeper) GetEffectiveMinCommissionRate(ctx sdk.Context, validator string) sdk.Dec {
+	votingPower := k.GetVotingPower(ctx, validator)
+	if votingPower == nil {
+		return k.GetDynCommissionRate(ctx, validator)
+	}
+
+	return k.CalculateDynCommissionForVotingPower(ctx, validator, *votingPower)
 }
 
 func (k Keeper) SetDynCommissionRate(ctx sdk.Context, validator string, rate sdk.Dec) {
@@ -81,6 +112,12 @@ func (k Keeper) SetTargetCommissionRate(ctx sdk.Context, validator string, rate
 	store.Set(types.GetMinCommissionRatesKey(validator), bz)
 }
 
+// SetValidatorCommissionRate stores the rates of a validator as they are
+func (k Keeper) SetValidatorCommissionRate(ctx sdk.Context, rate types.ValidatorCommissionRate) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetMinCommissionRatesKey(rate.ValidatorAddress), k.cdc.MustMarshal(&rate))
+}
+
 func (k Keeper) GetDynCommissionRate(ctx sdk.Context, validator string) (rate sdk.Dec) {
 	store := ctx.KVStore(k.storeKey)
 	bz := store.Get(types.GetMinCommissionRatesKey(validator))
@@ -105,10 +142,24 @@ func (k Keeper) GetTargetCommissionRate(ctx sdk.Context, validator string) (rate
 	return *validatorRate.TargetCommissionRate
 }
 
+// GetVotingPower returns the voting power the min commission rate of a
+// validator was last calculated with, nil if unknown
+func (k Keeper) GetVotingPower(ctx sdk.Context, validator string) *sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetMinCommissionRatesKey(validator))
+	if bz == nil {
+		return nil
+	}
+
+	var validatorRate types.ValidatorCommissionRate
+	k.cdc.MustUnmarshal(bz, &validatorRate)
+	return validatorRate.VotingPower
+}
+
 // IterateDynCommissionRates iterates over dyn commission rates in the store
 func (k Keeper) IterateDynCommissionRates(ctx sdk.Context, cb func(types.ValidatorCommissionRate) bool) {
 	store := ctx.KVStore(k.storeKey)
-	it := store.Iterator(nil, nil)
+	it := sdk.KVStorePrefixIterator(store, types.MinCommissionRatesPrefix)
 	defer it.Close()
 
 	for ; it.Valid(); it.Next() {
@@ -123,9 +174,12 @@ func (k Keeper) IterateDynCommissionRates(ctx sdk.Context, cb func(types.Validat
 	}
 }
 
-func (k Keeper) UpdateValidatorMinRates(ctx sdk.Context, validator stakingtypes.Validator) {
+// UpdateValidatorMinRates recalculates the min commission rate of a
+// validator and enforces it on its commission rate
+func (k Keeper) UpdateValidatorMinRates(ctx sdk.Context, validator stakingtypes.Validator) types.ValidatorCommissionRate {
 	var newRate sdk.Dec
-	minRate := k.CalculateDynCommission(ctx, validator)
+	votingPower := k.CalculateVotingPower(ctx, validator)
+	minRate := k.CalculateDynCommissionForVotingPower(ctx, validator.OperatorAddress, votingPower)
 	newMaxRate := validator.Commission.MaxRate
 	targetRate := k.GetTargetCommissionRate(ctx, validator.OperatorAddress)
 
@@ -151,14 +205,39 @@ func (k Keeper) UpdateValidatorMinRates(ctx sdk.Context, validator stakingtypes.
 	)
 
 	k.StakingKeeper.SetValidator(ctx, newValidator)
-	k.SetDynCommissionRate(ctx, validator.OperatorAddress, minRate)
+
+	rate := types.ValidatorCommissionRate{
+		ValidatorAddress:     validator.OperatorAddress,
+		MinCommissionRate:    &minRate,
+		TargetCommissionRate: &targetRate,
+		VotingPower:          &votingPower,
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetMinCommissionRatesKey(validator.OperatorAddress), k.cdc.MustMarshal(&rate))
+
+	if !newRate.Equal(validator.Commission.Rate) {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRateUpdate,
+				sdk.NewAttribute(types.AttributeKeyValidator, validator.OperatorAddress),
+				sdk.NewAttribute(types.AttributeKeyOldRate, validator.Commission.Rate.String()),
+				sdk.NewAttribute(types.AttributeKeyNewRate, newRate.String()),
+				sdk.NewAttribute(types.AttributeKeyMinRate, minRate.String()),
+				sdk.NewAttribute(types.AttributeKeyVotingPower, votingPower.String()),
+			),
+		)
+	}
 
 	// Debug
-	targetRate = k.GetTargetCommissionRate(ctx, validator.OperatorAddress)
 	ctx.Logger().Debug("dyncomm:", "val", validator.OperatorAddress, "min_rate", minRate, "new target_rate", targetRate)
+
+	return rate
 }
 
+// UpdateAllBondedValidatorRates recalculates the rates of all bonded
+// validators at the end of an epoch and records them in the history
 func (k Keeper) UpdateAllBondedValidatorRates(ctx sdk.Context) (err error) {
+	epoch := k.GetEpoch(ctx)
 	k.StakingKeeper.IterateValidators(ctx, func(index int64, validator stakingtypes.ValidatorI) (stop bool) {
 		val := validator.(stakingtypes.Validator)
 
@@ -166,10 +245,40 @@ func (k Keeper) UpdateAllBondedValidatorRates(ctx sdk.Context) (err error) {
 			return false
 		}
 
-		k.UpdateValidatorMinRates(ctx, val)
+		rate := k.UpdateValidatorMinRates(ctx, val)
+		k.SetCommissionHistory(ctx, types.ValidatorCommissionHistory{
+			Epoch:                epoch,
+			Height:               ctx.BlockHeight(),
+			ValidatorAddress:     rate.ValidatorAddress,
+			VotingPower:          *rate.VotingPower,
+			MinCommissionRate:    *rate.MinCommissionRate,
+			TargetCommissionRate: *rate.TargetCommissionRate,
+		})
 
 		return false
 	})
 
 	return nil
 }
+
+// UpdateDirtyValidatorRates recalculates the rates of the bonded validators
+// whose voting power changed by at least the threshold since their last
+// calculation and clears the dirty validators
+func (k Keeper) UpdateDirtyValidatorRates(ctx sdk.Context) {
+	threshold := k.GetVotingPowerThreshold(ctx)
+	for _, valAddr := range k.GetDirtyValidators(ctx) {
+		k.DeleteDirtyValidator(ctx, valAddr)
+
+		validator, found := k.StakingKeeper.GetValidator(ctx, valAddr)
+		if !found || !validator.IsBonded() {
+			continue
+		}
+
+		lastVotingPower := k.GetVotingPower(ctx, validator.OperatorAddress)
+		if lastVotingPower != nil && k.CalculateVotingPower(ctx, validator).Sub(*lastVotingPower).Abs().LT(threshold) {
+			continue
+		}
+
+		k.UpdateValidatorMinRates(ctx, validator)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	core "github.com/classic-terra/core/v3/types"
+	"github.com/classic-terra/core/v3/x/dyncomm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/testutil"
 	"github.com/stretchr/testify/require"
@@ -61,3 +62,213 @@ func TestCalculateDynCommission(t *testing.T) {
 		input.DyncommKeeper.CalculateDynCommission(input.Ctx, vals[2]),
 	)
 }
+
+func TestCalculateProjectedVotingPower(t *testing.T) {
+	input := CreateTestInput(t)
+	helper := testutil.NewHelper(
+		t, input.Ctx, input.StakingKeeper,
+	)
+	helper.Denom = core.MicroLunaDenom
+	helper.CreateValidatorWithValPower(ValAddrFrom(0), PubKeys[0], 9, true)
+	helper.CreateValidatorWithValPower(ValAddrFrom(1), PubKeys[1], 1, true)
+	helper.TurnBlock(time.Now())
+	vals := input.StakingKeeper.GetBondedValidatorsByPower(input.Ctx)
+	powerReduction := input.StakingKeeper.PowerReduction(input.Ctx)
+
+	// no change
+	votingPower, err := input.DyncommKeeper.CalculateProjectedVotingPower(input.Ctx, vals[1], sdk.ZeroInt())
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(10), votingPower)
+
+	// delegation of 10 power
+	votingPower, err = input.DyncommKeeper.CalculateProjectedVotingPower(input.Ctx, vals[1], powerReduction.MulRaw(10))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(55), votingPower)
+
+	// undelegation of 4 power
+	votingPower, err = input.DyncommKeeper.CalculateProjectedVotingPower(input.Ctx, vals[0], powerReduction.MulRaw(-4))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(5).QuoInt64(6).MulInt64(100), votingPower)
+
+	// undelegation exceeding the tokens
+	_, err = input.DyncommKeeper.CalculateProjectedVotingPower(input.Ctx, vals[1], powerReduction.MulRaw(-2))
+	require.Error(t, err)
+}
+
+func TestCommissionHistory(t *testing.T) {
+	input := CreateTestInput(t)
+	helper := testutil.NewHelper(
+		t, input.Ctx, input.StakingKeeper,
+	)
+	helper.Denom = core.MicroLunaDenom
+	helper.CreateValidatorWithValPower(ValAddrFrom(0), PubKeys[0], 950, true)
+	helper.CreateValidatorWithValPower(ValAddrFrom(1), PubKeys[1], 50, true)
+	helper.TurnBlock(time.Now())
+
+	ctx := input.Ctx.WithBlockHeight(int64(core.BlocksPerWeek) - 1)
+	require.NoError(t, input.DyncommKeeper.UpdateAllBondedValidatorRates(ctx))
+	ctx = ctx.WithBlockHeight(int64(core.BlocksPerWeek)*2 - 1)
+	require.NoError(t, input.DyncommKeeper.UpdateAllBondedValidatorRates(ctx))
+
+	entry, found := input.DyncommKeeper.GetCommissionHistory(ctx, ValAddrFrom(0).String(), 1)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(95), entry.VotingPower)
+	require.Equal(t, sdk.NewDecWithPrec(20, 2), entry.MinCommissionRate)
+	require.Equal(t, int64(core.BlocksPerWeek)*2-1, entry.Height)
+
+	var history []uint64
+	input.DyncommKeeper.IterateCommissionHistory(ctx, func(entry types.ValidatorCommissionHistory) bool {
+		if entry.ValidatorAddress == ValAddrFrom(1).String() {
+			history = append(history, entry.Epoch)
+		}
+		return false
+	})
+	require.Equal(t, []uint64{0, 1}, history)
+
+	// the min commission rates are not mixed up with the history
+	var rates int
+	input.DyncommKeeper.IterateDynCommissionRates(ctx, func(types.ValidatorCommissionRate) bool {
+		rates++
+		return false
+	})
+	require.Equal(t, 2, rates)
+
+	// only the epochs within the retention are kept
+	params := input.DyncommKeeper.GetParams(ctx)
+	params.HistoryRetentionEpochs = 1
+	input.DyncommKeeper.SetParams(ctx, params)
+	input.DyncommKeeper.PruneCommissionHistory(ctx, 1)
+
+	history = nil
+	input.DyncommKeeper.IterateCommissionHistory(ctx, func(entry types.ValidatorCommissionHistory) bool {
+		history = append(history, entry.Epoch)
+		return false
+	})
+	require.Equal(t, []uint64{1, 1}, history)
+}
+
+func TestCalculateDynCommissionFormulas(t *testing.T) {
+	input := CreateTestInput(t)
+	helper := testutil.NewHelper(
+		t, input.Ctx, input.StakingKeeper,
+	)
+	helper.Denom = core.MicroLunaDenom
+	helper.CreateValidatorWithValPower(ValAddrFrom(0), PubKeys[0], 950, true)
+	helper.CreateValidatorWithValPower(ValAddrFrom(1), PubKeys[1], 46, true)
+	helper.CreateValidatorWithValPower(ValAddrFrom(2), PubKeys[2], 4, true)
+	helper.TurnBlock(time.Now())
+	vals := input.StakingKeeper.GetBondedValidatorsByPower(input.Ctx)
+
+	points := []types.CommissionPoint{
+		{VotingPower: sdk.NewDec(1), Rate: sdk.NewDecWithPrec(1, 2)},
+		{VotingPower: sdk.NewDec(5), Rate: sdk.NewDecWithPrec(5, 2)},
+		{VotingPower: sdk.NewDec(10), Rate: sdk.NewDecWithPrec(15, 2)},
+	}
+
+	params := input.DyncommKeeper.GetParams(input.Ctx)
+	params.Schedule = types.CommissionSchedule{Formula: types.CommissionFormulaPiecewiseLinear, Points: points}
+	require.NoError(t, params.Validate())
+	input.DyncommKeeper.SetParams(input.Ctx, params)
+
+	// beyond the last point
+	require.Equal(t, sdk.NewDecWithPrec(15, 2), input.DyncommKeeper.CalculateDynCommission(input.Ctx, vals[0]))
+	// between the first and the second point
+	require.Equal(t, sdk.NewDecWithPrec(46, 3), input.DyncommKeeper.CalculateDynCommission(input.Ctx, vals[1]))
+	require.Equal(t, sdk.NewDecWithPrec(5, 2), input.DyncommKeeper.CalculateDynCommissionForVotingPower(input.Ctx, vals[1].OperatorAddress, sdk.NewDec(5)))
+	require.Equal(t, sdk.NewDecWithPrec(10, 2), input.DyncommKeeper.CalculateDynCommissionForVotingPower(input.Ctx, vals[1].OperatorAddress, sdk.NewDecWithPrec(75, 1)))
+	// below the first point
+	require.Equal(t, sdk.NewDecWithPrec(1, 2), input.DyncommKeeper.CalculateDynCommission(input.Ctx, vals[2]))
+
+	params.Schedule = types.CommissionSchedule{Formula: types.CommissionFormulaTieredBrackets, Tiers: points}
+	require.NoError(t, params.Validate())
+	input.DyncommKeeper.SetParams(input.Ctx, params)
+
+	require.Equal(t, sdk.NewDecWithPrec(15, 2), input.DyncommKeeper.CalculateDynCommission(input.Ctx, vals[0]))
+	require.Equal(t, sdk.NewDecWithPrec(1, 2), input.DyncommKeeper.CalculateDynCommission(input.Ctx, vals[1]))
+	require.Equal(t, sdk.ZeroDec(), input.DyncommKeeper.CalculateDynCommission(input.Ctx, vals[2]))
+
+	// opt-out validators follow their own schedule
+	params.OptOutValidators = []string{vals[0].OperatorAddress}
+	params.OptOutSchedule = types.CommissionSchedule{
+		Formula: types.CommissionFormulaTieredBrackets,
+		Tiers:   []types.CommissionPoint{{VotingPower: sdk.ZeroDec(), Rate: sdk.NewDecWithPrec(2, 2)}},
+	}
+	require.NoError(t, params.Validate())
+	input.DyncommKeeper.SetParams(input.Ctx, params)
+
+	require.Equal(t, sdk.NewDecWithPrec(2, 2), input.DyncommKeeper.CalculateDynCommission(input.Ctx, vals[0]))
+	require.Equal(t, sdk.NewDecWithPrec(1, 2), input.DyncommKeeper.CalculateDynCommission(input.Ctx, vals[1]))
+}
+
+func TestEffectiveMinCommissionRate(t *testing.T) {
+	input := CreateTestInput(t)
+	helper := testutil.NewHelper(
+		t, input.Ctx, input.StakingKeeper,
+	)
+	helper.Denom = core.MicroLunaDenom
+	helper.CreateValidatorWithValPower(ValAddrFrom(0), PubKeys[0], 950, true)
+	helper.CreateValidatorWithValPower(ValAddrFrom(1), PubKeys[1], 50, true)
+	helper.TurnBlock(time.Now())
+	operator := ValAddrFrom(0).String()
+
+	require.NoError(t, input.DyncommKeeper.UpdateAllBondedValidatorRates(input.Ctx))
+	require.Equal(t, sdk.NewDecWithPrec(20, 2), input.DyncommKeeper.GetEffectiveMinCommissionRate(input.Ctx, operator))
+
+	// a formula change applies to the voting power of the last epoch right away
+	params := input.DyncommKeeper.GetParams(input.Ctx)
+	params.OptOutValidators = []string{operator}
+	input.DyncommKeeper.SetParams(input.Ctx, params)
+
+	require.Equal(t, sdk.NewDecWithPrec(20, 2), input.DyncommKeeper.GetDynCommissionRate(input.Ctx, operator))
+	require.Equal(t, sdk.ZeroDec(), input.DyncommKeeper.GetEffectiveMinCommissionRate(input.Ctx, operator))
+}
+
+func TestMigrate1to2(t *testing.T) {
+	input := CreateTestInput(t)
+
+	require.NoError(t, NewMigrator(input.DyncommKeeper).Migrate1to2(input.Ctx))
+	params := types.DefaultParams()
+	require.True(t, params.Equal(input.DyncommKeeper.GetParams(input.Ctx)))
+}
+
+func TestUpdateDirtyValidatorRates(t *testing.T) {
+	input := CreateTestInput(t)
+	helper := testutil.NewHelper(
+		t, input.Ctx, input.StakingKeeper,
+	)
+	helper.Denom = core.MicroLunaDenom
+	helper.CreateValidatorWithValPower(ValAddrFrom(0), PubKeys[0], 97, true)
+	helper.CreateValidatorWithValPower(ValAddrFrom(1), PubKeys[1], 3, true)
+	helper.TurnBlock(time.Now())
+	operator := ValAddrFrom(1).String()
+
+	require.NoError(t, input.DyncommKeeper.UpdateAllBondedValidatorRates(input.Ctx))
+	input.DyncommKeeper.ClearDirtyValidators(input.Ctx)
+	require.Equal(t, sdk.NewDecWithPrec(575, 4), input.DyncommKeeper.GetDynCommissionRate(input.Ctx, operator))
+
+	// a small delegation does not exceed the threshold
+	helper.Delegate(AddrFrom(2), ValAddrFrom(1), sdk.OneInt())
+	helper.TurnBlock(time.Now())
+	require.Equal(t, []sdk.ValAddress{ValAddrFrom(1)}, input.DyncommKeeper.GetDirtyValidators(input.Ctx))
+	ctx := input.Ctx.WithEventManager(sdk.NewEventManager())
+	input.DyncommKeeper.UpdateDirtyValidatorRates(ctx)
+	require.Empty(t, input.DyncommKeeper.GetDirtyValidators(ctx))
+	require.Empty(t, ctx.EventManager().Events())
+	require.Equal(t, sdk.NewDecWithPrec(575, 4), input.DyncommKeeper.GetDynCommissionRate(ctx, operator))
+
+	// a large delegation is recalculated at the end of the block
+	helper.DelegateWithPower(AddrFrom(2), ValAddrFrom(1), 2)
+	helper.TurnBlock(time.Now())
+	ctx = input.Ctx.WithEventManager(sdk.NewEventManager())
+	input.DyncommKeeper.UpdateDirtyValidatorRates(ctx)
+	require.Empty(t, input.DyncommKeeper.GetDirtyValidators(ctx))
+
+	votingPower := sdk.NewDec(5).QuoInt64(102).MulInt64(100)
+	require.Equal(t, votingPower, *input.DyncommKeeper.GetVotingPower(ctx, operator))
+	require.Equal(t, input.DyncommKeeper.CalculateDynCommissionForVotingPower(ctx, operator, votingPower), input.DyncommKeeper.GetDynCommissionRate(ctx, operator))
+	require.True(t, input.DyncommKeeper.GetDynCommissionRate(ctx, operator).GT(sdk.NewDecWithPrec(575, 4)))
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypeRateUpdate, events[0].Type)
+}
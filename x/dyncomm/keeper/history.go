@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/classic-terra/core/v3/types"
+	"github.com/classic-terra/core/v3/x/dyncomm/types"
+)
+
+// GetEpoch returns the epoch the rates are recalculated for
+func (k Keeper) GetEpoch(ctx sdk.Context) uint64 {
+	return uint64(ctx.BlockHeight()) / core.BlocksPerWeek
+}
+
+// SetCommissionHistory stores the rates of a validator for an epoch
+func (k Keeper) SetCommissionHistory(ctx sdk.Context, entry types.ValidatorCommissionHistory) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetCommissionHistoryKey(entry.ValidatorAddress, entry.Epoch), k.cdc.MustMarshal(&entry))
+}
+
+// GetCommissionHistory returns the rates of a validator for an epoch
+func (k Keeper) GetCommissionHistory(ctx sdk.Context, validator string, epoch uint64) (types.ValidatorCommissionHistory, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetCommissionHistoryKey(validator, epoch))
+	if bz == nil {
+		return types.ValidatorCommissionHistory{}, false
+	}
+
+	var entry types.ValidatorCommissionHistory
+	k.cdc.MustUnmarshal(bz, &entry)
+	return entry, true
+}
+
+// IterateCommissionHistory iterates over the history of all validators
+// ordered by validator and epoch
+func (k Keeper) IterateCommissionHistory(ctx sdk.Context, cb func(types.ValidatorCommissionHistory) bool) {
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, types.CommissionHistoryPrefix)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var entry types.ValidatorCommissionHistory
+		k.cdc.MustUnmarshal(it.Value(), &entry)
+
+		if cb(entry) {
+			break
+		}
+	}
+}
+
+// PruneCommissionHistory removes the history of the epochs which are no
+// longer retained at the given epoch
+func (k Keeper) PruneCommissionHistory(ctx sdk.Context, epoch uint64) {
+	retention := k.GetHistoryRetentionEpochs(ctx)
+	if epoch < retention {
+		return
+	}
+
+	var expired [][]byte
+	store := ctx.KVStore(k.storeKey)
+	k.IterateCommissionHistory(ctx, func(entry types.ValidatorCommissionHistory) bool {
+		if entry.Epoch <= epoch-retention {
+			expired = append(expired, types.GetCommissionHistoryKey(entry.ValidatorAddress, entry.Epoch))
+		}
+		return false
+	})
+
+	for _, key := range expired {
+		store.Delete(key)
+	}
+}
+
+// ClearCommissionHistory removes the history of all validators
+func (k Keeper) ClearCommissionHistory(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, types.CommissionHistoryPrefix)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		store.Delete(it.Key())
+	}
+}
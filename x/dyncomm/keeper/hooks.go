@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/classic-terra/core/v3/x/dyncomm/types"
+)
+
+// SetDirtyValidator marks a validator to be recalculated at the end of the block
+func (k Keeper) SetDirtyValidator(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetDirtyValidatorKey(valAddr), valAddr)
+}
+
+// DeleteDirtyValidator unmarks a validator
+func (k Keeper) DeleteDirtyValidator(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetDirtyValidatorKey(valAddr))
+}
+
+// GetDirtyValidators returns the validators marked in the current block
+func (k Keeper) GetDirtyValidators(ctx sdk.Context) (valAddrs []sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	it := sdk.KVStorePrefixIterator(store, types.DirtyValidatorsPrefix)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		valAddrs = append(valAddrs, sdk.ValAddress(it.Value()))
+	}
+
+	return valAddrs
+}
+
+// ClearDirtyValidators unmarks all validators
+func (k Keeper) ClearDirtyValidators(ctx sdk.Context) {
+	for _, valAddr := range k.GetDirtyValidators(ctx) {
+		k.DeleteDirtyValidator(ctx, valAddr)
+	}
+}
+
+// Hooks wrapper struct for dyncomm keeper
+type Hooks struct {
+	k Keeper
+}
+
+var _ stakingtypes.StakingHooks = Hooks{}
+
+// Hooks returns the staking hooks marking validators whose voting power changed
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+func (h Hooks) AfterDelegationModified(ctx sdk.Context, _ sdk.AccAddress, valAddr sdk.ValAddress) error {
+	h.k.SetDirtyValidator(ctx, valAddr)
+	return nil
+}
+
+func (h Hooks) BeforeDelegationRemoved(ctx sdk.Context, _ sdk.AccAddress, valAddr sdk.ValAddress) error {
+	h.k.SetDirtyValidator(ctx, valAddr)
+	return nil
+}
+
+func (h Hooks) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, _ sdk.Dec) error {
+	h.k.SetDirtyValidator(ctx, valAddr)
+	return nil
+}
+
+func (h Hooks) AfterValidatorBonded(ctx sdk.Context, _ sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	h.k.SetDirtyValidator(ctx, valAddr)
+	return nil
+}
+
+func (h Hooks) AfterValidatorCreated(_ sdk.Context, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) BeforeValidatorModified(_ sdk.Context, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) AfterValidatorRemoved(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) BeforeDelegationCreated(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) BeforeDelegationSharesModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) AfterUnbondingInitiated(_ sdk.Context, _ uint64) error {
+	return nil
+}
@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/classic-terra/core/v3/x/dyncomm/types"
+)
+
+// RegisterInvariants registers all dyncomm invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "min-commission-rates", MinCommissionRatesInvariant(k))
+}
+
+// AllInvariants runs all invariants of the dyncomm module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return MinCommissionRatesInvariant(k)(ctx)
+	}
+}
+
+// MinCommissionRatesInvariant checks that the rates of all validators are
+// valid and that bonded validators enforce their min commission rate. Governance
+// may change the formula between two calculations, so the commission rate of a
+// validator must be at least the lower of its calculated min rate and the min
+// rate enforced on its edits. The target rate may fall below a later min rate,
+// so bonded validators must enforce the higher of both, max(target, min).
+func MinCommissionRatesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		k.IterateDynCommissionRates(ctx, func(rate types.ValidatorCommissionRate) bool {
+			if rate.MinCommissionRate == nil || rate.TargetCommissionRate == nil ||
+				rate.MinCommissionRate.IsNegative() || rate.TargetCommissionRate.IsNegative() {
+				broken = true
+				msg += fmt.Sprintf("\t%s has invalid rates %s\n", rate.ValidatorAddress, rate.String())
+				return false
+			}
+
+			valAddr, err := sdk.ValAddressFromBech32(rate.ValidatorAddress)
+			if err != nil {
+				broken = true
+				msg += fmt.Sprintf("\t%s is not a validator address\n", rate.ValidatorAddress)
+				return false
+			}
+
+			validator, found := k.StakingKeeper.GetValidator(ctx, valAddr)
+			if !found || !validator.IsBonded() {
+				return false
+			}
+
+			minRate := *rate.MinCommissionRate
+			if effectiveMinRate := k.GetEffectiveMinCommissionRate(ctx, rate.ValidatorAddress); effectiveMinRate.LT(minRate) {
+				minRate = effectiveMinRate
+			}
+
+			if validator.Commission.Rate.LT(minRate) {
+				broken = true
+				msg += fmt.Sprintf("\t%s commission rate %s is below the min commission rate %s\n",
+					rate.ValidatorAddress, validator.Commission.Rate, minRate)
+			}
+
+			if validator.Commission.Rate.LT(*rate.TargetCommissionRate) {
+				broken = true
+				msg += fmt.Sprintf("\t%s commission rate %s is below the target commission rate %s\n",
+					rate.ValidatorAddress, validator.Commission.Rate, rate.TargetCommissionRate)
+			}
+
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "min-commission-rates", msg), broken
+	}
+}
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	core "github.com/classic-terra/core/v3/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/testutil"
+)
+
+func TestMinCommissionRatesInvariant(t *testing.T) {
+	input := CreateTestInput(t)
+	helper := testutil.NewHelper(
+		t, input.Ctx, input.StakingKeeper,
+	)
+	helper.Denom = core.MicroLunaDenom
+	helper.CreateValidatorWithValPower(ValAddrFrom(0), PubKeys[0], 950, true)
+	helper.CreateValidatorWithValPower(ValAddrFrom(1), PubKeys[1], 50, true)
+	helper.TurnBlock(time.Now())
+	operator := ValAddrFrom(0).String()
+
+	require.NoError(t, input.DyncommKeeper.UpdateAllBondedValidatorRates(input.Ctx))
+	_, broken := AllInvariants(input.DyncommKeeper)(input.Ctx)
+	require.False(t, broken)
+
+	// a formula change lowers the min rate enforced on edits
+	params := input.DyncommKeeper.GetParams(input.Ctx)
+	params.OptOutValidators = []string{operator}
+	input.DyncommKeeper.SetParams(input.Ctx, params)
+
+	validator, found := input.StakingKeeper.GetValidator(input.Ctx, ValAddrFrom(0))
+	require.True(t, found)
+	validator.Commission.Rate = sdk.NewDecWithPrec(1, 2)
+	input.StakingKeeper.SetValidator(input.Ctx, validator)
+	_, broken = MinCommissionRatesInvariant(input.DyncommKeeper)(input.Ctx)
+	require.False(t, broken)
+
+	// the commission rate is below both min rates
+	params.OptOutValidators = nil
+	input.DyncommKeeper.SetParams(input.Ctx, params)
+	_, broken = MinCommissionRatesInvariant(input.DyncommKeeper)(input.Ctx)
+	require.True(t, broken)
+
+	input.DyncommKeeper.UpdateValidatorMinRates(input.Ctx, validator)
+	_, broken = MinCommissionRatesInvariant(input.DyncommKeeper)(input.Ctx)
+	require.False(t, broken)
+
+	// the target rate is above the min rate but not enforced
+	validator, found = input.StakingKeeper.GetValidator(input.Ctx, ValAddrFrom(0))
+	require.True(t, found)
+	targetRate := validator.Commission.Rate.Add(sdk.NewDecWithPrec(1, 2))
+	input.DyncommKeeper.SetTargetCommissionRate(input.Ctx, operator, targetRate)
+	_, broken = MinCommissionRatesInvariant(input.DyncommKeeper)(input.Ctx)
+	require.True(t, broken)
+
+	input.DyncommKeeper.UpdateValidatorMinRates(input.Ctx, validator)
+	_, broken = MinCommissionRatesInvariant(input.DyncommKeeper)(input.Ctx)
+	require.False(t, broken)
+}
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/classic-terra/core/v3/x/dyncomm/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	m.keeper.paramSpace.Set(ctx, types.KeySchedule, types.DefaultSchedule)
+	m.keeper.paramSpace.Set(ctx, types.KeyOptOutValidators, types.DefaultOptOutValidators)
+	m.keeper.paramSpace.Set(ctx, types.KeyOptOutSchedule, types.DefaultOptOutSchedule)
+	m.keeper.paramSpace.Set(ctx, types.KeyVotingPowerThreshold, types.DefaultVotingPowerThreshold)
+	m.keeper.paramSpace.Set(ctx, types.KeyHistoryRetention, types.DefaultHistoryRetention)
+
+	return nil
+}
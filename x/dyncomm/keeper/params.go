@@ -25,6 +25,16 @@ func (k Keeper) GetCap(ctx sdk.Context) (ret sdk.Dec) {
 	return ret
 }
 
+func (k Keeper) GetVotingPowerThreshold(ctx sdk.Context) (ret sdk.Dec) {
+	k.paramSpace.Get(ctx, types.KeyVotingPowerThreshold, &ret)
+	return ret
+}
+
+func (k Keeper) GetHistoryRetentionEpochs(ctx sdk.Context) (ret uint64) {
+	k.paramSpace.Get(ctx, types.KeyHistoryRetention, &ret)
+	return ret
+}
+
 // GetParams returns the total set of market parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
@@ -3,7 +3,11 @@ package keeper
 import (
 	"context"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/classic-terra/core/v3/x/dyncomm/types"
 )
@@ -34,3 +38,88 @@ func (q querier) Rate(c context.Context, req *types.QueryRateRequest) (*types.Qu
 	target := q.GetTargetCommissionRate(ctx, req.ValidatorAddr)
 	return &types.QueryRateResponse{Rate: &rate, Target: &target}, nil
 }
+
+// RateHistory queries the per-epoch commission history of a validator
+func (q querier) RateHistory(c context.Context, req *types.QueryRateHistoryRequest) (*types.QueryRateHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.GetCommissionHistoryPrefix(req.ValidatorAddr))
+
+	var history []types.ValidatorCommissionHistory
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var entry types.ValidatorCommissionHistory
+		if err := q.cdc.Unmarshal(value, &entry); err != nil {
+			return err
+		}
+
+		history = append(history, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRateHistoryResponse{History: history, Pagination: pageRes}, nil
+}
+
+// ProjectedRate queries the commission rates of a validator with a
+// hypothetical voting power or delegation change
+func (q querier) ProjectedRate(c context.Context, req *types.QueryProjectedRateRequest) (*types.QueryProjectedRateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	validator, found := q.StakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "validator %s not found", req.ValidatorAddr)
+	}
+
+	var projectedVotingPower sdk.Dec
+	switch {
+	case req.VotingPower != "":
+		projectedVotingPower, err = sdk.NewDecFromStr(req.VotingPower)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if projectedVotingPower.IsNegative() || projectedVotingPower.GT(sdk.NewDec(100)) {
+			return nil, status.Errorf(codes.InvalidArgument, "voting power must be between 0 and 100, got %s", projectedVotingPower)
+		}
+	default:
+		delta := sdk.ZeroInt()
+		if req.DelegationChange != "" {
+			var ok bool
+			delta, ok = sdk.NewIntFromString(req.DelegationChange)
+			if !ok {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid delegation change %s", req.DelegationChange)
+			}
+		}
+
+		projectedVotingPower, err = q.CalculateProjectedVotingPower(ctx, validator, delta)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	projectedMinRate := q.CalculateDynCommissionForVotingPower(ctx, req.ValidatorAddr, projectedVotingPower)
+	projectedRate := q.GetTargetCommissionRate(ctx, req.ValidatorAddr)
+	if projectedRate.LT(projectedMinRate) {
+		projectedRate = projectedMinRate
+	}
+
+	return &types.QueryProjectedRateResponse{
+		VotingPower:          q.CalculateVotingPower(ctx, validator),
+		ProjectedVotingPower: projectedVotingPower,
+		MinRate:              q.GetDynCommissionRate(ctx, req.ValidatorAddr),
+		ProjectedMinRate:     projectedMinRate,
+		ProjectedRate:        projectedRate,
+	}, nil
+}
@@ -178,7 +178,6 @@ func CreateTestInput(t *testing.T) TestInput {
 	distrParams.BaseProposerReward = sdk.NewDecWithPrec(1, 2)
 	distrParams.BonusProposerReward = sdk.NewDecWithPrec(4, 2)
 	distrKeeper.SetParams(ctx, distrParams)
-	stakingKeeper.SetHooks(stakingtypes.NewMultiStakingHooks(distrKeeper.Hooks()))
 
 	feeCollectorAcc := authtypes.NewEmptyModuleAccount(authtypes.FeeCollectorName)
 	notBondedPool := authtypes.NewEmptyModuleAccount(stakingtypes.NotBondedPoolName, authtypes.Burner, authtypes.Staking)
@@ -207,6 +206,7 @@ func CreateTestInput(t *testing.T) TestInput {
 		paramsKeeper.Subspace(types.ModuleName),
 		stakingKeeper,
 	)
+	stakingKeeper.SetHooks(stakingtypes.NewMultiStakingHooks(distrKeeper.Hooks(), dyncommKeeper.Hooks()))
 	dyncommKeeper.SetParams(
 		ctx, types.DefaultParams(),
 	)
@@ -3,13 +3,12 @@ package dyncomm
 import (
 	"context"
 	"encoding/json"
-	"fmt"
 	"math/rand"
 
 	"github.com/classic-terra/core/v3/x/dyncomm/client/cli"
 	"github.com/classic-terra/core/v3/x/dyncomm/keeper"
+	"github.com/classic-terra/core/v3/x/dyncomm/simulation"
 	"github.com/classic-terra/core/v3/x/dyncomm/types"
-	"github.com/classic-terra/core/v3/x/market/simulation"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
 
@@ -38,6 +37,8 @@ type AppModule struct {
 	AppModuleBasic
 	keeper        keeper.Keeper
 	stakingKeeper types.StakingKeeper
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
 }
 
 // NewAppModule creates a new AppModule object
@@ -45,11 +46,15 @@ func NewAppModule(
 	cdc codec.Codec,
 	keeper keeper.Keeper,
 	stakingKeeper types.StakingKeeper,
+	accountKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper,
 ) AppModule {
 	return AppModule{
 		AppModuleBasic: AppModuleBasic{cdc},
 		keeper:         keeper,
 		stakingKeeper:  stakingKeeper,
+		accountKeeper:  accountKeeper,
+		bankKeeper:     bankKeeper,
 	}
 }
 
@@ -95,7 +100,7 @@ func (AppModuleBasic) GetQueryCmd() *cobra.Command {
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (AppModule) ConsensusVersion() uint64 { return 2 }
 
 // ExportGenesis returns the exported genesis state as raw bytes for the dyncomm
 // module.
@@ -117,14 +122,22 @@ func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.
 // QuerierRoute returns the dyncomm module's querier route name.
 func (AppModule) QuerierRoute() string { return types.QuerierRoute }
 
-// RegisterInvariants performs a no-op for dyncomm module.
-func (AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+// RegisterInvariants registers the dyncomm module invariants.
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
 
 // RegisterServices registers module services.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	// no msg server for this module
 	querier := keeper.NewQuerier(am.keeper)
 	types.RegisterQueryServer(cfg.QueryServer(), querier)
+
+	m := keeper.NewMigrator(am.keeper)
+	err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2)
+	if err != nil {
+		panic(err)
+	}
 }
 
 // NewHandler returns an sdk.Handler for the dyncomm module.
@@ -134,19 +147,7 @@ func (am AppModule) NewHandler() sdk.Handler {
 
 // GenerateGenesisState creates a randomized GenState of the dyncomm module.
 func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
-	// workaround so that the staking module
-	// simulation would not fail
-	dyncommGenesis := types.DefaultGenesisState()
-	params := types.DefaultParams()
-	params.Cap = sdk.ZeroDec()
-	dyncommGenesis.Params = params
-	bz, err := json.MarshalIndent(&dyncommGenesis, "", " ")
-	if err != nil {
-		panic(err)
-	}
-
-	fmt.Printf("Selected default dyncomm parameters:\n%s\n", bz)
-	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(dyncommGenesis)
+	simulation.RandomizedGenState(simState)
 }
 
 // ProposalContents returns all the dyncomm content functions used to
@@ -156,9 +157,8 @@ func (am AppModule) ProposalContents(_ module.SimulationState) []simtypes.Weight
 }
 
 // RandomizedParams creates randomized dyncomm param changes for the simulator.
-func (AppModule) RandomizedParams(_ *rand.Rand) []simtypes.LegacyParamChange {
-	// workaround to make the sim work with staking module
-	return []simtypes.LegacyParamChange{}
+func (AppModule) RandomizedParams(r *rand.Rand) []simtypes.LegacyParamChange {
+	return simulation.ParamChanges(r)
 }
 
 // RegisterStoreDecoder registers a decoder for dyncomm module's types
@@ -167,8 +167,11 @@ func (am AppModule) RegisterStoreDecoder(sdr sdk.StoreDecoderRegistry) {
 }
 
 // WeightedOperations returns the all the dyncomm module operations with their respective weights.
-func (am AppModule) WeightedOperations(module.SimulationState) []simtypes.WeightedOperation {
-	return nil
+func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	return simulation.WeightedOperations(
+		simState.AppParams, simState.Cdc,
+		am.accountKeeper, am.bankKeeper, am.stakingKeeper, am.keeper,
+	)
 }
 
 // EndBlock returns the end blocker for the dyncomm module.
@@ -0,0 +1,35 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/classic-terra/core/v3/x/dyncomm/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding dyncomm type.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.MinCommissionRatesPrefix):
+			var rateA, rateB types.ValidatorCommissionRate
+			cdc.MustUnmarshal(kvA.Value, &rateA)
+			cdc.MustUnmarshal(kvB.Value, &rateB)
+			return fmt.Sprintf("%v\n%v", rateA, rateB)
+		case bytes.Equal(kvA.Key[:1], types.CommissionHistoryPrefix):
+			var historyA, historyB types.ValidatorCommissionHistory
+			cdc.MustUnmarshal(kvA.Value, &historyA)
+			cdc.MustUnmarshal(kvB.Value, &historyB)
+			return fmt.Sprintf("%v\n%v", historyA, historyB)
+		case bytes.Equal(kvA.Key[:1], types.DirtyValidatorsPrefix):
+			return fmt.Sprintf("%v\n%v", sdk.ValAddress(kvA.Value), sdk.ValAddress(kvB.Value))
+		default:
+			panic(fmt.Sprintf("invalid dyncomm key prefix %X", kvA.Key[:1]))
+		}
+	}
+}
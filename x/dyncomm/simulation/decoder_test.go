@@ -0,0 +1,73 @@
+package simulation
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/classic-terra/core/v3/x/dyncomm/keeper"
+	"github.com/classic-terra/core/v3/x/dyncomm/types"
+)
+
+var valAddr = sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+
+func TestDecodeDyncommStore(t *testing.T) {
+	cdc := keeper.MakeTestCodec(t)
+	dec := NewDecodeStore(cdc)
+
+	minRate := sdk.NewDecWithPrec(5, 2)
+	targetRate := sdk.NewDecWithPrec(1, 1)
+	votingPower := sdk.NewDecWithPrec(1234, 2)
+
+	rate := types.ValidatorCommissionRate{
+		ValidatorAddress:     valAddr.String(),
+		MinCommissionRate:    &minRate,
+		TargetCommissionRate: &targetRate,
+		VotingPower:          &votingPower,
+	}
+	history := types.ValidatorCommissionHistory{
+		Epoch:                3,
+		Height:               302400,
+		ValidatorAddress:     valAddr.String(),
+		VotingPower:          votingPower,
+		MinCommissionRate:    minRate,
+		TargetCommissionRate: targetRate,
+	}
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.GetMinCommissionRatesKey(valAddr.String()), Value: cdc.MustMarshal(&rate)},
+			{Key: types.GetCommissionHistoryKey(valAddr.String(), 3), Value: cdc.MustMarshal(&history)},
+			{Key: types.GetDirtyValidatorKey(valAddr), Value: valAddr},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"MinCommissionRates", fmt.Sprintf("%v\n%v", rate, rate)},
+		{"CommissionHistory", fmt.Sprintf("%v\n%v", history, history)},
+		{"DirtyValidators", fmt.Sprintf("%v\n%v", valAddr, valAddr)},
+		{"other", ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
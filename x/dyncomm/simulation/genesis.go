@@ -0,0 +1,159 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/classic-terra/core/v3/x/dyncomm/types"
+)
+
+// Simulation parameter constants
+const (
+	maxZeroKey              = "max_zero"
+	slopeBaseKey            = "slope_base"
+	slopeVpImpactKey        = "slope_vp_impact"
+	capKey                  = "cap"
+	scheduleKey             = "schedule"
+	votingPowerThresholdKey = "voting_power_threshold"
+	historyRetentionKey     = "history_retention_epochs"
+)
+
+// GenMaxZero randomized MaxZero
+func GenMaxZero(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(101)), 2)
+}
+
+// GenSlopeBase randomized SlopeBase
+func GenSlopeBase(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(51)), 1)
+}
+
+// GenSlopeVpImpact randomized SlopeVpImpact
+func GenSlopeVpImpact(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(1+r.Intn(200)), 1)
+}
+
+// GenCap randomized Cap
+func GenCap(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(51)), 2)
+}
+
+// GenSchedule randomized Schedule
+func GenSchedule(r *rand.Rand) types.CommissionSchedule {
+	switch r.Intn(3) {
+	case 1:
+		return types.CommissionSchedule{
+			Formula: types.CommissionFormulaPiecewiseLinear,
+			Points:  genCommissionPoints(r),
+		}
+	case 2:
+		return types.CommissionSchedule{
+			Formula: types.CommissionFormulaTieredBrackets,
+			Tiers:   genCommissionPoints(r),
+		}
+	default:
+		return types.DefaultSchedule
+	}
+}
+
+// GenVotingPowerThreshold randomized VotingPowerThreshold
+func GenVotingPowerThreshold(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(101)), 2)
+}
+
+// GenHistoryRetention randomized HistoryRetentionEpochs
+func GenHistoryRetention(r *rand.Rand) uint64 {
+	return uint64(1 + r.Intn(104))
+}
+
+// genCommissionPoints returns up to five points with strictly increasing
+// voting powers and rates of at most 50%
+func genCommissionPoints(r *rand.Rand) []types.CommissionPoint {
+	points := make([]types.CommissionPoint, 1+r.Intn(5))
+	votingPower := sdk.ZeroDec()
+	for i := range points {
+		votingPower = votingPower.Add(sdk.NewDec(int64(1 + r.Intn(20))))
+		points[i] = types.CommissionPoint{
+			VotingPower: votingPower,
+			Rate:        simtypes.RandomDecAmount(r, sdk.NewDecWithPrec(5, 1)),
+		}
+	}
+
+	return points
+}
+
+// RandomizedGenState generates a random GenesisState for dyncomm
+func RandomizedGenState(simState *module.SimulationState) {
+	var maxZero sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, maxZeroKey, &maxZero, simState.Rand,
+		func(r *rand.Rand) { maxZero = GenMaxZero(r) },
+	)
+
+	var slopeBase sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, slopeBaseKey, &slopeBase, simState.Rand,
+		func(r *rand.Rand) { slopeBase = GenSlopeBase(r) },
+	)
+
+	var slopeVpImpact sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, slopeVpImpactKey, &slopeVpImpact, simState.Rand,
+		func(r *rand.Rand) { slopeVpImpact = GenSlopeVpImpact(r) },
+	)
+
+	var capRate sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, capKey, &capRate, simState.Rand,
+		func(r *rand.Rand) { capRate = GenCap(r) },
+	)
+
+	var schedule types.CommissionSchedule
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, scheduleKey, &schedule, simState.Rand,
+		func(r *rand.Rand) { schedule = GenSchedule(r) },
+	)
+
+	var votingPowerThreshold sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, votingPowerThresholdKey, &votingPowerThreshold, simState.Rand,
+		func(r *rand.Rand) { votingPowerThreshold = GenVotingPowerThreshold(r) },
+	)
+
+	var historyRetention uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, historyRetentionKey, &historyRetention, simState.Rand,
+		func(r *rand.Rand) { historyRetention = GenHistoryRetention(r) },
+	)
+
+	dyncommGenesis := types.NewGenesisState(
+		types.Params{
+			MaxZero:                maxZero,
+			SlopeBase:              slopeBase,
+			SlopeVpImpact:          slopeVpImpact,
+			Cap:                    capRate,
+			Schedule:               schedule,
+			OptOutValidators:       types.DefaultOptOutValidators,
+			OptOutSchedule:         types.DefaultOptOutSchedule,
+			VotingPowerThreshold:   votingPowerThreshold,
+			HistoryRetentionEpochs: historyRetention,
+		},
+		[]types.ValidatorCommissionRate{},
+		[]types.ValidatorCommissionHistory{},
+	)
+
+	bz, err := json.MarshalIndent(&dyncommGenesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Selected randomly generated dyncomm parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(dyncommGenesis)
+}
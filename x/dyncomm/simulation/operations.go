@@ -0,0 +1,158 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"math/rand"
+
+	simappparams "cosmossdk.io/simapp/params"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	stakingsim "github.com/cosmos/cosmos-sdk/x/staking/simulation"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/classic-terra/core/v3/x/dyncomm/keeper"
+	"github.com/classic-terra/core/v3/x/dyncomm/types"
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights.
+// The staking module leaves MsgEditValidator to dyncomm, which picks commission rates
+// respecting the min commission rate, so the weight of the staking module is reused.
+func WeightedOperations(
+	appParams simtypes.AppParams,
+	cdc codec.JSONCodec,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	sk types.StakingKeeper,
+	k keeper.Keeper,
+) simulation.WeightedOperations {
+	var weightMsgEditValidator int
+	appParams.GetOrGenerate(cdc, stakingsim.OpWeightMsgEditValidator, &weightMsgEditValidator, nil,
+		func(*rand.Rand) {
+			weightMsgEditValidator = stakingsim.DefaultWeightMsgEditValidator
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgEditValidator,
+			SimulateMsgEditValidator(ak, bk, sk, k),
+		),
+	}
+}
+
+// SimulateMsgEditValidator generates a MsgEditValidator with a random commission
+// rate. Most edits keep the commission rate at or above the min commission rate
+// and must set the target rate, the others must be rejected.
+// nolint: funlen
+func SimulateMsgEditValidator(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	sk types.StakingKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		val, ok := testutil.RandSliceElem(r, sk.GetAllValidators(ctx))
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, stakingtypes.TypeMsgEditValidator, "unable to pick a validator"), nil, nil
+		}
+
+		commission := val.Commission
+		if ctx.BlockHeader().Time.Sub(commission.UpdateTime).Hours() < 24 {
+			return simtypes.NoOpMsg(types.ModuleName, stakingtypes.TypeMsgEditValidator, "commission updated within 24h"), nil, nil
+		}
+
+		simAccount, found := simtypes.FindAccount(accs, sdk.AccAddress(val.GetOperator()))
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, stakingtypes.TypeMsgEditValidator, "unable to find account"), nil,
+				fmt.Errorf("validator %s not found", val.GetOperator())
+		}
+
+		// the commission rate can change by at most the max change rate
+		lowerRate := sdk.MaxDec(commission.Rate.Sub(commission.MaxChangeRate), sdk.ZeroDec())
+		upperRate := sdk.MinDec(commission.Rate.Add(commission.MaxChangeRate), commission.MaxRate)
+		minRate := k.GetEffectiveMinCommissionRate(ctx, val.OperatorAddress)
+
+		belowMinRate := minRate.GT(lowerRate) && r.Intn(5) == 0
+		if belowMinRate {
+			upperRate = sdk.MinDec(minRate, upperRate)
+		} else {
+			lowerRate = sdk.MaxDec(minRate, lowerRate)
+		}
+
+		if lowerRate.GT(upperRate) {
+			return simtypes.NoOpMsg(types.ModuleName, stakingtypes.TypeMsgEditValidator, "no valid commission rate"), nil, nil
+		}
+
+		newRate := lowerRate.Add(simtypes.RandomDecAmount(r, upperRate.Sub(lowerRate)))
+		if belowMinRate && !newRate.LT(minRate) {
+			return simtypes.NoOpMsg(types.ModuleName, stakingtypes.TypeMsgEditValidator, "commission rate not below min rate"), nil, nil
+		}
+
+		description := stakingtypes.NewDescription(
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 10),
+		)
+		msg := stakingtypes.NewMsgEditValidator(val.GetOperator(), description, &newRate, nil)
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		fees, err := simtypes.RandomFees(r, ctx, bk.SpendableCoins(ctx, simAccount.Address))
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, stakingtypes.TypeMsgEditValidator, "unable to generate fees"), nil, err
+		}
+
+		txGen := simappparams.MakeTestEncodingConfig().TxConfig
+		tx, err := simtestutil.GenSignedMockTx(
+			r,
+			txGen,
+			[]sdk.Msg{msg},
+			fees,
+			simtestutil.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, stakingtypes.TypeMsgEditValidator, "unable to generate mock tx"), nil, err
+		}
+
+		_, _, err = app.SimDeliver(txGen.TxEncoder(), tx)
+		if belowMinRate {
+			if err == nil {
+				return simtypes.NoOpMsg(types.ModuleName, stakingtypes.TypeMsgEditValidator, "commission rate below min rate accepted"), nil,
+					fmt.Errorf("commission rate %s of %s is below the min commission rate %s", newRate, val.OperatorAddress, minRate)
+			}
+
+			return simtypes.NewOperationMsg(msg, false, "commission rate below min rate", nil), nil, nil
+		}
+
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, stakingtypes.TypeMsgEditValidator, "unable to deliver tx"), nil, err
+		}
+
+		val, _ = sk.GetValidator(ctx, val.GetOperator())
+		if !val.Commission.Rate.Equal(newRate) {
+			return simtypes.NoOpMsg(types.ModuleName, stakingtypes.TypeMsgEditValidator, "commission rate mismatch"), nil,
+				fmt.Errorf("commission rate of %s is %s instead of %s", val.OperatorAddress, val.Commission.Rate, newRate)
+		}
+
+		if targetRate := k.GetTargetCommissionRate(ctx, val.OperatorAddress); !targetRate.Equal(newRate) {
+			return simtypes.NoOpMsg(types.ModuleName, stakingtypes.TypeMsgEditValidator, "target commission rate mismatch"), nil,
+				fmt.Errorf("target commission rate of %s is %s instead of %s", val.OperatorAddress, targetRate, newRate)
+		}
+
+		return simtypes.NewOperationMsg(msg, true, "", nil), nil, nil
+	}
+}
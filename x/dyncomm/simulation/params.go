@@ -0,0 +1,50 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"math/rand"
+
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/classic-terra/core/v3/x/dyncomm/types"
+)
+
+// ParamChanges defines the parameters that can be modified by param change proposals
+// on the simulation
+func ParamChanges(*rand.Rand) []simtypes.LegacyParamChange {
+	return []simtypes.LegacyParamChange{
+		simulation.NewSimLegacyParamChange(types.ModuleName, string(types.KeyMaxZero),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenMaxZero(r))
+			},
+		),
+		simulation.NewSimLegacyParamChange(types.ModuleName, string(types.KeySlopeBase),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenSlopeBase(r))
+			},
+		),
+		simulation.NewSimLegacyParamChange(types.ModuleName, string(types.KeySlopeVpImpact),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenSlopeVpImpact(r))
+			},
+		),
+		simulation.NewSimLegacyParamChange(types.ModuleName, string(types.KeyCap),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenCap(r))
+			},
+		),
+		simulation.NewSimLegacyParamChange(types.ModuleName, string(types.KeyVotingPowerThreshold),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", GenVotingPowerThreshold(r))
+			},
+		),
+		simulation.NewSimLegacyParamChange(types.ModuleName, string(types.KeyHistoryRetention),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenHistoryRetention(r))
+			},
+		),
+	}
+}
@@ -25,12 +25,59 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// CommissionFormula defines the available formulas of the min commission rate
+type CommissionFormula int32
+
+const (
+	// CommissionFormulaStrathColes is StrathColes curve (x-A)*(x/C+B) capped at D
+	CommissionFormulaStrathColes CommissionFormula = 0
+	// CommissionFormulaPiecewiseLinear interpolates linearly between points
+	CommissionFormulaPiecewiseLinear CommissionFormula = 1
+	// CommissionFormulaTieredBrackets applies the rate of the highest reached tier
+	CommissionFormulaTieredBrackets CommissionFormula = 2
+)
+
+var CommissionFormula_name = map[int32]string{
+	0: "CommissionFormulaStrathColes",
+	1: "CommissionFormulaPiecewiseLinear",
+	2: "CommissionFormulaTieredBrackets",
+}
+
+var CommissionFormula_value = map[string]int32{
+	"CommissionFormulaStrathColes":     0,
+	"CommissionFormulaPiecewiseLinear": 1,
+	"CommissionFormulaTieredBrackets":  2,
+}
+
+func (x CommissionFormula) String() string {
+	return proto.EnumName(CommissionFormula_name, int32(x))
+}
+
+func (CommissionFormula) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_960758a428b59bad, []int{0}
+}
+
 // Params defines the parameters for the dyncomm module.
 type Params struct {
 	MaxZero       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=max_zero,json=maxZero,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_zero" yaml:"max_zero"`
 	SlopeBase     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=slope_base,json=slopeBase,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slope_base" yaml:"slope_base"`
 	SlopeVpImpact github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=slope_vp_impact,json=slopeVpImpact,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slope_vp_impact" yaml:"slope_vp_impact"`
 	Cap           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=cap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"cap" yaml:"cap"`
+	// schedule defines the formula the min commission rate of the validators
+	// is calculated with
+	Schedule CommissionSchedule `protobuf:"bytes,5,opt,name=schedule,proto3" json:"schedule" yaml:"schedule"`
+	// opt_out_validators defines the validators whose min commission rate
+	// follows the opt-out schedule
+	OptOutValidators []string `protobuf:"bytes,6,rep,name=opt_out_validators,json=optOutValidators,proto3" json:"opt_out_validators,omitempty" yaml:"opt_out_validators"`
+	// opt_out_schedule defines the formula the min commission rate of the
+	// opt-out validators is calculated with
+	OptOutSchedule CommissionSchedule `protobuf:"bytes,7,opt,name=opt_out_schedule,json=optOutSchedule,proto3" json:"opt_out_schedule" yaml:"opt_out_schedule"`
+	// voting_power_threshold defines the change of voting power in percentage
+	// points that triggers the recalculation of a validator within an epoch
+	VotingPowerThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=voting_power_threshold,json=votingPowerThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"voting_power_threshold" yaml:"voting_power_threshold"`
+	// history_retention_epochs defines the number of epochs the commission
+	// history of the validators is kept for
+	HistoryRetentionEpochs uint64 `protobuf:"varint,9,opt,name=history_retention_epochs,json=historyRetentionEpochs,proto3" json:"history_retention_epochs,omitempty" yaml:"history_retention_epochs"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -65,8 +112,141 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetSchedule() CommissionSchedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return CommissionSchedule{}
+}
+
+func (m *Params) GetOptOutValidators() []string {
+	if m != nil {
+		return m.OptOutValidators
+	}
+	return nil
+}
+
+func (m *Params) GetOptOutSchedule() CommissionSchedule {
+	if m != nil {
+		return m.OptOutSchedule
+	}
+	return CommissionSchedule{}
+}
+
+func (m *Params) GetHistoryRetentionEpochs() uint64 {
+	if m != nil {
+		return m.HistoryRetentionEpochs
+	}
+	return 0
+}
+
+// CommissionSchedule defines a formula and its configuration
+type CommissionSchedule struct {
+	Formula CommissionFormula `protobuf:"varint,1,opt,name=formula,proto3,enum=terra.dyncomm.v1beta1.CommissionFormula" json:"formula,omitempty" yaml:"formula"`
+	// points of the piecewise-linear formula
+	Points []CommissionPoint `protobuf:"bytes,2,rep,name=points,proto3" json:"points" yaml:"points"`
+	// tiers of the tiered-bracket formula
+	Tiers []CommissionPoint `protobuf:"bytes,3,rep,name=tiers,proto3" json:"tiers" yaml:"tiers"`
+}
+
+func (m *CommissionSchedule) Reset()         { *m = CommissionSchedule{} }
+func (m *CommissionSchedule) String() string { return proto.CompactTextString(m) }
+func (*CommissionSchedule) ProtoMessage()    {}
+func (*CommissionSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_960758a428b59bad, []int{1}
+}
+func (m *CommissionSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommissionSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommissionSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommissionSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommissionSchedule.Merge(m, src)
+}
+func (m *CommissionSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommissionSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommissionSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommissionSchedule proto.InternalMessageInfo
+
+func (m *CommissionSchedule) GetFormula() CommissionFormula {
+	if m != nil {
+		return m.Formula
+	}
+	return CommissionFormulaStrathColes
+}
+
+func (m *CommissionSchedule) GetPoints() []CommissionPoint {
+	if m != nil {
+		return m.Points
+	}
+	return nil
+}
+
+func (m *CommissionSchedule) GetTiers() []CommissionPoint {
+	if m != nil {
+		return m.Tiers
+	}
+	return nil
+}
+
+// CommissionPoint defines the min commission rate at a voting power in percent
+type CommissionPoint struct {
+	VotingPower github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=voting_power,json=votingPower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"voting_power" yaml:"voting_power"`
+	Rate        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate" yaml:"rate"`
+}
+
+func (m *CommissionPoint) Reset()         { *m = CommissionPoint{} }
+func (m *CommissionPoint) String() string { return proto.CompactTextString(m) }
+func (*CommissionPoint) ProtoMessage()    {}
+func (*CommissionPoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_960758a428b59bad, []int{2}
+}
+func (m *CommissionPoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommissionPoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommissionPoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommissionPoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommissionPoint.Merge(m, src)
+}
+func (m *CommissionPoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommissionPoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommissionPoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommissionPoint proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterEnum("terra.dyncomm.v1beta1.CommissionFormula", CommissionFormula_name, CommissionFormula_value)
 	proto.RegisterType((*Params)(nil), "terra.dyncomm.v1beta1.Params")
+	proto.RegisterType((*CommissionSchedule)(nil), "terra.dyncomm.v1beta1.CommissionSchedule")
+	proto.RegisterType((*CommissionPoint)(nil), "terra.dyncomm.v1beta1.CommissionPoint")
 }
 
 func init() {
@@ -74,30 +254,56 @@ func init() {
 }
 
 var fileDescriptor_960758a428b59bad = []byte{
-	// 360 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0xd2, 0x3f, 0x4f, 0xfa, 0x40,
-	0x18, 0x07, 0xf0, 0xf6, 0xc7, 0x2f, 0x08, 0x97, 0x18, 0x62, 0xa3, 0xa6, 0x32, 0xb4, 0x06, 0x13,
-	0xe3, 0x42, 0x2b, 0x61, 0x63, 0x24, 0x2c, 0xb2, 0xf8, 0x67, 0x60, 0x20, 0x26, 0xcd, 0xd3, 0xe3,
-	0x82, 0x28, 0xe7, 0x5d, 0xee, 0x4e, 0x02, 0x0e, 0xbe, 0x06, 0x47, 0x47, 0x5e, 0x84, 0x2f, 0x82,
-	0xc9, 0x10, 0x27, 0xe3, 0x40, 0x0c, 0x2c, 0xce, 0xbe, 0x02, 0xc3, 0x5d, 0x41, 0xc2, 0xc6, 0xd4,
-	0x3e, 0x7f, 0xfa, 0xfd, 0x74, 0x78, 0xd0, 0x91, 0x22, 0x42, 0x40, 0xd8, 0x1a, 0xdc, 0x63, 0x46,
-	0x69, 0xd8, 0x2b, 0xc5, 0x44, 0x41, 0x69, 0x51, 0x07, 0x5c, 0x30, 0xc5, 0x9c, 0x3d, 0xbd, 0x14,
-	0x2c, 0x9a, 0xc9, 0x52, 0xfe, 0x00, 0x33, 0x49, 0x99, 0x8c, 0xf4, 0x52, 0x68, 0x0a, 0xf3, 0x45,
-	0x7e, 0xb7, 0xcd, 0xda, 0xcc, 0xf4, 0xe7, 0x6f, 0xa6, 0x5b, 0x78, 0x4b, 0xa1, 0xf4, 0x05, 0x08,
-	0xa0, 0xd2, 0xb9, 0x45, 0x19, 0x0a, 0xfd, 0xe8, 0x91, 0x08, 0xe6, 0xda, 0x87, 0xf6, 0x49, 0xb6,
-	0x7a, 0x3e, 0x9a, 0xf8, 0xd6, 0xe7, 0xc4, 0x3f, 0x6e, 0x77, 0xd4, 0xcd, 0x43, 0x1c, 0x60, 0x46,
-	0x93, 0xcc, 0xe4, 0x51, 0x94, 0xad, 0xbb, 0x50, 0x0d, 0x38, 0x91, 0x41, 0x8d, 0xe0, 0x9f, 0x89,
-	0x9f, 0x1b, 0x00, 0xed, 0x56, 0x0a, 0x8b, 0x9c, 0xc2, 0xfb, 0x6b, 0x11, 0x25, 0x7f, 0x51, 0x23,
-	0xf8, 0x6a, 0x8b, 0x42, 0xbf, 0x49, 0x04, 0x73, 0x38, 0x42, 0xb2, 0xcb, 0x38, 0x89, 0x62, 0x90,
-	0xc4, 0xfd, 0xa7, 0xb5, 0xcb, 0x8d, 0xb5, 0x1d, 0xa3, 0xfd, 0x25, 0xad, 0x7b, 0x59, 0x3d, 0xaa,
-	0x82, 0x24, 0xce, 0x13, 0xca, 0x99, 0xbd, 0x1e, 0x8f, 0x3a, 0x94, 0x03, 0x56, 0x6e, 0x4a, 0xb3,
-	0x8d, 0x8d, 0xd9, 0xfd, 0x55, 0x76, 0x19, 0xb7, 0x6e, 0x6f, 0xeb, 0x79, 0x83, 0x9f, 0xe9, 0xa9,
-	0x73, 0x8d, 0x52, 0x18, 0xb8, 0xfb, 0x5f, 0x9b, 0xf5, 0x8d, 0x4d, 0x64, 0x4c, 0x0c, 0x7c, 0xdd,
-	0x99, 0xc7, 0x56, 0x32, 0x2f, 0x43, 0xdf, 0xfa, 0x1e, 0xfa, 0x76, 0xb5, 0x3e, 0x9a, 0x7a, 0xf6,
-	0x78, 0xea, 0xd9, 0x5f, 0x53, 0xcf, 0x7e, 0x9e, 0x79, 0xd6, 0x78, 0xe6, 0x59, 0x1f, 0x33, 0xcf,
-	0x6a, 0x9e, 0xae, 0x62, 0x5d, 0x90, 0xb2, 0x83, 0x8b, 0xe6, 0xd4, 0x30, 0x13, 0x24, 0xec, 0x95,
-	0xc3, 0xfe, 0xf2, 0xe8, 0x34, 0x1d, 0xa7, 0xf5, 0x8d, 0x94, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff,
-	0xb4, 0x58, 0x6a, 0x24, 0x92, 0x02, 0x00, 0x00,
+	// 782 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0xcf, 0x4f, 0xe3, 0x46,
+	0x14, 0xc7, 0xe3, 0x24, 0xe4, 0xc7, 0x84, 0x1f, 0x61, 0xca, 0x0f, 0x83, 0x4a, 0x1c, 0x99, 0x0a,
+	0xa5, 0x95, 0x48, 0x0a, 0xdc, 0x38, 0x1a, 0x5a, 0xa9, 0x50, 0x89, 0xd4, 0xd0, 0x1c, 0x50, 0xa9,
+	0x35, 0x71, 0xa6, 0x89, 0x8b, 0x9d, 0xb1, 0x66, 0x26, 0x81, 0xf4, 0xd0, 0x63, 0xd5, 0x63, 0xa5,
+	0x5e, 0x7a, 0xa9, 0x84, 0xb4, 0xff, 0xc2, 0xfe, 0x11, 0x1c, 0xd1, 0x9e, 0x56, 0x7b, 0x88, 0x56,
+	0x20, 0xad, 0xf6, 0xb8, 0xca, 0x5f, 0xb0, 0xca, 0x8c, 0x1d, 0xb2, 0x09, 0x2b, 0xe4, 0x13, 0xf8,
+	0xbd, 0xef, 0xfb, 0x7c, 0x9f, 0xe7, 0xbd, 0x78, 0xc0, 0x26, 0xc7, 0x94, 0xa2, 0x4a, 0xa3, 0xd7,
+	0xb6, 0x89, 0xe7, 0x55, 0xba, 0x3b, 0x75, 0xcc, 0xd1, 0x4e, 0xf8, 0x5c, 0xf6, 0x29, 0xe1, 0x04,
+	0x2e, 0x0b, 0x51, 0x39, 0x0c, 0x06, 0xa2, 0xf5, 0x35, 0x9b, 0x30, 0x8f, 0x30, 0x4b, 0x88, 0x2a,
+	0xf2, 0x41, 0x56, 0xac, 0x2f, 0x35, 0x49, 0x93, 0xc8, 0xf8, 0xf0, 0x3f, 0x19, 0xd5, 0xdf, 0xa5,
+	0x41, 0xaa, 0x8a, 0x28, 0xf2, 0x18, 0xfc, 0x1d, 0x64, 0x3c, 0x74, 0x6d, 0xfd, 0x81, 0x29, 0x51,
+	0x95, 0xa2, 0x52, 0xca, 0x1a, 0x27, 0xb7, 0x7d, 0x2d, 0xf6, 0xa6, 0xaf, 0x6d, 0x35, 0x1d, 0xde,
+	0xea, 0xd4, 0xcb, 0x36, 0xf1, 0x02, 0x66, 0xf0, 0x67, 0x9b, 0x35, 0x2e, 0x2b, 0xbc, 0xe7, 0x63,
+	0x56, 0x3e, 0xc4, 0xf6, 0xa0, 0xaf, 0x2d, 0xf4, 0x90, 0xe7, 0xee, 0xeb, 0x21, 0x47, 0x7f, 0xf5,
+	0x72, 0x1b, 0x04, 0x5d, 0x1c, 0x62, 0xdb, 0x4c, 0x7b, 0xe8, 0xfa, 0x1c, 0x53, 0x02, 0x7d, 0x00,
+	0x98, 0x4b, 0x7c, 0x6c, 0xd5, 0x11, 0xc3, 0x6a, 0x5c, 0xb8, 0xfd, 0x14, 0xd9, 0x6d, 0x51, 0xba,
+	0x3d, 0x92, 0x26, 0xfd, 0xb2, 0x22, 0x65, 0x20, 0x86, 0xe1, 0x9f, 0x60, 0x41, 0xea, 0xba, 0xbe,
+	0xe5, 0x78, 0x3e, 0xb2, 0xb9, 0x9a, 0x10, 0xb6, 0xb5, 0xc8, 0xb6, 0x2b, 0xe3, 0xb6, 0x23, 0xdc,
+	0xa4, 0xf7, 0x9c, 0xc8, 0xd7, 0xfc, 0x1f, 0x44, 0x16, 0xfe, 0x02, 0x12, 0x36, 0xf2, 0xd5, 0xa4,
+	0xf0, 0x3c, 0x8a, 0xec, 0x09, 0xa4, 0xa7, 0x8d, 0xfc, 0x49, 0x9f, 0x21, 0x16, 0xfe, 0x0a, 0x32,
+	0xcc, 0x6e, 0xe1, 0x46, 0xc7, 0xc5, 0xea, 0x4c, 0x51, 0x29, 0xe5, 0x76, 0xbf, 0x2e, 0x3f, 0xb9,
+	0x21, 0xe5, 0x03, 0xe2, 0x79, 0x0e, 0x63, 0x0e, 0x69, 0x9f, 0x06, 0x05, 0xc6, 0xea, 0xb0, 0x9b,
+	0xc7, 0xe1, 0x85, 0x20, 0xdd, 0x1c, 0x31, 0xe1, 0x31, 0x80, 0xc4, 0xe7, 0x16, 0xe9, 0x70, 0xab,
+	0x8b, 0x5c, 0xa7, 0x81, 0x38, 0xa1, 0x4c, 0x4d, 0x15, 0x13, 0xa5, 0xac, 0xb1, 0x31, 0xe8, 0x6b,
+	0x6b, 0xb2, 0x74, 0x5a, 0xa3, 0x9b, 0x79, 0xe2, 0xf3, 0x93, 0x0e, 0xaf, 0x8d, 0x42, 0x90, 0x83,
+	0x7c, 0x28, 0x1c, 0x35, 0x9d, 0x8e, 0xda, 0xb4, 0x16, 0x34, 0xbd, 0xfa, 0xa9, 0xf3, 0x63, 0xf3,
+	0xf3, 0xd2, 0x37, 0x2c, 0x80, 0xff, 0x2a, 0x60, 0xa5, 0x4b, 0xb8, 0xd3, 0x6e, 0x5a, 0x3e, 0xb9,
+	0xc2, 0xd4, 0xe2, 0x2d, 0x8a, 0x59, 0x8b, 0xb8, 0x0d, 0x35, 0x23, 0x86, 0x72, 0x11, 0x79, 0x28,
+	0x1b, 0xd2, 0xfb, 0x69, 0xea, 0xe4, 0x9c, 0x96, 0xa4, 0xac, 0x3a, 0x54, 0x9d, 0x85, 0x22, 0x78,
+	0x01, 0xd4, 0x96, 0xc3, 0x38, 0xa1, 0x3d, 0x8b, 0x62, 0x8e, 0xdb, 0xdc, 0x21, 0x6d, 0x0b, 0xfb,
+	0xc4, 0x6e, 0x31, 0x35, 0x5b, 0x54, 0x4a, 0x49, 0x63, 0x73, 0xd0, 0xd7, 0x34, 0x69, 0xf4, 0x39,
+	0xa5, 0x6e, 0xae, 0x04, 0x29, 0x33, 0xcc, 0x7c, 0x27, 0x12, 0xfb, 0x99, 0xff, 0x6e, 0xb4, 0xd8,
+	0xfb, 0x1b, 0x4d, 0xd1, 0xff, 0x8f, 0x03, 0x38, 0x7d, 0x8c, 0xb0, 0x06, 0xd2, 0xbf, 0x11, 0xea,
+	0x75, 0x5c, 0x24, 0x7e, 0xf3, 0xf3, 0xbb, 0xa5, 0x67, 0x47, 0xf0, 0xbd, 0xd4, 0x1b, 0x70, 0xd0,
+	0xd7, 0xe6, 0x65, 0x63, 0x01, 0x42, 0x37, 0x43, 0x18, 0xfc, 0x19, 0xa4, 0x7c, 0xe2, 0xb4, 0x39,
+	0x53, 0xe3, 0xc5, 0x44, 0x29, 0xb7, 0xbb, 0xf5, 0x2c, 0xb6, 0x3a, 0x94, 0x1b, 0xcb, 0xc1, 0x58,
+	0xe7, 0x24, 0x58, 0x32, 0x74, 0x33, 0x80, 0x41, 0x13, 0xcc, 0x70, 0x07, 0x53, 0xa6, 0x26, 0x22,
+	0x51, 0x97, 0x02, 0xea, 0xac, 0xa4, 0x0a, 0x84, 0x6e, 0x4a, 0xd4, 0x7e, 0x52, 0x9c, 0xcf, 0x07,
+	0x05, 0x2c, 0x4c, 0x94, 0xc1, 0x2e, 0x98, 0x1d, 0x9f, 0x6d, 0xf0, 0x55, 0x3c, 0x8d, 0xbc, 0x27,
+	0x5f, 0x4c, 0xef, 0xc9, 0xe4, 0x76, 0xe4, 0xc6, 0xb6, 0x03, 0x5a, 0x20, 0x49, 0x11, 0x0f, 0xbf,
+	0x8b, 0xc7, 0x91, 0xfd, 0x72, 0xd2, 0x6f, 0xc8, 0x98, 0xf4, 0x11, 0x60, 0xf9, 0xca, 0xdf, 0xfc,
+	0xa5, 0x80, 0xc5, 0xa9, 0xb1, 0xc2, 0x22, 0xf8, 0x72, 0x2a, 0x78, 0xca, 0x29, 0xe2, 0xad, 0x03,
+	0xe2, 0x62, 0x96, 0x8f, 0xc1, 0xaf, 0x40, 0x71, 0x4a, 0x51, 0x75, 0xb0, 0x8d, 0xaf, 0x1c, 0x86,
+	0x7f, 0x74, 0xda, 0x18, 0xd1, 0xbc, 0x02, 0x37, 0x81, 0x36, 0xa5, 0x3a, 0x73, 0x30, 0xc5, 0x0d,
+	0x83, 0x22, 0xfb, 0x12, 0x73, 0x96, 0x8f, 0xaf, 0x27, 0xff, 0x7e, 0x51, 0x88, 0x19, 0x47, 0xb7,
+	0xf7, 0x05, 0xe5, 0xee, 0xbe, 0xa0, 0xbc, 0xbd, 0x2f, 0x28, 0xff, 0x3c, 0x14, 0x62, 0x77, 0x0f,
+	0x85, 0xd8, 0xeb, 0x87, 0x42, 0xec, 0xfc, 0xdb, 0xf1, 0x77, 0x76, 0x11, 0x63, 0x8e, 0xbd, 0x2d,
+	0xaf, 0x47, 0x9b, 0x50, 0x5c, 0xe9, 0xee, 0x55, 0xae, 0x47, 0x17, 0xa5, 0x38, 0x81, 0x7a, 0x4a,
+	0xdc, 0x6b, 0x7b, 0x1f, 0x07, 0x00, 0xcf, 0x5a, 0x0f, 0x94, 0x46, 0x07, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -131,6 +337,93 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.Cap.Equal(that1.Cap) {
 		return false
 	}
+	if !this.Schedule.Equal(&that1.Schedule) {
+		return false
+	}
+	if len(this.OptOutValidators) != len(that1.OptOutValidators) {
+		return false
+	}
+	for i := range this.OptOutValidators {
+		if this.OptOutValidators[i] != that1.OptOutValidators[i] {
+			return false
+		}
+	}
+	if !this.OptOutSchedule.Equal(&that1.OptOutSchedule) {
+		return false
+	}
+	if !this.VotingPowerThreshold.Equal(that1.VotingPowerThreshold) {
+		return false
+	}
+	if this.HistoryRetentionEpochs != that1.HistoryRetentionEpochs {
+		return false
+	}
+	return true
+}
+func (this *CommissionSchedule) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CommissionSchedule)
+	if !ok {
+		that2, ok := that.(CommissionSchedule)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Formula != that1.Formula {
+		return false
+	}
+	if len(this.Points) != len(that1.Points) {
+		return false
+	}
+	for i := range this.Points {
+		if !this.Points[i].Equal(&that1.Points[i]) {
+			return false
+		}
+	}
+	if len(this.Tiers) != len(that1.Tiers) {
+		return false
+	}
+	for i := range this.Tiers {
+		if !this.Tiers[i].Equal(&that1.Tiers[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *CommissionPoint) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CommissionPoint)
+	if !ok {
+		that2, ok := that.(CommissionPoint)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.VotingPower.Equal(that1.VotingPower) {
+		return false
+	}
+	if !this.Rate.Equal(that1.Rate) {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -153,6 +446,50 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.HistoryRetentionEpochs != 0 {
+		i = encodeVarintDyncomm(dAtA, i, uint64(m.HistoryRetentionEpochs))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.VotingPowerThreshold.Size()
+		i -= size
+		if _, err := m.VotingPowerThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDyncomm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.OptOutSchedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDyncomm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.OptOutValidators) > 0 {
+		for iNdEx := len(m.OptOutValidators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OptOutValidators[iNdEx])
+			copy(dAtA[i:], m.OptOutValidators[iNdEx])
+			i = encodeVarintDyncomm(dAtA, i, uint64(len(m.OptOutValidators[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDyncomm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	{
 		size := m.Cap.Size()
 		i -= size
@@ -196,6 +533,105 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CommissionSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommissionSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommissionSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tiers) > 0 {
+		for iNdEx := len(m.Tiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDyncomm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Points) > 0 {
+		for iNdEx := len(m.Points) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Points[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDyncomm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Formula != 0 {
+		i = encodeVarintDyncomm(dAtA, i, uint64(m.Formula))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommissionPoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommissionPoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommissionPoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDyncomm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.VotingPower.Size()
+		i -= size
+		if _, err := m.VotingPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDyncomm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintDyncomm(dAtA []byte, offset int, v uint64) int {
 	offset -= sovDyncomm(v)
 	base := offset
@@ -221,14 +657,66 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovDyncomm(uint64(l))
 	l = m.Cap.Size()
 	n += 1 + l + sovDyncomm(uint64(l))
+	l = m.Schedule.Size()
+	n += 1 + l + sovDyncomm(uint64(l))
+	if len(m.OptOutValidators) > 0 {
+		for _, s := range m.OptOutValidators {
+			l = len(s)
+			n += 1 + l + sovDyncomm(uint64(l))
+		}
+	}
+	l = m.OptOutSchedule.Size()
+	n += 1 + l + sovDyncomm(uint64(l))
+	l = m.VotingPowerThreshold.Size()
+	n += 1 + l + sovDyncomm(uint64(l))
+	if m.HistoryRetentionEpochs != 0 {
+		n += 1 + sovDyncomm(uint64(m.HistoryRetentionEpochs))
+	}
 	return n
 }
 
-func sovDyncomm(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozDyncomm(x uint64) (n int) {
-	return sovDyncomm(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *CommissionSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Formula != 0 {
+		n += 1 + sovDyncomm(uint64(m.Formula))
+	}
+	if len(m.Points) > 0 {
+		for _, e := range m.Points {
+			l = e.Size()
+			n += 1 + l + sovDyncomm(uint64(l))
+		}
+	}
+	if len(m.Tiers) > 0 {
+		for _, e := range m.Tiers {
+			l = e.Size()
+			n += 1 + l + sovDyncomm(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CommissionPoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.VotingPower.Size()
+	n += 1 + l + sovDyncomm(uint64(l))
+	l = m.Rate.Size()
+	n += 1 + l + sovDyncomm(uint64(l))
+	return n
+}
+
+func sovDyncomm(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDyncomm(x uint64) (n int) {
+	return sovDyncomm(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -395,6 +883,412 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDyncomm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDyncomm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDyncomm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptOutValidators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDyncomm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDyncomm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDyncomm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptOutValidators = append(m.OptOutValidators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptOutSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDyncomm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDyncomm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDyncomm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OptOutSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPowerThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDyncomm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDyncomm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDyncomm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VotingPowerThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryRetentionEpochs", wireType)
+			}
+			m.HistoryRetentionEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDyncomm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoryRetentionEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDyncomm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDyncomm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommissionSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDyncomm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommissionSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommissionSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Formula", wireType)
+			}
+			m.Formula = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDyncomm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Formula |= CommissionFormula(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDyncomm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDyncomm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDyncomm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Points = append(m.Points, CommissionPoint{})
+			if err := m.Points[len(m.Points)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDyncomm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDyncomm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDyncomm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tiers = append(m.Tiers, CommissionPoint{})
+			if err := m.Tiers[len(m.Tiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDyncomm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDyncomm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommissionPoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDyncomm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommissionPoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommissionPoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDyncomm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDyncomm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDyncomm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDyncomm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDyncomm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDyncomm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDyncomm(dAtA[iNdEx:])
@@ -3,6 +3,7 @@ package types
 import (
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -13,4 +14,18 @@ type StakingKeeper interface {
 	PowerReduction(ctx sdk.Context) math.Int
 	IterateValidators(sdk.Context, func(index int64, validator stakingtypes.ValidatorI) (stop bool))
 	SetValidator(ctx sdk.Context, validator stakingtypes.Validator)
+	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (validator stakingtypes.Validator, found bool)
+
+	// only used for simulation
+	GetAllValidators(ctx sdk.Context) (validators []stakingtypes.Validator)
+}
+
+// AccountKeeper is expected keeper for auth module, only used for simulation
+type AccountKeeper interface {
+	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
+}
+
+// BankKeeper is expected keeper for bank module, only used for simulation
+type BankKeeper interface {
+	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 }
@@ -0,0 +1,141 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Formula calculates the min commission rate of a validator
+// from its voting power in percent
+type Formula interface {
+	MinRate(votingPower sdk.Dec) sdk.Dec
+}
+
+var (
+	_ Formula = StrathColesFormula{}
+	_ Formula = PiecewiseLinearFormula{}
+	_ Formula = TieredBracketsFormula{}
+)
+
+// StrathColesFormula is the curve (x-A)*(x/C+B) capped at D
+type StrathColesFormula struct {
+	MaxZero       sdk.Dec // A
+	SlopeBase     sdk.Dec // B
+	SlopeVpImpact sdk.Dec // C
+	Cap           sdk.Dec // D
+}
+
+// MinRate implements Formula
+func (f StrathColesFormula) MinRate(x sdk.Dec) sdk.Dec {
+	factorA := x.Sub(f.MaxZero)
+	factorB := x.Quo(f.SlopeVpImpact).Add(f.SlopeBase)
+
+	y := factorA.Mul(factorB)
+	if capPercent := f.Cap.MulInt64(100); y.GT(capPercent) {
+		y = capPercent
+	}
+	return y.QuoInt64(100)
+}
+
+// PiecewiseLinearFormula interpolates linearly between points ordered by
+// voting power, the rates of the first and last point apply beyond them
+type PiecewiseLinearFormula struct {
+	Points []CommissionPoint
+}
+
+// MinRate implements Formula
+func (f PiecewiseLinearFormula) MinRate(x sdk.Dec) sdk.Dec {
+	if len(f.Points) == 0 {
+		return sdk.ZeroDec()
+	}
+
+	if first := f.Points[0]; x.LTE(first.VotingPower) {
+		return first.Rate
+	}
+
+	for i := 1; i < len(f.Points); i++ {
+		lower, upper := f.Points[i-1], f.Points[i]
+		if x.GT(upper.VotingPower) {
+			continue
+		}
+
+		slope := upper.Rate.Sub(lower.Rate).Quo(upper.VotingPower.Sub(lower.VotingPower))
+		return lower.Rate.Add(x.Sub(lower.VotingPower).Mul(slope))
+	}
+
+	return f.Points[len(f.Points)-1].Rate
+}
+
+// TieredBracketsFormula applies the rate of the highest tier whose voting
+// power is reached, tiers are ordered by voting power
+type TieredBracketsFormula struct {
+	Tiers []CommissionPoint
+}
+
+// MinRate implements Formula
+func (f TieredBracketsFormula) MinRate(x sdk.Dec) sdk.Dec {
+	rate := sdk.ZeroDec()
+	for _, tier := range f.Tiers {
+		if x.LT(tier.VotingPower) {
+			break
+		}
+		rate = tier.Rate
+	}
+
+	return rate
+}
+
+// ToFormula returns the formula of the schedule, StrathColes curve is
+// configured by params
+func (s CommissionSchedule) ToFormula(params Params) Formula {
+	switch s.Formula {
+	case CommissionFormulaPiecewiseLinear:
+		return PiecewiseLinearFormula{Points: s.Points}
+	case CommissionFormulaTieredBrackets:
+		return TieredBracketsFormula{Tiers: s.Tiers}
+	default:
+		return StrathColesFormula{
+			MaxZero:       params.MaxZero,
+			SlopeBase:     params.SlopeBase,
+			SlopeVpImpact: params.SlopeVpImpact,
+			Cap:           params.Cap,
+		}
+	}
+}
+
+// Validate checks the points or tiers required by the formula
+func (s CommissionSchedule) Validate() error {
+	switch s.Formula {
+	case CommissionFormulaStrathColes:
+		return nil
+	case CommissionFormulaPiecewiseLinear:
+		if len(s.Points) == 0 {
+			return fmt.Errorf("piecewise-linear formula requires at least one point")
+		}
+		return validateCommissionPoints(s.Points)
+	case CommissionFormulaTieredBrackets:
+		if len(s.Tiers) == 0 {
+			return fmt.Errorf("tiered-brackets formula requires at least one tier")
+		}
+		return validateCommissionPoints(s.Tiers)
+	default:
+		return fmt.Errorf("unknown commission formula %s", s.Formula)
+	}
+}
+
+func validateCommissionPoints(points []CommissionPoint) error {
+	for i, point := range points {
+		if point.VotingPower.IsNil() || point.VotingPower.IsNegative() || point.VotingPower.GT(sdk.NewDec(100)) {
+			return fmt.Errorf("voting power shall be between 0 and 100: %s", point.VotingPower)
+		}
+		if point.Rate.IsNil() || point.Rate.IsNegative() || point.Rate.GT(sdk.OneDec()) {
+			return fmt.Errorf("rate shall be between 0 and 1.0: %s", point.Rate)
+		}
+		if i > 0 && !point.VotingPower.GT(points[i-1].VotingPower) {
+			return fmt.Errorf("voting powers shall be strictly increasing: %s after %s", point.VotingPower, points[i-1].VotingPower)
+		}
+	}
+
+	return nil
+}
@@ -1,10 +1,11 @@
 package types
 
 // NewGenesisState creates a new GenesisState object
-func NewGenesisState(params Params, rates []ValidatorCommissionRate) *GenesisState {
+func NewGenesisState(params Params, rates []ValidatorCommissionRate, history []ValidatorCommissionHistory) *GenesisState {
 	return &GenesisState{
-		Params:                   params,
-		ValidatorCommissionRates: rates,
+		Params:                     params,
+		ValidatorCommissionRates:   rates,
+		ValidatorCommissionHistory: history,
 	}
 }
 
@@ -12,7 +13,8 @@ func NewGenesisState(params Params, rates []ValidatorCommissionRate) *GenesisSta
 func DefaultGenesisState() *GenesisState {
 	emptySet := []ValidatorCommissionRate{}
 	return &GenesisState{
-		Params:                   DefaultParams(),
-		ValidatorCommissionRates: emptySet,
+		Params:                     DefaultParams(),
+		ValidatorCommissionRates:   emptySet,
+		ValidatorCommissionHistory: []ValidatorCommissionHistory{},
 	}
 }
@@ -28,8 +28,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the dyncomm module's genesis state.
 type GenesisState struct {
 	// params defines all the paramaters of the module.
-	Params                   Params                    `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	ValidatorCommissionRates []ValidatorCommissionRate `protobuf:"bytes,2,rep,name=validator_commission_rates,json=validatorCommissionRates,proto3" json:"validator_commission_rates"`
+	Params                     Params                       `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	ValidatorCommissionRates   []ValidatorCommissionRate    `protobuf:"bytes,2,rep,name=validator_commission_rates,json=validatorCommissionRates,proto3" json:"validator_commission_rates"`
+	ValidatorCommissionHistory []ValidatorCommissionHistory `protobuf:"bytes,3,rep,name=validator_commission_history,json=validatorCommissionHistory,proto3" json:"validator_commission_history"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,12 +80,22 @@ func (m *GenesisState) GetValidatorCommissionRates() []ValidatorCommissionRate {
 	return nil
 }
 
+func (m *GenesisState) GetValidatorCommissionHistory() []ValidatorCommissionHistory {
+	if m != nil {
+		return m.ValidatorCommissionHistory
+	}
+	return nil
+}
+
 // MinDynCommission defines a validator - min commission rate
 // pair to be enforced by the blockchain
 type ValidatorCommissionRate struct {
 	ValidatorAddress     string                                  `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 	MinCommissionRate    *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_commission_rate,omitempty"`
 	TargetCommissionRate *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=target_commission_rate,json=targetCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"target_commission_rate,omitempty"`
+	// voting_power defines the voting power in percent the min commission rate
+	// was calculated with
+	VotingPower *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=voting_power,json=votingPower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"voting_power,omitempty"`
 }
 
 func (m *ValidatorCommissionRate) Reset()         { *m = ValidatorCommissionRate{} }
@@ -127,9 +138,75 @@ func (m *ValidatorCommissionRate) GetValidatorAddress() string {
 	return ""
 }
 
+// ValidatorCommissionHistory records the voting power and the commission
+// rates of a validator computed at the end of an epoch
+type ValidatorCommissionHistory struct {
+	Epoch                uint64                                 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Height               int64                                  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	ValidatorAddress     string                                 `protobuf:"bytes,3,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	VotingPower          github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=voting_power,json=votingPower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"voting_power"`
+	MinCommissionRate    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_commission_rate"`
+	TargetCommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=target_commission_rate,json=targetCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"target_commission_rate"`
+}
+
+func (m *ValidatorCommissionHistory) Reset()         { *m = ValidatorCommissionHistory{} }
+func (m *ValidatorCommissionHistory) String() string { return proto.CompactTextString(m) }
+func (*ValidatorCommissionHistory) ProtoMessage()    {}
+func (*ValidatorCommissionHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac14a232c2479651, []int{2}
+}
+func (m *ValidatorCommissionHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorCommissionHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorCommissionHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorCommissionHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorCommissionHistory.Merge(m, src)
+}
+func (m *ValidatorCommissionHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorCommissionHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorCommissionHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorCommissionHistory proto.InternalMessageInfo
+
+func (m *ValidatorCommissionHistory) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ValidatorCommissionHistory) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ValidatorCommissionHistory) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "terra.dyncomm.v1beta1.GenesisState")
 	proto.RegisterType((*ValidatorCommissionRate)(nil), "terra.dyncomm.v1beta1.ValidatorCommissionRate")
+	proto.RegisterType((*ValidatorCommissionHistory)(nil), "terra.dyncomm.v1beta1.ValidatorCommissionHistory")
 }
 
 func init() {
@@ -137,32 +214,39 @@ func init() {
 }
 
 var fileDescriptor_ac14a232c2479651 = []byte{
-	// 391 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0x31, 0x4f, 0xfa, 0x40,
-	0x18, 0xc6, 0x5b, 0xf8, 0x87, 0xe4, 0x7f, 0x38, 0x48, 0x45, 0xad, 0x24, 0x16, 0x82, 0x89, 0x61,
-	0xe9, 0x55, 0x60, 0x31, 0x71, 0x12, 0x31, 0x26, 0x4e, 0xa6, 0x24, 0x0e, 0x2e, 0xe4, 0x68, 0x2f,
-	0xe5, 0x22, 0xed, 0x91, 0xbb, 0xb3, 0x91, 0x6f, 0xe1, 0x87, 0x61, 0x72, 0x71, 0x65, 0x24, 0x4c,
-	0xc6, 0x81, 0x18, 0xf8, 0x22, 0x86, 0xde, 0x81, 0x06, 0x61, 0x72, 0x6a, 0xfb, 0xde, 0xf3, 0x3c,
-	0xbf, 0xf7, 0xde, 0xbe, 0xe0, 0x44, 0x60, 0xc6, 0x90, 0xe3, 0x0f, 0x22, 0x8f, 0x86, 0xa1, 0x13,
-	0x57, 0x3b, 0x58, 0xa0, 0xaa, 0x13, 0xe0, 0x08, 0x73, 0xc2, 0x61, 0x9f, 0x51, 0x41, 0x8d, 0xfd,
-	0x44, 0x04, 0x95, 0x08, 0x2a, 0x51, 0xe1, 0xc8, 0xa3, 0x3c, 0xa4, 0xbc, 0x9d, 0x88, 0x1c, 0xf9,
-	0x21, 0x1d, 0x85, 0x7c, 0x40, 0x03, 0x2a, 0xeb, 0x8b, 0x37, 0x55, 0xdd, 0x02, 0x5b, 0xe6, 0x26,
-	0xa2, 0xf2, 0x9b, 0x0e, 0x76, 0x6e, 0x24, 0xbe, 0x25, 0x90, 0xc0, 0xc6, 0x05, 0xc8, 0xf4, 0x11,
-	0x43, 0x21, 0x37, 0xf5, 0x92, 0x5e, 0xc9, 0xd6, 0x8e, 0xe1, 0xc6, 0x76, 0xe0, 0x5d, 0x22, 0x6a,
-	0xfc, 0x1b, 0x4d, 0x8b, 0x9a, 0xab, 0x2c, 0x06, 0x03, 0x85, 0x18, 0xf5, 0x88, 0x8f, 0x04, 0x65,
-	0xed, 0x85, 0x9c, 0x70, 0x4e, 0x68, 0xd4, 0x66, 0x48, 0x60, 0x6e, 0xa6, 0x4a, 0xe9, 0x4a, 0xb6,
-	0x06, 0xb7, 0x04, 0xde, 0x2f, 0x8d, 0x57, 0x2b, 0x9f, 0x8b, 0x04, 0x56, 0x04, 0x33, 0xde, 0x7c,
-	0xcc, 0xcb, 0xaf, 0x29, 0x70, 0xb8, 0xc5, 0x6b, 0x5c, 0x83, 0xdc, 0x77, 0x3f, 0xc8, 0xf7, 0x19,
-	0xe6, 0xf2, 0x5e, 0xff, 0x1b, 0xe6, 0x64, 0x68, 0xe7, 0xd5, 0x14, 0x2f, 0xe5, 0x49, 0x4b, 0x30,
-	0x12, 0x05, 0xee, 0xee, 0xca, 0xa2, 0xea, 0x46, 0x17, 0xec, 0x85, 0x24, 0x5a, 0xbf, 0x90, 0x99,
-	0x4a, 0x82, 0xce, 0x3f, 0xa6, 0xc5, 0xd3, 0x80, 0x88, 0xee, 0x53, 0x07, 0x7a, 0x34, 0x54, 0x7f,
-	0x46, 0x3d, 0x6c, 0xee, 0x3f, 0x3a, 0x62, 0xd0, 0xc7, 0x1c, 0x36, 0xb1, 0x37, 0x19, 0xda, 0x40,
-	0x21, 0x9b, 0xd8, 0x73, 0x73, 0x21, 0x89, 0xd6, 0x1a, 0x8e, 0xc0, 0x81, 0x40, 0x2c, 0xc0, 0xe2,
-	0x17, 0x2c, 0xfd, 0x47, 0x58, 0x5e, 0xe6, 0xae, 0x0d, 0xf7, 0x76, 0x34, 0xb3, 0xf4, 0xf1, 0xcc,
-	0xd2, 0x3f, 0x67, 0x96, 0xfe, 0x32, 0xb7, 0xb4, 0xf1, 0xdc, 0xd2, 0xde, 0xe7, 0x96, 0xf6, 0x70,
-	0xf6, 0x93, 0xd2, 0x43, 0x9c, 0x13, 0xcf, 0x96, 0x0b, 0xe5, 0x51, 0x86, 0x9d, 0xb8, 0xee, 0x3c,
-	0xaf, 0x56, 0x2b, 0x61, 0x76, 0x32, 0xc9, 0x46, 0xd5, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0xcf,
-	0x05, 0xce, 0x08, 0xe5, 0x02, 0x00, 0x00,
+	// 510 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x9b, 0xa6, 0xab, 0x84, 0xbb, 0x03, 0x33, 0x65, 0x84, 0x08, 0xb2, 0xa9, 0x48, 0x68,
+	0x97, 0x26, 0x74, 0xbb, 0x20, 0xc1, 0x85, 0x32, 0x04, 0xe2, 0x34, 0x65, 0x12, 0x07, 0x38, 0x44,
+	0x6e, 0x62, 0x25, 0x16, 0x4d, 0x1c, 0xd9, 0x26, 0xd0, 0x6f, 0xc1, 0x8d, 0x2f, 0xb2, 0x13, 0x9f,
+	0x60, 0xc7, 0x69, 0xe2, 0x80, 0x38, 0x4c, 0xa8, 0xfd, 0x22, 0x28, 0xb6, 0x57, 0x50, 0x71, 0x24,
+	0x50, 0x7b, 0x4a, 0x6c, 0xff, 0xdf, 0xfb, 0x3d, 0xfb, 0xfd, 0xf5, 0xc0, 0x03, 0x81, 0x19, 0x43,
+	0x41, 0x32, 0x2b, 0x62, 0x9a, 0xe7, 0x41, 0x35, 0x9a, 0x60, 0x81, 0x46, 0x41, 0x8a, 0x0b, 0xcc,
+	0x09, 0xf7, 0x4b, 0x46, 0x05, 0x85, 0xb7, 0xa5, 0xc8, 0xd7, 0x22, 0x5f, 0x8b, 0xdc, 0xbb, 0x31,
+	0xe5, 0x39, 0xe5, 0x91, 0x14, 0x05, 0x6a, 0xa1, 0x22, 0xdc, 0x7e, 0x4a, 0x53, 0xaa, 0xf6, 0xeb,
+	0x3f, 0xbd, 0xdb, 0x00, 0xbb, 0xce, 0x2b, 0x45, 0x83, 0xaf, 0x6d, 0xb0, 0xfd, 0x52, 0xe1, 0x4f,
+	0x05, 0x12, 0x18, 0x3e, 0x01, 0xdd, 0x12, 0x31, 0x94, 0x73, 0xc7, 0xda, 0xb7, 0x0e, 0x7a, 0x87,
+	0xf7, 0x7d, 0x63, 0x39, 0xfe, 0x89, 0x14, 0x8d, 0x3b, 0xe7, 0x57, 0x7b, 0xad, 0x50, 0x87, 0x40,
+	0x06, 0xdc, 0x0a, 0x4d, 0x49, 0x82, 0x04, 0x65, 0x51, 0x2d, 0x27, 0x9c, 0x13, 0x5a, 0x44, 0x0c,
+	0x09, 0xcc, 0x9d, 0xf6, 0xbe, 0x7d, 0xd0, 0x3b, 0xf4, 0x1b, 0x12, 0xbe, 0xb9, 0x0e, 0x7c, 0xbe,
+	0x8c, 0x0b, 0x91, 0xc0, 0x9a, 0xe0, 0x54, 0xe6, 0x63, 0x0e, 0x67, 0xe0, 0x9e, 0x91, 0x99, 0x11,
+	0x2e, 0x28, 0x9b, 0x39, 0xb6, 0xa4, 0x8e, 0xfe, 0x9d, 0xfa, 0x4a, 0x05, 0x6a, 0xb0, 0x5b, 0x35,
+	0x2a, 0x06, 0x5f, 0x6c, 0x70, 0xa7, 0xa1, 0x6c, 0xf8, 0x02, 0xec, 0xfc, 0x2e, 0x0b, 0x25, 0x09,
+	0xc3, 0x5c, 0x3d, 0xe9, 0x8d, 0xb1, 0x73, 0x79, 0x36, 0xec, 0xeb, 0x06, 0x3e, 0x53, 0x27, 0xa7,
+	0x82, 0x91, 0x22, 0x0d, 0x6f, 0x2e, 0x43, 0xf4, 0x3e, 0xcc, 0xc0, 0xad, 0x9c, 0x14, 0xab, 0x6f,
+	0xe9, 0xb4, 0x65, 0xa2, 0xc7, 0x3f, 0xae, 0xf6, 0x1e, 0xa6, 0x44, 0x64, 0x1f, 0x26, 0x7e, 0x4c,
+	0x73, 0x6d, 0x0a, 0xfd, 0x19, 0xf2, 0xe4, 0x7d, 0x20, 0x66, 0x25, 0xe6, 0xfe, 0x31, 0x8e, 0x2f,
+	0xcf, 0x86, 0x40, 0x23, 0x8f, 0x71, 0x1c, 0xee, 0xe4, 0xa4, 0x58, 0x29, 0xb8, 0x00, 0xbb, 0x02,
+	0xb1, 0x14, 0x8b, 0xbf, 0x60, 0xf6, 0x9a, 0xb0, 0xbe, 0xca, 0xbb, 0xc2, 0x7b, 0x07, 0xb6, 0x2b,
+	0x2a, 0x48, 0x91, 0x46, 0x25, 0xfd, 0x88, 0x99, 0xd3, 0x59, 0x93, 0xd2, 0x53, 0xd9, 0x4e, 0xea,
+	0x64, 0x83, 0x6f, 0x36, 0x70, 0x9b, 0x5b, 0x0b, 0xfb, 0x60, 0x0b, 0x97, 0x34, 0xce, 0x64, 0x43,
+	0x3a, 0xa1, 0x5a, 0xc0, 0x5d, 0xd0, 0xcd, 0x30, 0x49, 0x33, 0x21, 0x9f, 0xd7, 0x0e, 0xf5, 0xca,
+	0xdc, 0x4a, 0xfb, 0xbf, 0x5b, 0x19, 0x19, 0x2f, 0xfc, 0xb4, 0x76, 0xd9, 0x46, 0x2e, 0x0d, 0xa7,
+	0x66, 0xaf, 0x6c, 0x6d, 0x80, 0x63, 0xf0, 0x0b, 0x6b, 0xf4, 0x4b, 0x77, 0x03, 0x40, 0xa3, 0x67,
+	0xc6, 0xaf, 0xcf, 0xe7, 0x9e, 0x75, 0x31, 0xf7, 0xac, 0x9f, 0x73, 0xcf, 0xfa, 0xbc, 0xf0, 0x5a,
+	0x17, 0x0b, 0xaf, 0xf5, 0x7d, 0xe1, 0xb5, 0xde, 0x3e, 0xfa, 0x93, 0x32, 0x45, 0x9c, 0x93, 0x78,
+	0xa8, 0xe6, 0x5f, 0x4c, 0x19, 0x0e, 0xaa, 0xa3, 0xe0, 0xd3, 0x72, 0x12, 0x4a, 0xe6, 0xa4, 0x2b,
+	0x07, 0xe0, 0xd1, 0xaf, 0x01, 0x00, 0xf2, 0x97, 0x3d, 0xe5, 0x94, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -185,6 +269,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ValidatorCommissionHistory) > 0 {
+		for iNdEx := len(m.ValidatorCommissionHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorCommissionHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.ValidatorCommissionRates) > 0 {
 		for iNdEx := len(m.ValidatorCommissionRates) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -232,6 +330,18 @@ func (m *ValidatorCommissionRate) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.VotingPower != nil {
+		{
+			size := m.VotingPower.Size()
+			i -= size
+			if _, err := m.VotingPower.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.TargetCommissionRate != nil {
 		{
 			size := m.TargetCommissionRate.Size()
@@ -266,6 +376,76 @@ func (m *ValidatorCommissionRate) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorCommissionHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorCommissionHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorCommissionHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TargetCommissionRate.Size()
+		i -= size
+		if _, err := m.TargetCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MinCommissionRate.Size()
+		i -= size
+		if _, err := m.MinCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.VotingPower.Size()
+		i -= size
+		if _, err := m.VotingPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -291,6 +471,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ValidatorCommissionHistory) > 0 {
+		for _, e := range m.ValidatorCommissionHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -312,6 +498,35 @@ func (m *ValidatorCommissionRate) Size() (n int) {
 		l = m.TargetCommissionRate.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if m.VotingPower != nil {
+		l = m.VotingPower.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorCommissionHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovGenesis(uint64(m.Epoch))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.VotingPower.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MinCommissionRate.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.TargetCommissionRate.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -417,6 +632,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorCommissionHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorCommissionHistory = append(m.ValidatorCommissionHistory, ValidatorCommissionHistory{})
+			if err := m.ValidatorCommissionHistory[len(m.ValidatorCommissionHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -571,6 +820,264 @@ func (m *ValidatorCommissionRate) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.VotingPower = &v
+			if err := m.VotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorCommissionHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorCommissionHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorCommissionHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
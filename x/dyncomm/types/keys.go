@@ -1,5 +1,11 @@
 package types
 
+import (
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName defines the module's name.
 	ModuleName = "dyncomm"
@@ -9,10 +15,40 @@ const (
 	QuerierRoute = ModuleName
 )
 
-// MinCommissionRatesPrefix store prefixes
-var MinCommissionRatesPrefix = []byte{0x01} // prefix for each MinCommissionRate entry
+// dyncomm module event types
+const (
+	EventTypeRateUpdate = "dyncomm_rate_update"
+
+	AttributeKeyValidator   = "validator"
+	AttributeKeyOldRate     = "old_rate"
+	AttributeKeyNewRate     = "new_rate"
+	AttributeKeyMinRate     = "min_rate"
+	AttributeKeyVotingPower = "voting_power"
+)
+
+// store prefixes
+var (
+	MinCommissionRatesPrefix = []byte{0x01} // prefix for each MinCommissionRate entry
+	CommissionHistoryPrefix  = []byte{0x02} // prefix for each per-epoch ValidatorCommissionHistory entry
+	DirtyValidatorsPrefix    = []byte{0x03} // prefix for each validator to be recalculated at the end of the block
+)
 
 // MinCommissionRates - stored by *validator addr*
 func GetMinCommissionRatesKey(addr string) []byte {
 	return append(MinCommissionRatesPrefix, []byte(addr)...)
 }
+
+// GetDirtyValidatorKey - stored by *validator addr*
+func GetDirtyValidatorKey(addr sdk.ValAddress) []byte {
+	return append(DirtyValidatorsPrefix, address.MustLengthPrefix(addr)...)
+}
+
+// GetCommissionHistoryPrefix - stored by *validator addr*
+func GetCommissionHistoryPrefix(addr string) []byte {
+	return append(CommissionHistoryPrefix, address.MustLengthPrefix([]byte(addr))...)
+}
+
+// GetCommissionHistoryKey - stored by *validator addr* and *epoch*
+func GetCommissionHistoryKey(addr string, epoch uint64) []byte {
+	return append(GetCommissionHistoryPrefix(addr), sdk.Uint64ToBigEndian(epoch)...)
+}
@@ -11,10 +11,15 @@ import (
 
 // Parameter keys
 var (
-	KeyMaxZero       = []byte("MaxZero")
-	KeySlopeBase     = []byte("SlopeBase")
-	KeySlopeVpImpact = []byte("SlopeVpImpact")
-	KeyCap           = []byte("Cap")
+	KeyMaxZero              = []byte("MaxZero")
+	KeySlopeBase            = []byte("SlopeBase")
+	KeySlopeVpImpact        = []byte("SlopeVpImpact")
+	KeyCap                  = []byte("Cap")
+	KeySchedule             = []byte("Schedule")
+	KeyOptOutValidators     = []byte("OptOutValidators")
+	KeyOptOutSchedule       = []byte("OptOutSchedule")
+	KeyVotingPowerThreshold = []byte("VotingPowerThreshold")
+	KeyHistoryRetention     = []byte("HistoryRetentionEpochs")
 )
 
 // Default dyncomm parameter values
@@ -23,6 +28,17 @@ var (
 	DefaultSlopeBase     = sdk.NewDecWithPrec(2, 0)  // StrathColes B = 2
 	DefaultSlopeVpImpact = sdk.NewDecWithPrec(10, 0) // StrathColes C = 10
 	DefaultCap           = sdk.NewDecWithPrec(2, 1)  // StrathColes D = 20%
+	DefaultSchedule      = CommissionSchedule{
+		Formula: CommissionFormulaStrathColes,
+	}
+	DefaultOptOutValidators = []string{}
+	// opt-out validators are only bound to the staking min commission rate
+	DefaultOptOutSchedule = CommissionSchedule{
+		Formula: CommissionFormulaTieredBrackets,
+		Tiers:   []CommissionPoint{{VotingPower: sdk.ZeroDec(), Rate: sdk.ZeroDec()}},
+	}
+	DefaultVotingPowerThreshold = sdk.NewDecWithPrec(1, 1) // 0.1 percentage points
+	DefaultHistoryRetention     = uint64(52)               // a year of weekly epochs
 )
 
 var _ paramstypes.ParamSet = &Params{}
@@ -30,10 +46,15 @@ var _ paramstypes.ParamSet = &Params{}
 // DefaultParams creates default dyncomm module parameters
 func DefaultParams() Params {
 	return Params{
-		MaxZero:       DefaultMaxZero,
-		SlopeBase:     DefaultSlopeBase,
-		SlopeVpImpact: DefaultSlopeVpImpact,
-		Cap:           DefaultCap,
+		MaxZero:                DefaultMaxZero,
+		SlopeBase:              DefaultSlopeBase,
+		SlopeVpImpact:          DefaultSlopeVpImpact,
+		Cap:                    DefaultCap,
+		Schedule:               DefaultSchedule,
+		OptOutValidators:       DefaultOptOutValidators,
+		OptOutSchedule:         DefaultOptOutSchedule,
+		VotingPowerThreshold:   DefaultVotingPowerThreshold,
+		HistoryRetentionEpochs: DefaultHistoryRetention,
 	}
 }
 
@@ -56,6 +77,11 @@ func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 		paramstypes.NewParamSetPair(KeySlopeBase, &p.SlopeBase, validateSlopeBase),
 		paramstypes.NewParamSetPair(KeySlopeVpImpact, &p.SlopeVpImpact, validateSlopeVpImpact),
 		paramstypes.NewParamSetPair(KeyCap, &p.Cap, validateCap),
+		paramstypes.NewParamSetPair(KeySchedule, &p.Schedule, validateSchedule),
+		paramstypes.NewParamSetPair(KeyOptOutValidators, &p.OptOutValidators, validateOptOutValidators),
+		paramstypes.NewParamSetPair(KeyOptOutSchedule, &p.OptOutSchedule, validateSchedule),
+		paramstypes.NewParamSetPair(KeyVotingPowerThreshold, &p.VotingPowerThreshold, validateVotingPowerThreshold),
+		paramstypes.NewParamSetPair(KeyHistoryRetention, &p.HistoryRetentionEpochs, validateHistoryRetention),
 	}
 }
 
@@ -79,10 +105,47 @@ func (p Params) Validate() error {
 	if p.MaxZero.GT(sdk.OneDec()) {
 		return fmt.Errorf("max zero shall be less than 1.0: %s", p.MaxZero)
 	}
+	if err := p.Schedule.Validate(); err != nil {
+		return fmt.Errorf("invalid schedule: %w", err)
+	}
+	if err := validateOptOutValidators(p.OptOutValidators); err != nil {
+		return err
+	}
+	if err := p.OptOutSchedule.Validate(); err != nil {
+		return fmt.Errorf("invalid opt-out schedule: %w", err)
+	}
+	if err := validateVotingPowerThreshold(p.VotingPowerThreshold); err != nil {
+		return err
+	}
+	if err := validateHistoryRetention(p.HistoryRetentionEpochs); err != nil {
+		return err
+	}
 
 	return nil
 }
 
+// IsOptOutValidator returns whether the min commission rate of the
+// validator follows the opt-out schedule
+func (p Params) IsOptOutValidator(validator string) bool {
+	for _, v := range p.OptOutValidators {
+		if v == validator {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetFormula returns the formula the min commission rate of the validator
+// is calculated with
+func (p Params) GetFormula(validator string) Formula {
+	if p.IsOptOutValidator(validator) {
+		return p.OptOutSchedule.ToFormula(p)
+	}
+
+	return p.Schedule.ToFormula(p)
+}
+
 func validateMaxZero(i interface{}) error {
 	v, ok := i.(sdk.Dec)
 	if !ok {
@@ -142,3 +205,62 @@ func validateCap(i interface{}) error {
 
 	return nil
 }
+
+func validateSchedule(i interface{}) error {
+	v, ok := i.(CommissionSchedule)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return v.Validate()
+}
+
+func validateOptOutValidators(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, validator := range v {
+		if _, err := sdk.ValAddressFromBech32(validator); err != nil {
+			return fmt.Errorf("invalid opt-out validator %s: %w", validator, err)
+		}
+		if seen[validator] {
+			return fmt.Errorf("duplicate opt-out validator %s", validator)
+		}
+		seen[validator] = true
+	}
+
+	return nil
+}
+
+func validateVotingPowerThreshold(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("voting power threshold shall be 0 or positive: %s", v)
+	}
+
+	if v.GT(sdk.NewDec(100)) {
+		return fmt.Errorf("voting power threshold shall be less than 100: %s", v)
+	}
+
+	return nil
+}
+
+func validateHistoryRetention(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("history retention epochs shall be positive: %d", v)
+	}
+
+	return nil
+}
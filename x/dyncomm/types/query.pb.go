@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -199,47 +200,288 @@ func (m *QueryRateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryRateResponse proto.InternalMessageInfo
 
+// QueryRateHistoryRequest is the request type for the Query/RateHistory RPC method.
+type QueryRateHistoryRequest struct {
+	// validator_addr defines the validator address to query for.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRateHistoryRequest) Reset()         { *m = QueryRateHistoryRequest{} }
+func (m *QueryRateHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRateHistoryRequest) ProtoMessage()    {}
+func (*QueryRateHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6284eb8921642edc, []int{4}
+}
+func (m *QueryRateHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRateHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRateHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateHistoryRequest.Merge(m, src)
+}
+func (m *QueryRateHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRateHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRateHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryRateHistoryRequest) GetValidatorAddr() string {
+	if m != nil {
+		return m.ValidatorAddr
+	}
+	return ""
+}
+
+func (m *QueryRateHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryRateHistoryResponse is the response type for the Query/RateHistory RPC method.
+type QueryRateHistoryResponse struct {
+	History []ValidatorCommissionHistory `protobuf:"bytes,1,rep,name=history,proto3" json:"history"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRateHistoryResponse) Reset()         { *m = QueryRateHistoryResponse{} }
+func (m *QueryRateHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRateHistoryResponse) ProtoMessage()    {}
+func (*QueryRateHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6284eb8921642edc, []int{5}
+}
+func (m *QueryRateHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRateHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRateHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateHistoryResponse.Merge(m, src)
+}
+func (m *QueryRateHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRateHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRateHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryRateHistoryResponse) GetHistory() []ValidatorCommissionHistory {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
+func (m *QueryRateHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryProjectedRateRequest is the request type for the Query/ProjectedRate RPC method.
+type QueryProjectedRateRequest struct {
+	// validator_addr defines the validator address to query for.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+	// voting_power defines a hypothetical voting power in percent.
+	VotingPower string `protobuf:"bytes,2,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+	// delegation_change defines a hypothetical change of the validator tokens,
+	// negative for an undelegation. Ignored if voting_power is set.
+	DelegationChange string `protobuf:"bytes,3,opt,name=delegation_change,json=delegationChange,proto3" json:"delegation_change,omitempty"`
+}
+
+func (m *QueryProjectedRateRequest) Reset()         { *m = QueryProjectedRateRequest{} }
+func (m *QueryProjectedRateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectedRateRequest) ProtoMessage()    {}
+func (*QueryProjectedRateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6284eb8921642edc, []int{6}
+}
+func (m *QueryProjectedRateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectedRateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectedRateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectedRateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectedRateRequest.Merge(m, src)
+}
+func (m *QueryProjectedRateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectedRateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectedRateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectedRateRequest proto.InternalMessageInfo
+
+func (m *QueryProjectedRateRequest) GetValidatorAddr() string {
+	if m != nil {
+		return m.ValidatorAddr
+	}
+	return ""
+}
+
+func (m *QueryProjectedRateRequest) GetVotingPower() string {
+	if m != nil {
+		return m.VotingPower
+	}
+	return ""
+}
+
+func (m *QueryProjectedRateRequest) GetDelegationChange() string {
+	if m != nil {
+		return m.DelegationChange
+	}
+	return ""
+}
+
+// QueryProjectedRateResponse is the response type for the Query/ProjectedRate RPC method.
+type QueryProjectedRateResponse struct {
+	VotingPower          github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=voting_power,json=votingPower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"voting_power"`
+	ProjectedVotingPower github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=projected_voting_power,json=projectedVotingPower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"projected_voting_power"`
+	MinRate              github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=min_rate,json=minRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_rate"`
+	ProjectedMinRate     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=projected_min_rate,json=projectedMinRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"projected_min_rate"`
+	ProjectedRate        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=projected_rate,json=projectedRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"projected_rate"`
+}
+
+func (m *QueryProjectedRateResponse) Reset()         { *m = QueryProjectedRateResponse{} }
+func (m *QueryProjectedRateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectedRateResponse) ProtoMessage()    {}
+func (*QueryProjectedRateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6284eb8921642edc, []int{7}
+}
+func (m *QueryProjectedRateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectedRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectedRateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectedRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectedRateResponse.Merge(m, src)
+}
+func (m *QueryProjectedRateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectedRateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectedRateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectedRateResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "terra.dyncomm.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "terra.dyncomm.v1beta1.QueryParamsResponse")
 	proto.RegisterType((*QueryRateRequest)(nil), "terra.dyncomm.v1beta1.QueryRateRequest")
 	proto.RegisterType((*QueryRateResponse)(nil), "terra.dyncomm.v1beta1.QueryRateResponse")
+	proto.RegisterType((*QueryRateHistoryRequest)(nil), "terra.dyncomm.v1beta1.QueryRateHistoryRequest")
+	proto.RegisterType((*QueryRateHistoryResponse)(nil), "terra.dyncomm.v1beta1.QueryRateHistoryResponse")
+	proto.RegisterType((*QueryProjectedRateRequest)(nil), "terra.dyncomm.v1beta1.QueryProjectedRateRequest")
+	proto.RegisterType((*QueryProjectedRateResponse)(nil), "terra.dyncomm.v1beta1.QueryProjectedRateResponse")
 }
 
 func init() { proto.RegisterFile("terra/dyncomm/v1beta1/query.proto", fileDescriptor_6284eb8921642edc) }
 
 var fileDescriptor_6284eb8921642edc = []byte{
-	// 467 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0x41, 0x8b, 0xd3, 0x40,
-	0x14, 0xc7, 0x33, 0xa5, 0x06, 0x1c, 0x51, 0x74, 0xac, 0x50, 0x83, 0x9b, 0x6a, 0x44, 0x5d, 0xc5,
-	0xcc, 0xb8, 0xbb, 0x1e, 0x04, 0x0f, 0x62, 0xd9, 0x93, 0x78, 0x58, 0xb3, 0x37, 0x2f, 0xcb, 0x34,
-	0x19, 0x62, 0xb0, 0xc9, 0x64, 0x67, 0xa6, 0xc5, 0x22, 0x5e, 0x3c, 0x78, 0x55, 0xf0, 0x1b, 0xf8,
-	0x15, 0xdc, 0x0f, 0xd1, 0x63, 0xa9, 0x17, 0xf1, 0x50, 0xa4, 0xf5, 0x83, 0x48, 0x66, 0xa6, 0xa5,
-	0xc5, 0x56, 0x85, 0x3d, 0x25, 0x79, 0xfc, 0xdf, 0xef, 0xfd, 0xf3, 0x7f, 0x0f, 0xde, 0x50, 0x4c,
-	0x08, 0x4a, 0x92, 0x41, 0x11, 0xf3, 0x3c, 0x27, 0xfd, 0x9d, 0x0e, 0x53, 0x74, 0x87, 0x1c, 0xf7,
-	0x98, 0x18, 0xe0, 0x52, 0x70, 0xc5, 0xd1, 0x15, 0x2d, 0xc1, 0x56, 0x82, 0xad, 0xc4, 0xbb, 0x1a,
-	0x73, 0x99, 0x73, 0x79, 0xa4, 0x45, 0xc4, 0x7c, 0x98, 0x0e, 0xaf, 0x91, 0xf2, 0x94, 0x9b, 0x7a,
-	0xf5, 0x66, 0xab, 0xd7, 0x52, 0xce, 0xd3, 0x2e, 0x23, 0xb4, 0xcc, 0x08, 0x2d, 0x0a, 0xae, 0xa8,
-	0xca, 0x78, 0x31, 0xef, 0xb9, 0xb9, 0xde, 0xc8, 0x7c, 0xaa, 0x16, 0x05, 0x0d, 0x88, 0x5e, 0x54,
-	0xce, 0x0e, 0xa8, 0xa0, 0xb9, 0x8c, 0xd8, 0x71, 0x8f, 0x49, 0x15, 0x44, 0xf0, 0xf2, 0x4a, 0x55,
-	0x96, 0xbc, 0x90, 0x0c, 0x3d, 0x86, 0x6e, 0xa9, 0x2b, 0x4d, 0x70, 0x1d, 0x6c, 0x9f, 0xdb, 0xdd,
-	0xc2, 0x6b, 0x7f, 0x04, 0x9b, 0xb6, 0x76, 0x7d, 0x38, 0x69, 0x39, 0x91, 0x6d, 0x09, 0x0e, 0xe1,
-	0x45, 0xcd, 0x8c, 0xa8, 0x62, 0x76, 0x0e, 0x7a, 0x02, 0x2f, 0xf4, 0x69, 0x37, 0x4b, 0xa8, 0xe2,
-	0xe2, 0x88, 0x26, 0x89, 0xd0, 0xe0, 0xb3, 0xed, 0xe6, 0xf8, 0x24, 0x6c, 0xd8, 0x00, 0x9e, 0x26,
-	0x89, 0x60, 0x52, 0x1e, 0x2a, 0x91, 0x15, 0x69, 0x74, 0x7e, 0xa1, 0xaf, 0xea, 0xc1, 0x57, 0x00,
-	0x2f, 0x2d, 0x51, 0xad, 0xcf, 0xe7, 0xb0, 0x2e, 0xa8, 0x62, 0x16, 0xf6, 0xe8, 0xc7, 0xa4, 0x75,
-	0x3b, 0xcd, 0xd4, 0xab, 0x5e, 0x07, 0xc7, 0x3c, 0xb7, 0xc1, 0xda, 0x47, 0x28, 0x93, 0xd7, 0x44,
-	0x0d, 0x4a, 0x26, 0xf1, 0x3e, 0x8b, 0xc7, 0x27, 0x21, 0xb4, 0x63, 0xf7, 0x59, 0x1c, 0x69, 0x0a,
-	0x3a, 0x80, 0xae, 0xa2, 0x22, 0x65, 0xaa, 0x59, 0x3b, 0x25, 0xcf, 0x72, 0x76, 0xbf, 0xd4, 0xe0,
-	0x19, 0xed, 0x1a, 0x7d, 0x00, 0xd0, 0x35, 0x69, 0xa1, 0xbb, 0x1b, 0xc2, 0xfc, 0x73, 0x3d, 0xde,
-	0xbd, 0xff, 0x91, 0x9a, 0x2c, 0x82, 0x5b, 0xef, 0xbf, 0xfd, 0xfa, 0x5c, 0x6b, 0xa1, 0x2d, 0xb2,
-	0xfe, 0x1c, 0xcc, 0x76, 0xd0, 0x47, 0x00, 0xeb, 0x55, 0x86, 0xe8, 0xce, 0xdf, 0xd8, 0x4b, 0xbb,
-	0xf3, 0xb6, 0xff, 0x2d, 0xb4, 0x16, 0x1e, 0x6a, 0x0b, 0x18, 0xdd, 0xdf, 0x60, 0xa1, 0x4a, 0x99,
-	0xbc, 0x5d, 0x3d, 0x84, 0x77, 0xed, 0x67, 0xc3, 0xa9, 0x0f, 0x46, 0x53, 0x1f, 0xfc, 0x9c, 0xfa,
-	0xe0, 0xd3, 0xcc, 0x77, 0x46, 0x33, 0xdf, 0xf9, 0x3e, 0xf3, 0x9d, 0x97, 0x0f, 0x96, 0xc3, 0xef,
-	0x52, 0x29, 0xb3, 0x38, 0x34, 0xe4, 0x98, 0x0b, 0x46, 0xfa, 0x7b, 0xe4, 0xcd, 0x62, 0x86, 0x5e,
-	0x45, 0xc7, 0xd5, 0xc7, 0xbe, 0xf7, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x5a, 0xe8, 0x7e, 0xcf, 0x9c,
-	0x03, 0x00, 0x00,
+	// 809 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x4f, 0x1b, 0x47,
+	0x18, 0xf5, 0x82, 0x31, 0xed, 0xb8, 0x20, 0x98, 0xba, 0xad, 0xb1, 0x8a, 0xa1, 0x5b, 0x15, 0x28,
+	0xaa, 0x77, 0xb1, 0x41, 0x08, 0x09, 0xd4, 0xaa, 0x80, 0xfa, 0x4b, 0xad, 0x04, 0x8b, 0x44, 0xa5,
+	0x5e, 0xac, 0xf1, 0xee, 0x68, 0x59, 0xea, 0xdd, 0x59, 0x66, 0x06, 0x27, 0x56, 0x94, 0x4b, 0x0e,
+	0x51, 0x6e, 0x89, 0x94, 0x63, 0x6e, 0x39, 0xe7, 0x16, 0x94, 0xbf, 0x81, 0x43, 0x0e, 0x88, 0x5c,
+	0xa2, 0x1c, 0x50, 0x04, 0xf9, 0x43, 0xa2, 0x9d, 0x19, 0xaf, 0x6d, 0x30, 0x18, 0x82, 0x4f, 0xc0,
+	0xf0, 0xbe, 0xf7, 0xde, 0x7c, 0xdf, 0x9b, 0xcf, 0x06, 0xdf, 0x71, 0x4c, 0x29, 0x32, 0x9d, 0x7a,
+	0x60, 0x13, 0xdf, 0x37, 0x6b, 0xc5, 0x0a, 0xe6, 0xa8, 0x68, 0xee, 0xed, 0x63, 0x5a, 0x37, 0x42,
+	0x4a, 0x38, 0x81, 0x5f, 0x09, 0x88, 0xa1, 0x20, 0x86, 0x82, 0xe4, 0xc6, 0x6c, 0xc2, 0x7c, 0xc2,
+	0xca, 0x02, 0x64, 0xca, 0x3f, 0x64, 0x45, 0x2e, 0xe3, 0x12, 0x97, 0xc8, 0xf3, 0xe8, 0x37, 0x75,
+	0x3a, 0x2b, 0x31, 0x66, 0x05, 0x31, 0x2c, 0x05, 0x62, 0xb9, 0x10, 0xb9, 0x5e, 0x80, 0xb8, 0x47,
+	0x02, 0x85, 0xfd, 0xd6, 0x25, 0xc4, 0xad, 0x62, 0x13, 0x85, 0x9e, 0x89, 0x82, 0x80, 0x70, 0xf1,
+	0xcf, 0x06, 0xff, 0xf7, 0x9d, 0x4d, 0xbb, 0x38, 0xc0, 0xcc, 0xeb, 0x02, 0x6a, 0x5c, 0x43, 0x80,
+	0xf4, 0x0c, 0x80, 0x9b, 0x91, 0x93, 0x0d, 0x44, 0x91, 0xcf, 0x2c, 0xbc, 0xb7, 0x8f, 0x19, 0xd7,
+	0x2d, 0xf0, 0x65, 0xdb, 0x29, 0x0b, 0x49, 0xc0, 0x30, 0x5c, 0x06, 0xa9, 0x50, 0x9c, 0x64, 0xb5,
+	0x49, 0x6d, 0x26, 0x5d, 0x1a, 0x37, 0x3a, 0x76, 0xc6, 0x90, 0x65, 0xab, 0xc9, 0xc3, 0x93, 0x89,
+	0x84, 0xa5, 0x4a, 0xf4, 0x2d, 0x30, 0x22, 0x38, 0x2d, 0xc4, 0xb1, 0xd2, 0x81, 0xbf, 0x80, 0xe1,
+	0x1a, 0xaa, 0x7a, 0x0e, 0xe2, 0x84, 0x96, 0x91, 0xe3, 0x50, 0x41, 0xfc, 0xf9, 0x6a, 0xf6, 0xf8,
+	0xa0, 0x90, 0x51, 0x1d, 0xfd, 0xd5, 0x71, 0x28, 0x66, 0x6c, 0x8b, 0x53, 0x2f, 0x70, 0xad, 0xa1,
+	0x18, 0x1f, 0x9d, 0xeb, 0x2f, 0x35, 0x30, 0xda, 0xc2, 0xaa, 0x7c, 0xfe, 0x0d, 0x92, 0x14, 0x71,
+	0xac, 0xc8, 0x96, 0xde, 0x9d, 0x4c, 0x4c, 0xb9, 0x1e, 0xdf, 0xd9, 0xaf, 0x18, 0x36, 0xf1, 0xd5,
+	0xa4, 0xd4, 0x8f, 0x02, 0x73, 0xfe, 0x37, 0x79, 0x3d, 0xc4, 0xcc, 0x58, 0xc7, 0xf6, 0xf1, 0x41,
+	0x01, 0x28, 0xd9, 0x75, 0x6c, 0x5b, 0x82, 0x05, 0x6e, 0x80, 0x14, 0x47, 0xd4, 0xc5, 0x3c, 0xdb,
+	0x77, 0x4b, 0x3e, 0xc5, 0xa3, 0x3f, 0xd7, 0xc0, 0x37, 0xb1, 0xeb, 0x3f, 0x3c, 0xc6, 0x09, 0xad,
+	0xf7, 0xaa, 0x25, 0xf0, 0x37, 0x00, 0x9a, 0x69, 0x12, 0x96, 0xd3, 0xa5, 0x29, 0x43, 0x55, 0x46,
+	0xd1, 0x33, 0x64, 0xb6, 0x9b, 0xc3, 0x72, 0x1b, 0xf3, 0xb0, 0x5a, 0x2a, 0xf5, 0x57, 0x1a, 0xc8,
+	0x5e, 0x34, 0xa9, 0x3a, 0xbc, 0x09, 0x06, 0x77, 0xe4, 0x51, 0x56, 0x9b, 0xec, 0x9f, 0x49, 0x97,
+	0x8a, 0x97, 0x44, 0x61, 0xbb, 0xe1, 0x6d, 0x8d, 0xf8, 0xbe, 0xc7, 0x98, 0x47, 0x02, 0xc5, 0xa5,
+	0xe2, 0xd1, 0xe0, 0x81, 0xbf, 0x77, 0xf0, 0x3d, 0xdd, 0xd5, 0xb7, 0xf4, 0xd3, 0x66, 0xfc, 0xb5,
+	0x06, 0xc6, 0x64, 0x7a, 0x29, 0xd9, 0xc5, 0x36, 0xc7, 0x4e, 0x2f, 0x23, 0x07, 0x8b, 0xe0, 0x8b,
+	0x1a, 0xe1, 0x5e, 0xe0, 0x96, 0x43, 0x72, 0x07, 0x53, 0x15, 0x8a, 0xe1, 0x73, 0xa3, 0x4e, 0x4b,
+	0xcc, 0x46, 0x04, 0x81, 0xcb, 0x60, 0xd4, 0xc1, 0x55, 0xec, 0x0a, 0x7f, 0x65, 0x7b, 0x07, 0x05,
+	0x2e, 0xce, 0xf6, 0x5f, 0xa8, 0xfb, 0x33, 0xe0, 0xd6, 0x48, 0x13, 0xb8, 0x26, 0x70, 0xfa, 0xb3,
+	0x24, 0xc8, 0x75, 0xba, 0x8e, 0x9a, 0x44, 0xf9, 0x9c, 0x1d, 0x79, 0x9b, 0x95, 0xa8, 0xb7, 0x9f,
+	0x9c, 0xd3, 0x36, 0xf3, 0x14, 0x7c, 0x1d, 0x36, 0x94, 0xcb, 0x1d, 0x6e, 0x7e, 0x3b, 0xa9, 0x4c,
+	0xcc, 0xbd, 0xdd, 0xa2, 0xf9, 0x2f, 0xf8, 0xcc, 0xf7, 0x82, 0xb2, 0x78, 0xc4, 0xfd, 0x3d, 0x50,
+	0x19, 0xf4, 0xbd, 0x20, 0xea, 0x1a, 0xdc, 0x05, 0xb0, 0x79, 0x99, 0x58, 0x22, 0xd9, 0x03, 0x89,
+	0x91, 0x98, 0xf7, 0x1f, 0xa5, 0x65, 0x83, 0xe1, 0xa6, 0x96, 0xd0, 0x19, 0xe8, 0x81, 0xce, 0x50,
+	0xd8, 0x1a, 0x83, 0xd2, 0xa3, 0x01, 0x30, 0x20, 0xd2, 0x01, 0x1f, 0x6a, 0x20, 0x25, 0x17, 0x2f,
+	0xfc, 0xf1, 0x92, 0xc7, 0x78, 0x71, 0xd3, 0xe7, 0x66, 0xaf, 0x03, 0x95, 0x51, 0xd3, 0x7f, 0x78,
+	0xf0, 0xe6, 0xc3, 0xd3, 0xbe, 0x09, 0x38, 0x6e, 0x76, 0xfe, 0x64, 0x91, 0x8b, 0x1e, 0x3e, 0xd6,
+	0x40, 0x52, 0x34, 0x60, 0xfa, 0x2a, 0xee, 0x96, 0x37, 0x99, 0x9b, 0xe9, 0x0e, 0x54, 0x16, 0x16,
+	0x84, 0x05, 0x03, 0xfe, 0x74, 0x89, 0x85, 0xa8, 0xcd, 0xe6, 0xbd, 0xf6, 0x07, 0x7e, 0x1f, 0xbe,
+	0xd0, 0x40, 0xba, 0x65, 0x8b, 0x41, 0xa3, 0x9b, 0x5e, 0xfb, 0x4e, 0xce, 0x99, 0xd7, 0xc6, 0x2b,
+	0x9b, 0x2b, 0xc2, 0xe6, 0x22, 0x5c, 0xb8, 0x89, 0x4d, 0xb3, 0xb1, 0x09, 0x0f, 0x34, 0x30, 0xd4,
+	0xf6, 0xd8, 0xe1, 0xdc, 0x95, 0x53, 0xea, 0xb0, 0xe6, 0x72, 0xc5, 0x1b, 0x54, 0x28, 0xd3, 0x3f,
+	0x0b, 0xd3, 0x4b, 0x70, 0xf1, 0x46, 0xa6, 0xe3, 0x38, 0xae, 0xfe, 0x75, 0x78, 0x9a, 0xd7, 0x8e,
+	0x4e, 0xf3, 0xda, 0xfb, 0xd3, 0xbc, 0xf6, 0xe4, 0x2c, 0x9f, 0x38, 0x3a, 0xcb, 0x27, 0xde, 0x9e,
+	0xe5, 0x13, 0xff, 0xcd, 0xb5, 0x26, 0xbd, 0x8a, 0x18, 0xf3, 0xec, 0x82, 0xd4, 0xb0, 0x09, 0xc5,
+	0x66, 0x6d, 0xde, 0xbc, 0x1b, 0xab, 0x89, 0xdc, 0x57, 0x52, 0xe2, 0xdb, 0xc9, 0xfc, 0xc7, 0x01,
+	0x00, 0xb4, 0x82, 0x15, 0xe4, 0x9e, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -257,6 +499,11 @@ type QueryClient interface {
 	// Params queries all parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	Rate(ctx context.Context, in *QueryRateRequest, opts ...grpc.CallOption) (*QueryRateResponse, error)
+	// RateHistory queries the per-epoch commission history of a validator.
+	RateHistory(ctx context.Context, in *QueryRateHistoryRequest, opts ...grpc.CallOption) (*QueryRateHistoryResponse, error)
+	// ProjectedRate queries the commission rates a validator would get with
+	// a different voting power or after a delegation change.
+	ProjectedRate(ctx context.Context, in *QueryProjectedRateRequest, opts ...grpc.CallOption) (*QueryProjectedRateResponse, error)
 }
 
 type queryClient struct {
@@ -285,11 +532,34 @@ func (c *queryClient) Rate(ctx context.Context, in *QueryRateRequest, opts ...gr
 	return out, nil
 }
 
+func (c *queryClient) RateHistory(ctx context.Context, in *QueryRateHistoryRequest, opts ...grpc.CallOption) (*QueryRateHistoryResponse, error) {
+	out := new(QueryRateHistoryResponse)
+	err := c.cc.Invoke(ctx, "/terra.dyncomm.v1beta1.Query/RateHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProjectedRate(ctx context.Context, in *QueryProjectedRateRequest, opts ...grpc.CallOption) (*QueryProjectedRateResponse, error) {
+	out := new(QueryProjectedRateResponse)
+	err := c.cc.Invoke(ctx, "/terra.dyncomm.v1beta1.Query/ProjectedRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries all parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	Rate(context.Context, *QueryRateRequest) (*QueryRateResponse, error)
+	// RateHistory queries the per-epoch commission history of a validator.
+	RateHistory(context.Context, *QueryRateHistoryRequest) (*QueryRateHistoryResponse, error)
+	// ProjectedRate queries the commission rates a validator would get with
+	// a different voting power or after a delegation change.
+	ProjectedRate(context.Context, *QueryProjectedRateRequest) (*QueryProjectedRateResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -302,6 +572,12 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) Rate(ctx context.Context, req *QueryRateRequest) (*QueryRateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Rate not implemented")
 }
+func (*UnimplementedQueryServer) RateHistory(ctx context.Context, req *QueryRateHistoryRequest) (*QueryRateHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateHistory not implemented")
+}
+func (*UnimplementedQueryServer) ProjectedRate(ctx context.Context, req *QueryProjectedRateRequest) (*QueryProjectedRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectedRate not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -343,6 +619,43 @@ func _Query_Rate_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_RateHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRateHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.dyncomm.v1beta1.Query/RateHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateHistory(ctx, req.(*QueryRateHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProjectedRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectedRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectedRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.dyncomm.v1beta1.Query/ProjectedRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectedRate(ctx, req.(*QueryProjectedRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "terra.dyncomm.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -355,6 +668,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Rate",
 			Handler:    _Query_Rate_Handler,
 		},
+		{
+			MethodName: "RateHistory",
+			Handler:    _Query_RateHistory_Handler,
+		},
+		{
+			MethodName: "ProjectedRate",
+			Handler:    _Query_ProjectedRate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "terra/dyncomm/v1beta1/query.proto",
@@ -493,72 +814,356 @@ func (m *QueryRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRateHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryRateHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryRateHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryRateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Rate != nil {
-		l = m.Rate.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Target != nil {
-		l = m.Target.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryRateHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryRateHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryRateHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProjectedRateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectedRateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectedRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegationChange) > 0 {
+		i -= len(m.DelegationChange)
+		copy(dAtA[i:], m.DelegationChange)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegationChange)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.VotingPower) > 0 {
+		i -= len(m.VotingPower)
+		copy(dAtA[i:], m.VotingPower)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VotingPower)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProjectedRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectedRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectedRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ProjectedRate.Size()
+		i -= size
+		if _, err := m.ProjectedRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.ProjectedMinRate.Size()
+		i -= size
+		if _, err := m.ProjectedMinRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MinRate.Size()
+		i -= size
+		if _, err := m.MinRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ProjectedVotingPower.Size()
+		i -= size
+		if _, err := m.ProjectedVotingPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.VotingPower.Size()
+		i -= size
+		if _, err := m.VotingPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Rate != nil {
+		l = m.Rate.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Target != nil {
+		l = m.Target.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRateHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRateHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProjectedRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.VotingPower)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DelegationChange)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProjectedRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.VotingPower.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ProjectedVotingPower.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MinRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ProjectedMinRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ProjectedRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -897,6 +1502,610 @@ func (m *QueryRateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryRateHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRateHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, ValidatorCommissionHistory{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProjectedRateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectedRateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectedRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VotingPower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegationChange = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProjectedRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectedRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectedRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectedVotingPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProjectedVotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectedMinRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProjectedMinRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectedRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProjectedRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
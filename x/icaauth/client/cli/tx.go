@@ -0,0 +1,144 @@
+package cli
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/classic-terra/core/v3/x/icaauth/types"
+)
+
+const (
+	flagVersion         = "version"
+	flagMemo            = "packet-memo"
+	flagRelativeTimeout = "relative-packet-timeout"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	icaauthTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Interchain accounts transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	icaauthTxCmd.AddCommand(
+		GetRegisterInterchainAccountCmd(),
+		GetSubmitTxCmd(),
+	)
+
+	return icaauthTxCmd
+}
+
+// GetRegisterInterchainAccountCmd will create and send a MsgRegisterInterchainAccount
+func GetRegisterInterchainAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register [connection-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Register an interchain account of the sender on the host chain of a connection",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			version, err := cmd.Flags().GetString(flagVersion)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRegisterInterchainAccount(clientCtx.GetFromAddress(), args[0], version)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagVersion, "", "Channel version, the default ICS27 metadata is used when empty")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetSubmitTxCmd will create and send a MsgSubmitTx
+func GetSubmitTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-tx [connection-id] [msgs-file]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit msgs to be executed by the interchain account of the sender",
+		Long: strings.TrimSpace(`
+Submit msgs to be executed by the interchain account of the sender on the host
+chain of a connection. The msgs file contains a JSON list of msgs, their types
+must be known to this chain.
+
+$ terrad tx icaauth submit-tx connection-0 msgs.json
+
+Where msgs.json contains:
+
+[
+  {
+    "@type": "/cosmos.bank.v1beta1.MsgSend",
+    "from_address": "cosmos1...",
+    "to_address": "cosmos1...",
+    "amount": [{"denom": "uatom", "amount": "1000"}]
+  }
+]
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			var rawMsgs []json.RawMessage
+			if err := json.Unmarshal(bz, &rawMsgs); err != nil {
+				return err
+			}
+
+			msgs := make([]*codectypes.Any, len(rawMsgs))
+			for i, rawMsg := range rawMsgs {
+				var msg sdk.Msg
+				if err := clientCtx.Codec.UnmarshalInterfaceJSON(rawMsg, &msg); err != nil {
+					return err
+				}
+
+				msgs[i], err = codectypes.NewAnyWithValue(msg)
+				if err != nil {
+					return err
+				}
+			}
+
+			memo, err := cmd.Flags().GetString(flagMemo)
+			if err != nil {
+				return err
+			}
+
+			relativeTimeout, err := cmd.Flags().GetUint64(flagRelativeTimeout)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSubmitTx(clientCtx.GetFromAddress(), args[0], msgs, memo, relativeTimeout)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagMemo, "", "Memo of the interchain accounts packet")
+	cmd.Flags().Uint64(flagRelativeTimeout, 0, "Packet timeout in nanoseconds relative to the block time, 10 minutes when zero")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,167 @@
+package keeper
+
+import (
+	"encoding/json"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	"github.com/classic-terra/core/v3/x/icaauth/types"
+)
+
+// SudoMsg is the ibc-hooks compatible message delivering the outcome of a
+// packet to the contract which sent it.
+type SudoMsg struct {
+	IBCLifecycleComplete IBCLifecycleComplete `json:"ibc_lifecycle_complete"`
+}
+
+// IBCLifecycleComplete holds either the acknowledgement or the timeout of a
+// packet.
+type IBCLifecycleComplete struct {
+	IBCAck     *IBCAck     `json:"ibc_ack,omitempty"`
+	IBCTimeout *IBCTimeout `json:"ibc_timeout,omitempty"`
+}
+
+// IBCAck is the acknowledgement of a packet, ack holds the raw acknowledgement
+// bytes.
+type IBCAck struct {
+	Channel  string `json:"channel"`
+	Sequence uint64 `json:"sequence"`
+	Ack      []byte `json:"ack"`
+	Success  bool   `json:"success"`
+}
+
+// IBCTimeout is the timeout of a packet.
+type IBCTimeout struct {
+	Channel  string `json:"channel"`
+	Sequence uint64 `json:"sequence"`
+}
+
+// SetPacketCallback stores the contract to notify about a packet.
+func (k Keeper) SetPacketCallback(ctx sdk.Context, callback types.PacketCallback) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPacketCallbackKey(callback.ChannelId, callback.Sequence), k.cdc.MustMarshal(&callback))
+}
+
+// GetPacketCallback returns the contract to notify about a packet.
+func (k Keeper) GetPacketCallback(ctx sdk.Context, channelID string, sequence uint64) (types.PacketCallback, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPacketCallbackKey(channelID, sequence))
+	if bz == nil {
+		return types.PacketCallback{}, false
+	}
+
+	var callback types.PacketCallback
+	k.cdc.MustUnmarshal(bz, &callback)
+	return callback, true
+}
+
+// DeletePacketCallback removes the callback of a packet.
+func (k Keeper) DeletePacketCallback(ctx sdk.Context, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPacketCallbackKey(channelID, sequence))
+}
+
+// GetAllPacketCallbacks returns all pending packet callbacks.
+func (k Keeper) GetAllPacketCallbacks(ctx sdk.Context) []types.PacketCallback {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.PacketCallbackKeyPrefix)
+	defer iter.Close()
+
+	callbacks := []types.PacketCallback{}
+	for ; iter.Valid(); iter.Next() {
+		var callback types.PacketCallback
+		k.cdc.MustUnmarshal(iter.Value(), &callback)
+		callbacks = append(callbacks, callback)
+	}
+
+	return callbacks
+}
+
+// OnAcknowledgementPacket notifies the contract which sent the packet about
+// its acknowledgement.
+func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte) {
+	var ack channeltypes.Acknowledgement
+	success := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack) == nil && ack.Success()
+
+	k.deliverCallback(ctx, packet, types.AttributeValueAck, IBCLifecycleComplete{
+		IBCAck: &IBCAck{
+			Channel:  packet.SourceChannel,
+			Sequence: packet.Sequence,
+			Ack:      acknowledgement,
+			Success:  success,
+		},
+	})
+}
+
+// OnTimeoutPacket notifies the contract which sent the packet about its
+// timeout.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) {
+	k.deliverCallback(ctx, packet, types.AttributeValueTimeout, IBCLifecycleComplete{
+		IBCTimeout: &IBCTimeout{
+			Channel:  packet.SourceChannel,
+			Sequence: packet.Sequence,
+		},
+	})
+}
+
+// deliverCallback calls the sudo entry point of the contract registered for
+// the packet. The callback is removed in any case and a failing contract does
+// not fail the acknowledgement or timeout, its state changes are discarded and
+// an event is emitted instead.
+func (k Keeper) deliverCallback(ctx sdk.Context, packet channeltypes.Packet, kind string, lifecycle IBCLifecycleComplete) {
+	callback, found := k.GetPacketCallback(ctx, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return
+	}
+	k.DeletePacketCallback(ctx, packet.SourceChannel, packet.Sequence)
+
+	msg, err := json.Marshal(SudoMsg{IBCLifecycleComplete: lifecycle})
+	if err == nil {
+		if err = k.sudo(ctx, sdk.MustAccAddressFromBech32(callback.ContractAddress), msg); err == nil {
+			return
+		}
+	}
+
+	k.Logger(ctx).Info("interchain tx callback failed", "contract", callback.ContractAddress, "channel", packet.SourceChannel, "sequence", packet.Sequence, "err", err)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCallbackError,
+			sdk.NewAttribute(types.AttributeKeyContract, callback.ContractAddress),
+			sdk.NewAttribute(types.AttributeKeyCallback, kind),
+			sdk.NewAttribute(types.AttributeKeyChannelID, packet.SourceChannel),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(packet.Sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+		),
+	)
+}
+
+// sudo calls the sudo entry point of contract with the callback gas limit of
+// the params, running out of gas is returned as an error. The gas consumed by
+// the contract is charged to ctx.
+func (k Keeper) sudo(ctx sdk.Context, contract sdk.AccAddress, msg []byte) (err error) {
+	cacheCtx, write := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(sdk.NewGasMeter(k.GetParams(ctx).CallbackGasLimit))
+
+	defer func() {
+		if r := recover(); r != nil {
+			outOfGas, ok := r.(sdk.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			err = errorsmod.Wrapf(sdkerrors.ErrOutOfGas, "out of gas in location: %v", outOfGas.Descriptor)
+		}
+
+		ctx.GasMeter().ConsumeGas(cacheCtx.GasMeter().GasConsumedToLimit(), "interchain tx callback")
+	}()
+
+	if _, err = k.contractKeeper.Sudo(cacheCtx, contract, msg); err != nil {
+		return err
+	}
+
+	write()
+	return nil
+}
@@ -0,0 +1,196 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/libs/log"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icatypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/types"
+
+	"github.com/classic-terra/core/v3/x/icaauth/types"
+)
+
+type Keeper struct {
+	storeKey storetypes.StoreKey
+	cdc      codec.BinaryCodec
+
+	icaControllerKeeper types.ICAControllerKeeper
+	contractKeeper      types.ContractKeeper
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+}
+
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey storetypes.StoreKey,
+	icaControllerKeeper types.ICAControllerKeeper,
+	contractKeeper types.ContractKeeper,
+	authority string,
+) Keeper {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(fmt.Errorf("invalid icaauth authority address: %w", err))
+	}
+
+	return Keeper{
+		cdc:                 cdc,
+		storeKey:            storeKey,
+		icaControllerKeeper: icaControllerKeeper,
+		contractKeeper:      contractKeeper,
+		authority:           authority,
+	}
+}
+
+// InitGenesis initializes the icaauth module's state from a provided genesis
+// state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	if err := genState.Validate(); err != nil {
+		panic(err)
+	}
+
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		panic(err)
+	}
+
+	for _, callback := range genState.PacketCallbacks {
+		k.SetPacketCallback(ctx, callback)
+	}
+}
+
+// ExportGenesis returns the icaauth module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return types.NewGenesisState(k.GetAllPacketCallbacks(ctx), k.GetParams(ctx))
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetAuthority returns the x/icaauth module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// SetParams sets the icaauth module's parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(types.ParamsKey, bz)
+	return nil
+}
+
+// GetParams gets the icaauth module's parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return types.DefaultParams()
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// RegisterInterchainAccount opens the channel of the interchain account of
+// owner on the host chain of the connection and returns its controller port.
+// The packets of the channel are routed back to this module.
+func (k Keeper) RegisterInterchainAccount(ctx sdk.Context, owner sdk.AccAddress, connectionID, version string) (string, error) {
+	portID, err := icatypes.NewControllerPortID(owner.String())
+	if err != nil {
+		return "", err
+	}
+
+	//nolint:staticcheck // the legacy API is the one routing the packet callbacks to this module
+	if err := k.icaControllerKeeper.RegisterInterchainAccount(ctx, connectionID, owner.String(), version); err != nil {
+		return "", err
+	}
+
+	return portID, nil
+}
+
+// SubmitTx sends msgs to be executed by the interchain account of owner and
+// returns the channel and sequence of the packet. If owner is a contract it is
+// notified of the acknowledgement or timeout of the packet.
+func (k Keeper) SubmitTx(
+	ctx sdk.Context,
+	owner sdk.AccAddress,
+	connectionID string,
+	msgs []*codectypes.Any,
+	memo string,
+	relativeTimeout uint64,
+) (string, uint64, error) {
+	portID, err := icatypes.NewControllerPortID(owner.String())
+	if err != nil {
+		return "", 0, err
+	}
+
+	channelID, found := k.icaControllerKeeper.GetActiveChannelID(ctx, connectionID, portID)
+	if !found {
+		return "", 0, errorsmod.Wrapf(types.ErrInterchainAccountMissing, "no active channel for %s on %s", portID, connectionID)
+	}
+
+	// the msgs are already encoded for the host chain, they are passed through without decoding
+	data, err := k.cdc.Marshal(&icatypes.CosmosTx{Messages: msgs})
+	if err != nil {
+		return "", 0, err
+	}
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+		Memo: memo,
+	}
+
+	if relativeTimeout == 0 {
+		relativeTimeout = icatypes.DefaultRelativePacketTimeoutTimestamp
+	}
+	timeoutTimestamp := uint64(ctx.BlockTime().UnixNano()) + relativeTimeout
+
+	//nolint:staticcheck // the legacy API is the one routing the packet callbacks to this module
+	sequence, err := k.icaControllerKeeper.SendTx(ctx, nil, connectionID, portID, packetData, timeoutTimestamp)
+	if err != nil {
+		return "", 0, err
+	}
+
+	if k.contractKeeper.HasContractInfo(ctx, owner) {
+		k.SetPacketCallback(ctx, types.PacketCallback{
+			ChannelId:       channelID,
+			Sequence:        sequence,
+			ContractAddress: owner.String(),
+		})
+	}
+
+	return channelID, sequence, nil
+}
+
+// GetInterchainAccount returns the address, controller port and active
+// channel of the interchain account of owner on the host chain of the
+// connection.
+func (k Keeper) GetInterchainAccount(ctx sdk.Context, owner, connectionID string) (string, string, string, error) {
+	portID, err := icatypes.NewControllerPortID(owner)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	address, found := k.icaControllerKeeper.GetInterchainAccountAddress(ctx, connectionID, portID)
+	if !found {
+		return "", "", "", errorsmod.Wrapf(types.ErrInterchainAccountMissing, "no interchain account for %s on %s", portID, connectionID)
+	}
+
+	channelID, _ := k.icaControllerKeeper.GetActiveChannelID(ctx, connectionID, portID)
+	return address, portID, channelID, nil
+}
@@ -0,0 +1,268 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	icatypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	apptesting "github.com/classic-terra/core/v3/app/testing"
+	"github.com/classic-terra/core/v3/x/icaauth/keeper"
+	"github.com/classic-terra/core/v3/x/icaauth/types"
+)
+
+const (
+	connectionID = "connection-0"
+	channelID    = "channel-3"
+)
+
+// mockICAControllerKeeper opens a channel for every registered owner and
+// assigns increasing sequences to the sent packets
+type mockICAControllerKeeper struct {
+	channels map[string]string
+	sent     []icatypes.InterchainAccountPacketData
+}
+
+func (m *mockICAControllerKeeper) RegisterInterchainAccount(_ sdk.Context, connectionID, owner, _ string) error {
+	portID, err := icatypes.NewControllerPortID(owner)
+	if err != nil {
+		return err
+	}
+
+	m.channels[connectionID+portID] = channelID
+	return nil
+}
+
+func (m *mockICAControllerKeeper) SendTx(_ sdk.Context, _ *capabilitytypes.Capability, _, _ string, icaPacketData icatypes.InterchainAccountPacketData, _ uint64) (uint64, error) {
+	m.sent = append(m.sent, icaPacketData)
+	return uint64(len(m.sent)), nil
+}
+
+func (m *mockICAControllerKeeper) GetActiveChannelID(_ sdk.Context, connectionID, portID string) (string, bool) {
+	channelID, found := m.channels[connectionID+portID]
+	return channelID, found
+}
+
+func (m *mockICAControllerKeeper) GetInterchainAccountAddress(_ sdk.Context, connectionID, portID string) (string, bool) {
+	if _, found := m.channels[connectionID+portID]; !found {
+		return "", false
+	}
+	return "cosmos1ica", true
+}
+
+// mockContractKeeper records the sudo calls of its contracts
+type mockContractKeeper struct {
+	contracts map[string]bool
+	sudoMsgs  []keeper.SudoMsg
+	sudoErr   error
+	sudoGas   uint64
+}
+
+func (m *mockContractKeeper) HasContractInfo(_ sdk.Context, contractAddress sdk.AccAddress) bool {
+	return m.contracts[contractAddress.String()]
+}
+
+func (m *mockContractKeeper) Sudo(ctx sdk.Context, _ sdk.AccAddress, msg []byte) ([]byte, error) {
+	ctx.GasMeter().ConsumeGas(m.sudoGas, "sudo")
+
+	var sudoMsg keeper.SudoMsg
+	if err := json.Unmarshal(msg, &sudoMsg); err != nil {
+		return nil, err
+	}
+
+	m.sudoMsgs = append(m.sudoMsgs, sudoMsg)
+	return nil, m.sudoErr
+}
+
+type KeeperTestSuite struct {
+	apptesting.KeeperTestHelper
+
+	keeper         keeper.Keeper
+	controller     *mockICAControllerKeeper
+	contractKeeper *mockContractKeeper
+	contract       sdk.AccAddress
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.Setup(s.T(), apptesting.SimAppChainID)
+
+	s.contract = s.TestAccs[2]
+	s.controller = &mockICAControllerKeeper{channels: map[string]string{}}
+	s.contractKeeper = &mockContractKeeper{contracts: map[string]bool{s.contract.String(): true}}
+	s.keeper = keeper.NewKeeper(
+		s.App.AppCodec(),
+		s.App.GetKey(types.StoreKey),
+		s.controller,
+		s.contractKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) bankSend(owner sdk.AccAddress) []*codectypes.Any {
+	msg, err := codectypes.NewAnyWithValue(banktypes.NewMsgSend(owner, s.TestAccs[1], sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000))))
+	s.Require().NoError(err)
+	return []*codectypes.Any{msg}
+}
+
+func (s *KeeperTestSuite) TestSubmitTx() {
+	msgServer := keeper.NewMsgServerImpl(s.keeper)
+	owner := s.TestAccs[0]
+
+	// no interchain account yet
+	_, err := msgServer.SubmitTx(s.Ctx, types.NewMsgSubmitTx(owner, connectionID, s.bankSend(owner), "", 0))
+	s.Require().ErrorIs(err, types.ErrInterchainAccountMissing)
+
+	registerRes, err := msgServer.RegisterInterchainAccount(s.Ctx, types.NewMsgRegisterInterchainAccount(owner, connectionID, ""))
+	s.Require().NoError(err)
+	s.Require().Equal(icatypes.ControllerPortPrefix+owner.String(), registerRes.PortId)
+
+	res, err := msgServer.SubmitTx(s.Ctx, types.NewMsgSubmitTx(owner, connectionID, s.bankSend(owner), "memo", 0))
+	s.Require().NoError(err)
+	s.Require().Equal(channelID, res.ChannelId)
+	s.Require().Equal(uint64(1), res.Sequence)
+
+	// the msgs are passed through as a cosmos tx
+	s.Require().Len(s.controller.sent, 1)
+	s.Require().Equal(icatypes.EXECUTE_TX, s.controller.sent[0].Type)
+	s.Require().Equal("memo", s.controller.sent[0].Memo)
+	var cosmosTx icatypes.CosmosTx
+	s.Require().NoError(s.App.AppCodec().Unmarshal(s.controller.sent[0].Data, &cosmosTx))
+	s.Require().Len(cosmosTx.Messages, 1)
+	s.Require().Equal(sdk.MsgTypeURL(&banktypes.MsgSend{}), cosmosTx.Messages[0].TypeUrl)
+
+	// regular accounts do not get callbacks
+	s.Require().Empty(s.keeper.GetAllPacketCallbacks(s.Ctx))
+
+	address, portID, activeChannelID, err := s.keeper.GetInterchainAccount(s.Ctx, owner.String(), connectionID)
+	s.Require().NoError(err)
+	s.Require().Equal("cosmos1ica", address)
+	s.Require().Equal(registerRes.PortId, portID)
+	s.Require().Equal(channelID, activeChannelID)
+}
+
+func (s *KeeperTestSuite) TestContractCallbacks() {
+	_, err := s.keeper.RegisterInterchainAccount(s.Ctx, s.contract, connectionID, "")
+	s.Require().NoError(err)
+
+	var sequences []uint64
+	for i := 0; i < 3; i++ {
+		_, sequence, err := s.keeper.SubmitTx(s.Ctx, s.contract, connectionID, s.bankSend(s.contract), "", 0)
+		s.Require().NoError(err)
+		sequences = append(sequences, sequence)
+	}
+	s.Require().Len(s.keeper.GetAllPacketCallbacks(s.Ctx), 3)
+
+	packet := func(sequence uint64) channeltypes.Packet {
+		return channeltypes.Packet{Sequence: sequence, SourcePort: icatypes.ControllerPortPrefix + s.contract.String(), SourceChannel: channelID}
+	}
+
+	// successful acknowledgement
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement()
+	s.keeper.OnAcknowledgementPacket(s.Ctx, packet(sequences[0]), ack)
+	s.Require().Len(s.contractKeeper.sudoMsgs, 1)
+	s.Require().Equal(&keeper.IBCAck{Channel: channelID, Sequence: sequences[0], Ack: ack, Success: true}, s.contractKeeper.sudoMsgs[0].IBCLifecycleComplete.IBCAck)
+	_, found := s.keeper.GetPacketCallback(s.Ctx, channelID, sequences[0])
+	s.Require().False(found)
+
+	// error acknowledgement
+	ack = channeltypes.NewErrorAcknowledgement(errors.New("failed")).Acknowledgement()
+	s.keeper.OnAcknowledgementPacket(s.Ctx, packet(sequences[1]), ack)
+	s.Require().Len(s.contractKeeper.sudoMsgs, 2)
+	s.Require().False(s.contractKeeper.sudoMsgs[1].IBCLifecycleComplete.IBCAck.Success)
+
+	// a failing contract does not fail the timeout
+	s.contractKeeper.sudoErr = errors.New("contract failed")
+	s.keeper.OnTimeoutPacket(s.Ctx, packet(sequences[2]))
+	s.Require().Len(s.contractKeeper.sudoMsgs, 3)
+	s.Require().Equal(&keeper.IBCTimeout{Channel: channelID, Sequence: sequences[2]}, s.contractKeeper.sudoMsgs[2].IBCLifecycleComplete.IBCTimeout)
+	s.Require().Empty(s.keeper.GetAllPacketCallbacks(s.Ctx))
+
+	var emitted bool
+	for _, event := range s.Ctx.EventManager().Events() {
+		emitted = emitted || event.Type == types.EventTypeCallbackError
+	}
+	s.Require().True(emitted)
+
+	// packets without callback are ignored
+	s.keeper.OnAcknowledgementPacket(s.Ctx, packet(sequences[0]), ack)
+	s.Require().Len(s.contractKeeper.sudoMsgs, 3)
+}
+
+func (s *KeeperTestSuite) TestCallbackOutOfGas() {
+	s.Require().NoError(s.keeper.SetParams(s.Ctx, types.Params{CallbackGasLimit: 1000}))
+	_, err := s.keeper.RegisterInterchainAccount(s.Ctx, s.contract, connectionID, "")
+	s.Require().NoError(err)
+
+	timeout := func() uint64 {
+		_, sequence, err := s.keeper.SubmitTx(s.Ctx, s.contract, connectionID, s.bankSend(s.contract), "", 0)
+		s.Require().NoError(err)
+
+		gasConsumed := s.Ctx.GasMeter().GasConsumed()
+		s.Require().NotPanics(func() {
+			s.keeper.OnTimeoutPacket(s.Ctx, channeltypes.Packet{Sequence: sequence, SourceChannel: channelID})
+		})
+		return s.Ctx.GasMeter().GasConsumed() - gasConsumed
+	}
+	baseGas := timeout()
+
+	// the contract runs out of gas without failing the timeout, the gas up to
+	// the limit is charged
+	s.contractKeeper.sudoGas = 2000
+	s.Require().Equal(baseGas+1000, timeout())
+	s.Require().Empty(s.keeper.GetAllPacketCallbacks(s.Ctx))
+
+	var outOfGas bool
+	for _, event := range s.Ctx.EventManager().Events() {
+		if event.Type != types.EventTypeCallbackError {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			outOfGas = outOfGas || (attr.Key == types.AttributeKeyError && strings.Contains(attr.Value, "out of gas"))
+		}
+	}
+	s.Require().True(outOfGas)
+}
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	msgServer := keeper.NewMsgServerImpl(s.keeper)
+	params := types.Params{CallbackGasLimit: 500_000}
+
+	_, err := msgServer.UpdateParams(sdk.WrapSDKContext(s.Ctx), &types.MsgUpdateParams{Authority: s.TestAccs[0].String(), Params: params})
+	s.Require().Error(err)
+
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(s.Ctx), &types.MsgUpdateParams{Authority: s.keeper.GetAuthority(), Params: types.Params{}})
+	s.Require().Error(err)
+
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(s.Ctx), &types.MsgUpdateParams{Authority: s.keeper.GetAuthority(), Params: params})
+	s.Require().NoError(err)
+	s.Require().Equal(params, s.keeper.GetParams(s.Ctx))
+}
+
+func (s *KeeperTestSuite) TestGenesis() {
+	callbacks := []types.PacketCallback{
+		{ChannelId: channelID, Sequence: 1, ContractAddress: s.contract.String()},
+		{ChannelId: channelID, Sequence: 2, ContractAddress: s.contract.String()},
+	}
+	params := types.Params{CallbackGasLimit: 500_000}
+	s.keeper.InitGenesis(s.Ctx, types.NewGenesisState(callbacks, params))
+	s.Require().Equal(callbacks, s.keeper.ExportGenesis(s.Ctx).PacketCallbacks)
+	s.Require().Equal(params, s.keeper.ExportGenesis(s.Ctx).Params)
+
+	s.Require().Error(types.NewGenesisState(append(callbacks, callbacks[0]), params).Validate())
+	s.Require().Error(types.NewGenesisState(callbacks, types.Params{}).Validate())
+}
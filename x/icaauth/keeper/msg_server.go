@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/classic-terra/core/v3/x/icaauth/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the icaauth MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+func (k msgServer) RegisterInterchainAccount(goCtx context.Context, req *types.MsgRegisterInterchainAccount) (*types.MsgRegisterInterchainAccountResponse, error) {
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	portID, err := k.Keeper.RegisterInterchainAccount(ctx, owner, req.ConnectionId, req.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterInterchainAccount,
+			sdk.NewAttribute(types.AttributeKeyOwner, req.Owner),
+			sdk.NewAttribute(types.AttributeKeyConnectionID, req.ConnectionId),
+			sdk.NewAttribute(types.AttributeKeyPortID, portID),
+		),
+	)
+
+	return &types.MsgRegisterInterchainAccountResponse{PortId: portID}, nil
+}
+
+func (k msgServer) SubmitTx(goCtx context.Context, req *types.MsgSubmitTx) (*types.MsgSubmitTxResponse, error) {
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	channelID, sequence, err := k.Keeper.SubmitTx(ctx, owner, req.ConnectionId, req.Msgs, req.Memo, req.RelativeTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSubmitTx,
+			sdk.NewAttribute(types.AttributeKeyOwner, req.Owner),
+			sdk.NewAttribute(types.AttributeKeyConnectionID, req.ConnectionId),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(sequence, 10)),
+		),
+	)
+
+	return &types.MsgSubmitTxResponse{ChannelId: channelID, Sequence: sequence}, nil
+}
+
+func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
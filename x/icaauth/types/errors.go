@@ -0,0 +1,12 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// Icaauth module sentinel errors
+var (
+	ErrInvalidConnectionID      = errorsmod.Register(ModuleName, 2, "invalid connection id")
+	ErrEmptyMsgs                = errorsmod.Register(ModuleName, 3, "interchain tx has no msgs")
+	ErrInterchainAccountMissing = errorsmod.Register(ModuleName, 4, "interchain account not found")
+)
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+// DefaultGenesisState returns the default icaauth genesis state.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		PacketCallbacks: []PacketCallback{},
+		Params:          DefaultParams(),
+	}
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(packetCallbacks []PacketCallback, params Params) *GenesisState {
+	return &GenesisState{
+		PacketCallbacks: packetCallbacks,
+		Params:          params,
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(gs.PacketCallbacks))
+	for _, callback := range gs.PacketCallbacks {
+		if err := host.ChannelIdentifierValidator(callback.ChannelId); err != nil {
+			return err
+		}
+		if _, err := sdk.AccAddressFromBech32(callback.ContractAddress); err != nil {
+			return err
+		}
+
+		key := string(GetPacketCallbackKey(callback.ChannelId, callback.Sequence))
+		if seen[key] {
+			return fmt.Errorf("duplicate callback of packet %d over %s", callback.Sequence, callback.ChannelId)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	ModuleName = "icaauth"
+	StoreKey   = ModuleName
+
+	RouterKey = ModuleName
+
+	EventTypeRegisterInterchainAccount = "register_interchain_account"
+	EventTypeSubmitTx                  = "submit_interchain_tx"
+	EventTypeCallbackError             = "interchain_tx_callback_error"
+	AttributeKeyOwner                  = "owner"
+	AttributeKeyConnectionID           = "connection_id"
+	AttributeKeyPortID                 = "port_id"
+	AttributeKeyChannelID              = "channel_id"
+	AttributeKeySequence               = "sequence"
+	AttributeKeyContract               = "contract"
+	AttributeKeyCallback               = "callback"
+	AttributeKeyError                  = "error"
+	AttributeValueAck                  = "ack"
+	AttributeValueTimeout              = "timeout"
+)
+
+// Key defines the store key for icaauth.
+var (
+	PacketCallbackKeyPrefix = []byte{0x1}
+	ParamsKey               = []byte{0x2}
+)
+
+// GetPacketCallbackKey returns the store key of the callback of a packet.
+func GetPacketCallbackKey(channelID string, sequence uint64) []byte {
+	return append(append(PacketCallbackKeyPrefix, address.MustLengthPrefix([]byte(channelID))...), sdk.Uint64ToBigEndian(sequence)...)
+}
@@ -0,0 +1,111 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+const (
+	TypeMsgRegisterInterchainAccount = "register_interchain_account"
+	TypeMsgSubmitTx                  = "submit_tx"
+	TypeMsgUpdateParams              = "update_params"
+)
+
+var (
+	_ sdk.Msg = &MsgRegisterInterchainAccount{}
+	_ sdk.Msg = &MsgSubmitTx{}
+	_ sdk.Msg = &MsgUpdateParams{}
+)
+
+// NewMsgRegisterInterchainAccount creates a new MsgRegisterInterchainAccount instance
+func NewMsgRegisterInterchainAccount(owner sdk.AccAddress, connectionID, version string) *MsgRegisterInterchainAccount {
+	return &MsgRegisterInterchainAccount{
+		Owner:        owner.String(),
+		ConnectionId: connectionID,
+		Version:      version,
+	}
+}
+
+func (msg MsgRegisterInterchainAccount) Route() string { return ModuleName }
+func (msg MsgRegisterInterchainAccount) Type() string  { return TypeMsgRegisterInterchainAccount }
+func (msg MsgRegisterInterchainAccount) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return err
+	}
+
+	return validateConnectionID(msg.ConnectionId)
+}
+
+func (msg MsgRegisterInterchainAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgRegisterInterchainAccount) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(msg.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+// NewMsgSubmitTx creates a new MsgSubmitTx instance
+func NewMsgSubmitTx(owner sdk.AccAddress, connectionID string, msgs []*codectypes.Any, memo string, relativeTimeout uint64) *MsgSubmitTx {
+	return &MsgSubmitTx{
+		Owner:           owner.String(),
+		ConnectionId:    connectionID,
+		Msgs:            msgs,
+		Memo:            memo,
+		RelativeTimeout: relativeTimeout,
+	}
+}
+
+func (msg MsgSubmitTx) Route() string { return ModuleName }
+func (msg MsgSubmitTx) Type() string  { return TypeMsgSubmitTx }
+func (msg MsgSubmitTx) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return err
+	}
+	if err := validateConnectionID(msg.ConnectionId); err != nil {
+		return err
+	}
+	if len(msg.Msgs) == 0 {
+		return ErrEmptyMsgs
+	}
+
+	return nil
+}
+
+func (msg MsgSubmitTx) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSubmitTx) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(msg.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+func (msg MsgUpdateParams) Route() string { return ModuleName }
+func (msg MsgUpdateParams) Type() string  { return TypeMsgUpdateParams }
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return err
+	}
+
+	return msg.Params.Validate()
+}
+
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+func validateConnectionID(connectionID string) error {
+	if err := host.ConnectionIdentifierValidator(connectionID); err != nil {
+		return errorsmod.Wrap(ErrInvalidConnectionID, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,25 @@
+package types
+
+import (
+	"fmt"
+)
+
+// DefaultCallbackGasLimit is the default gas limit of the packet callbacks of
+// contracts.
+const DefaultCallbackGasLimit = uint64(1_000_000)
+
+// DefaultParams returns the default icaauth parameters.
+func DefaultParams() Params {
+	return Params{
+		CallbackGasLimit: DefaultCallbackGasLimit,
+	}
+}
+
+// Validate performs basic validation on icaauth parameters.
+func (p Params) Validate() error {
+	if p.CallbackGasLimit == 0 {
+		return fmt.Errorf("callback gas limit must be positive")
+	}
+
+	return nil
+}
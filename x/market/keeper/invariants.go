@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/classic-terra/core/v3/x/market/types"
+)
+
+// RegisterInvariants registers all market invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "terra-pool-delta", TerraPoolDeltaInvariant(k))
+}
+
+// AllInvariants runs all invariants of the market module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return TerraPoolDeltaInvariant(k)(ctx)
+	}
+}
+
+// TerraPoolDeltaInvariant checks that the terra pool delta is bounded by the
+// base pool, a luna swap can never drain the terra pool of the constant product
+func TerraPoolDeltaInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		basePool := k.BasePool(ctx)
+		terraPoolDelta := k.GetTerraPoolDelta(ctx)
+		if !basePool.Add(terraPoolDelta).IsPositive() {
+			broken = true
+			msg += fmt.Sprintf("\tterra pool delta %s drains the base pool %s\n", terraPoolDelta, basePool)
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "terra-pool-delta", msg), broken
+	}
+}
@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTerraPoolDeltaInvariant(t *testing.T) {
+	input := CreateTestInput(t)
+	basePool := input.MarketKeeper.BasePool(input.Ctx)
+
+	_, broken := AllInvariants(input.MarketKeeper)(input.Ctx)
+	require.False(t, broken)
+
+	input.MarketKeeper.SetTerraPoolDelta(input.Ctx, basePool.Neg().QuoInt64(2))
+	_, broken = TerraPoolDeltaInvariant(input.MarketKeeper)(input.Ctx)
+	require.False(t, broken)
+
+	// the terra pool is drained
+	input.MarketKeeper.SetTerraPoolDelta(input.Ctx, basePool.Neg())
+	_, broken = TerraPoolDeltaInvariant(input.MarketKeeper)(input.Ctx)
+	require.True(t, broken)
+}
@@ -1,9 +1,12 @@
 package keeper
 
 import (
+	"strings"
+
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
 
 	core "github.com/classic-terra/core/v3/types"
 	"github.com/classic-terra/core/v3/x/market/types"
@@ -55,6 +58,13 @@ func (k Keeper) ComputeSwap(ctx sdk.Context, offerCoin sdk.Coin, askDenom string
 		return sdk.DecCoin{}, sdk.ZeroDec(), errorsmod.Wrap(types.ErrRecursiveSwap, askDenom)
 	}
 
+	// IBC assets may be priced by the oracle, but the market can only mint and burn native denoms
+	for _, denom := range []string{offerCoin.Denom, askDenom} {
+		if strings.HasPrefix(denom, ibctransfertypes.DenomPrefix+"/") {
+			return sdk.DecCoin{}, sdk.ZeroDec(), errorsmod.Wrap(types.ErrIBCDenomSwap, denom)
+		}
+	}
+
 	// Swap offer coin to base denom for simplicity of swap process
 	baseOfferDecCoin, err := k.ComputeInternalSwap(ctx, sdk.NewDecCoinFromCoin(offerCoin), core.MicroSDRDenom)
 	if err != nil {
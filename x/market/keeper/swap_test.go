@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	core "github.com/classic-terra/core/v3/types"
+	"github.com/classic-terra/core/v3/x/market/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -64,6 +65,14 @@ func TestComputeSwap(t *testing.T) {
 	offerCoin := sdk.NewCoin(core.MicroSDRDenom, lunaPriceInSDR.QuoInt64(2).TruncateInt())
 	_, _, err := input.MarketKeeper.ComputeSwap(input.Ctx, offerCoin, core.MicroLunaDenom)
 	require.Error(t, err)
+
+	// ibc assets priced by the oracle cannot be swapped
+	ibcDenom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+	input.OracleKeeper.SetLunaExchangeRate(input.Ctx, ibcDenom, sdk.NewDecWithPrec(5, 1))
+	_, _, err = input.MarketKeeper.ComputeSwap(input.Ctx, sdk.NewInt64Coin(core.MicroLunaDenom, 1000), ibcDenom)
+	require.ErrorIs(t, err, types.ErrIBCDenomSwap)
+	_, _, err = input.MarketKeeper.ComputeSwap(input.Ctx, sdk.NewInt64Coin(ibcDenom, 1000), core.MicroLunaDenom)
+	require.ErrorIs(t, err, types.ErrIBCDenomSwap)
 }
 
 func TestComputeInternalSwap(t *testing.T) {
@@ -114,8 +114,10 @@ func NewAppModule(
 // Name returns the market module's name.
 func (AppModule) Name() string { return types.ModuleName }
 
-// RegisterInvariants performs a no-op.
-func (AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+// RegisterInvariants registers the market module invariants.
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
 
 // QuerierRoute returns the market module's querier route name.
 func (AppModule) QuerierRoute() string { return types.QuerierRoute }
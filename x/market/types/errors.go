@@ -9,4 +9,6 @@ var (
 	ErrRecursiveSwap    = errorsmod.Register(ModuleName, 2, "recursive swap")
 	ErrNoEffectivePrice = errorsmod.Register(ModuleName, 3, "no price registered with oracle")
 	ErrZeroSwapCoin     = errorsmod.Register(ModuleName, 4, "zero swap coin")
+	ErrSlippageExceeded = errorsmod.Register(ModuleName, 5, "swap slippage limit exceeded")
+	ErrIBCDenomSwap     = errorsmod.Register(ModuleName, 6, "ibc denoms cannot be swapped")
 )
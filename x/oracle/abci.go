@@ -110,7 +110,7 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 		k.ClearBallots(ctx, params.VotePeriod)
 
 		// Update vote targets and tobin tax
-		k.ApplyWhitelist(ctx, params.Whitelist, voteTargets)
+		k.ApplyWhitelist(ctx, params.Whitelist, params.IbcAssets, voteTargets)
 	}
 
 	// Do slash who did miss voting over threshold and
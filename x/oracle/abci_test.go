@@ -656,6 +656,72 @@ func TestVoteTargets(t *testing.T) {
 	require.True(t, sdk.ZeroDec().Equal(tobinTax))
 }
 
+func TestIBCAssetVoteTargets(t *testing.T) {
+	input, h := setup(t)
+	ibcDenom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{{Name: core.MicroKRWDenom, TobinTax: types.DefaultTobinTax}}
+	params.IbcAssets = types.IBCAssetList{{
+		Denom:         ibcDenom,
+		OriginChainId: "cosmoshub-4",
+		BaseDenom:     "uatom",
+		Symbol:        "ATOM",
+		Decimals:      6,
+	}}
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	// clear tobin tax to reset vote targets
+	input.OracleKeeper.ClearTobinTaxes(input.Ctx)
+	input.OracleKeeper.SetTobinTax(input.Ctx, core.MicroKRWDenom, types.DefaultTobinTax)
+
+	// KRW
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: core.MicroKRWDenom, Amount: randomExchangeRate}}, 0)
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: core.MicroKRWDenom, Amount: randomExchangeRate}}, 1)
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: core.MicroKRWDenom, Amount: randomExchangeRate}}, 2)
+
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+
+	// vote targets are {ibc asset, KRW}
+	require.Equal(t, []string{ibcDenom, core.MicroKRWDenom}, input.OracleKeeper.GetVoteTargets(input.Ctx))
+
+	// ibc assets are not taxed and their display metadata is registered
+	tobinTax, err := input.OracleKeeper.GetTobinTax(input.Ctx, ibcDenom)
+	require.NoError(t, err)
+	require.True(t, tobinTax.IsZero())
+
+	metadata, ok := input.BankKeeper.GetDenomMetaData(input.Ctx, ibcDenom)
+	require.True(t, ok)
+	require.NoError(t, metadata.Validate())
+	require.Equal(t, ibcDenom, metadata.Base)
+	require.Equal(t, "atom", metadata.Display)
+	require.Equal(t, "ATOM", metadata.Symbol)
+	require.Equal(t, uint32(6), metadata.DenomUnits[1].Exponent)
+	require.Equal(t, []string{"uatom"}, metadata.DenomUnits[0].Aliases)
+
+	// KRW and ibc asset
+	ibcRate := randomExchangeRate.QuoInt64(3)
+	rates := sdk.DecCoins{{Denom: ibcDenom, Amount: ibcRate}, {Denom: core.MicroKRWDenom, Amount: randomExchangeRate}}
+	makeAggregatePrevoteAndVote(t, input, h, 0, rates, 0)
+	makeAggregatePrevoteAndVote(t, input, h, 0, rates, 1)
+	makeAggregatePrevoteAndVote(t, input, h, 0, rates, 2)
+
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+
+	// rates are derived through cross rates against the reference denom
+	rate, err := input.OracleKeeper.GetLunaExchangeRate(input.Ctx, ibcDenom)
+	require.NoError(t, err)
+	require.True(t, rate.Sub(ibcRate).Abs().LT(sdk.NewDecWithPrec(1, 12)))
+
+	rate, err = input.OracleKeeper.GetLunaExchangeRate(input.Ctx, core.MicroKRWDenom)
+	require.NoError(t, err)
+	require.True(t, rate.Sub(randomExchangeRate).Abs().LT(sdk.NewDecWithPrec(1, 12)))
+
+	// no missing for both rounds as the ibc asset was not a target in the first
+	require.Equal(t, uint64(0), input.OracleKeeper.GetMissCounter(input.Ctx, keeper.ValAddrs[0]))
+	require.Equal(t, uint64(0), input.OracleKeeper.GetMissCounter(input.Ctx, keeper.ValAddrs[1]))
+	require.Equal(t, uint64(0), input.OracleKeeper.GetMissCounter(input.Ctx, keeper.ValAddrs[2]))
+}
+
 func TestAbstainWithSmallStakingPower(t *testing.T) {
 	input, h := setupWithSmallVotingPower(t)
 
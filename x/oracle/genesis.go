@@ -65,6 +65,9 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState
 		for _, item := range data.Params.Whitelist {
 			keeper.SetTobinTax(ctx, item.Name, item.TobinTax)
 		}
+		for _, item := range data.Params.IbcAssets.Whitelist() {
+			keeper.SetTobinTax(ctx, item.Name, item.TobinTax)
+		}
 	}
 
 	keeper.SetParams(ctx, data.Params)
@@ -74,13 +74,18 @@ func (k Keeper) ClearBallots(ctx sdk.Context, votePeriod uint64) {
 }
 
 // ApplyWhitelist update vote target denom list and set tobin tax with params whitelist
-func (k Keeper) ApplyWhitelist(ctx sdk.Context, whitelist types.DenomList, voteTargets map[string]sdk.Dec) {
+// and ibc assets. A param change validates the ibc assets without the whitelist,
+// so ibc assets which are already whitelisted are ignored.
+func (k Keeper) ApplyWhitelist(ctx sdk.Context, whitelist types.DenomList, ibcAssets types.IBCAssetList, voteTargets map[string]sdk.Dec) {
+	ibcAssets = excludeWhitelisted(ctx, whitelist, ibcAssets)
+	targets := append(append(types.DenomList{}, whitelist...), ibcAssets.Whitelist()...)
+
 	// check is there any update in whitelist params
 	updateRequired := false
-	if len(voteTargets) != len(whitelist) {
+	if len(voteTargets) != len(targets) {
 		updateRequired = true
 	} else {
-		for _, item := range whitelist {
+		for _, item := range targets {
 			if tobinTax, ok := voteTargets[item.Name]; !ok || !tobinTax.Equal(item.TobinTax) {
 				updateRequired = true
 				break
@@ -113,5 +118,57 @@ func (k Keeper) ApplyWhitelist(ctx sdk.Context, whitelist types.DenomList, voteT
 				})
 			}
 		}
+
+		for _, asset := range ibcAssets {
+			k.SetTobinTax(ctx, asset.Denom, sdk.ZeroDec())
+
+			// Register meta data to bank module
+			if _, ok := k.bankKeeper.GetDenomMetaData(ctx, asset.Denom); !ok {
+				k.bankKeeper.SetDenomMetaData(ctx, ibcAssetMetadata(asset))
+			}
+		}
+	}
+}
+
+// excludeWhitelisted drops the ibc assets whose denom is already in the
+// whitelist, so that they are not registered twice as vote targets
+func excludeWhitelisted(ctx sdk.Context, whitelist types.DenomList, ibcAssets types.IBCAssetList) types.IBCAssetList {
+	whitelisted := make(map[string]bool, len(whitelist))
+	for _, item := range whitelist {
+		whitelisted[item.Name] = true
+	}
+
+	assets := types.IBCAssetList{}
+	for _, asset := range ibcAssets {
+		if whitelisted[asset.Denom] {
+			ctx.Logger().Error("ignoring ibc asset already in whitelist", "denom", asset.Denom)
+			continue
+		}
+		assets = append(assets, asset)
+	}
+
+	return assets
+}
+
+// ibcAssetMetadata builds the bank metadata of an ibc asset from its governance
+// registered display information
+func ibcAssetMetadata(asset types.IBCAsset) banktypes.Metadata {
+	display := strings.ToLower(asset.Symbol)
+	units := []*banktypes.DenomUnit{
+		{Denom: asset.Denom, Exponent: uint32(0), Aliases: []string{asset.BaseDenom}},
+	}
+	if asset.Decimals > 0 {
+		units = append(units, &banktypes.DenomUnit{Denom: display, Exponent: asset.Decimals, Aliases: []string{}})
+	} else {
+		display = asset.Denom
+	}
+
+	return banktypes.Metadata{
+		Description: fmt.Sprintf("%s transferred over IBC from %s.", asset.BaseDenom, asset.OriginChainId),
+		DenomUnits:  units,
+		Base:        asset.Denom,
+		Display:     display,
+		Name:        asset.Symbol,
+		Symbol:      asset.Symbol,
 	}
 }
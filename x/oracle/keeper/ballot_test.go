@@ -160,7 +160,7 @@ func TestApplyWhitelist(t *testing.T) {
 			Name:     "ukrw",
 			TobinTax: sdk.OneDec(),
 		},
-	}, map[string]sdk.Dec{
+	}, types.IBCAssetList{}, map[string]sdk.Dec{
 		"uusd": sdk.ZeroDec(),
 		"ukrw": sdk.ZeroDec(),
 	})
@@ -187,3 +187,38 @@ func TestApplyWhitelist(t *testing.T) {
 	require.Equal(t, len(metadata.DenomUnits), 3)
 	require.Equal(t, metadata.Description, "The native stable token of the Terra Columbus.")
 }
+
+func TestApplyWhitelistIgnoresWhitelistedIBCAssets(t *testing.T) {
+	input := CreateTestInput(t)
+	ibcDenom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+	tobinTax := sdk.NewDecWithPrec(1, 2)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{{Name: ibcDenom, TobinTax: tobinTax}}
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	// a param change only validates the changed ibc assets
+	ibcAssets := types.IBCAssetList{{
+		Denom:         ibcDenom,
+		OriginChainId: "cosmoshub-4",
+		BaseDenom:     "uatom",
+		Symbol:        "ATOM",
+		Decimals:      6,
+	}}
+	bz, err := input.Cdc.MarshalJSON(ibcAssets)
+	require.NoError(t, err)
+	require.NoError(t, input.OracleKeeper.paramSpace.Update(input.Ctx, types.KeyIBCAssets, bz))
+
+	params = input.OracleKeeper.GetParams(input.Ctx)
+	require.Equal(t, ibcAssets, params.IbcAssets)
+	require.Error(t, params.Validate())
+
+	input.OracleKeeper.ClearTobinTaxes(input.Ctx)
+	input.OracleKeeper.ApplyWhitelist(input.Ctx, params.Whitelist, params.IbcAssets, map[string]sdk.Dec{})
+
+	// the whitelisted tobin tax is kept
+	require.Equal(t, []string{ibcDenom}, input.OracleKeeper.GetVoteTargets(input.Ctx))
+	price, err := input.OracleKeeper.GetTobinTax(input.Ctx, ibcDenom)
+	require.NoError(t, err)
+	require.Equal(t, tobinTax, price)
+}
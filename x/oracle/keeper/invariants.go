@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/classic-terra/core/v3/types"
+	"github.com/classic-terra/core/v3/x/oracle/types"
+)
+
+// RegisterInvariants registers all oracle invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "exchange-rates", ExchangeRatesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "reward-pool", RewardPoolInvariant(k))
+}
+
+// AllInvariants runs all invariants of the oracle module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := ExchangeRatesInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return RewardPoolInvariant(k)(ctx)
+	}
+}
+
+// ExchangeRatesInvariant checks that all exchange rates are positive
+func ExchangeRatesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		k.IterateLunaExchangeRates(ctx, func(denom string, exchangeRate sdk.Dec) bool {
+			if !exchangeRate.IsPositive() {
+				broken = true
+				msg += fmt.Sprintf("\t%s exchange rate %s is not positive\n", denom, exchangeRate)
+			}
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "exchange-rates", msg), broken
+	}
+}
+
+// RewardPoolInvariant checks that the oracle module account balance covers the
+// rewards RewardBallotWinners pays out of every reward pool over the next vote
+// period of the RewardDistributionWindow
+func RewardPoolInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		votePeriod, rewardDistributionWindow := k.VotePeriod(ctx), k.RewardDistributionWindow(ctx)
+		if rewardDistributionWindow == 0 {
+			return sdk.FormatInvariant(types.ModuleName, "reward-pool", "\treward distribution window is zero\n"), true
+		}
+		distributionRatio := sdk.NewDec(int64(votePeriod)).QuoInt64(int64(rewardDistributionWindow))
+
+		balance := k.GetRewardPoolLegacy(ctx)
+		rewardDenoms := append([]string{core.MicroLunaDenom}, k.GetVoteTargets(ctx)...)
+		for _, denom := range rewardDenoms {
+			rewardPool := balance.AmountOf(denom)
+			periodRewards := sdk.NewDecFromInt(rewardPool).Mul(distributionRatio).TruncateInt()
+			if periodRewards.GT(rewardPool) {
+				broken = true
+				msg += fmt.Sprintf("\t%s rewards %s of the next vote period exceed the balance %s\n", denom, periodRewards, rewardPool)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "reward-pool", msg), broken
+	}
+}
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	core "github.com/classic-terra/core/v3/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestExchangeRatesInvariant(t *testing.T) {
+	input := CreateTestInput(t)
+
+	input.OracleKeeper.SetLunaExchangeRate(input.Ctx, core.MicroSDRDenom, sdk.OneDec())
+	_, broken := AllInvariants(input.OracleKeeper)(input.Ctx)
+	require.False(t, broken)
+
+	input.OracleKeeper.SetLunaExchangeRate(input.Ctx, core.MicroKRWDenom, sdk.ZeroDec())
+	_, broken = ExchangeRatesInvariant(input.OracleKeeper)(input.Ctx)
+	require.True(t, broken)
+}
+
+func TestRewardPoolInvariant(t *testing.T) {
+	input := CreateTestInput(t)
+
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 1000))
+	require.NoError(t, FundAccount(input, input.OracleKeeper.GetOracleAccount(input.Ctx).GetAddress(), rewards))
+	_, broken := RewardPoolInvariant(input.OracleKeeper)(input.Ctx)
+	require.False(t, broken)
+
+	// a vote period longer than the window pays out more than the balance
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.RewardDistributionWindow = params.VotePeriod / 2
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	_, broken = RewardPoolInvariant(input.OracleKeeper)(input.Ctx)
+	require.True(t, broken)
+}
@@ -119,6 +119,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 			SlashFraction:     slashFraction,
 			SlashWindow:       slashWindow,
 			MinValidPerWindow: minValidPerWindow,
+			IbcAssets:         types.IBCAssetList{},
 		},
 		[]types.ExchangeRateTuple{},
 		[]types.FeederDelegation{},
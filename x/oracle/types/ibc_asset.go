@@ -0,0 +1,91 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	"gopkg.in/yaml.v2"
+)
+
+// MaxIBCAssetDecimals is the largest number of decimals an IBC asset can have
+const MaxIBCAssetDecimals = 18
+
+// String implements fmt.Stringer interface
+func (a IBCAsset) String() string {
+	out, _ := yaml.Marshal(a)
+	return string(out)
+}
+
+// Equal implements equal interface
+func (a IBCAsset) Equal(a1 *IBCAsset) bool {
+	return a.Denom == a1.Denom &&
+		a.OriginChainId == a1.OriginChainId &&
+		a.BaseDenom == a1.BaseDenom &&
+		a.Symbol == a1.Symbol &&
+		a.Decimals == a1.Decimals
+}
+
+// Validate performs basic validation of the asset denom and its metadata
+func (a IBCAsset) Validate() error {
+	hash, found := strings.CutPrefix(a.Denom, ibctransfertypes.DenomPrefix+"/")
+	if !found {
+		return fmt.Errorf("oracle parameter IBCAssets denom must be in the format ibc/{hash}: %s", a.Denom)
+	}
+	if _, err := ibctransfertypes.ParseHexHash(hash); err != nil {
+		return fmt.Errorf("oracle parameter IBCAssets denom has invalid hash %s: %w", hash, err)
+	}
+	if len(a.OriginChainId) == 0 {
+		return fmt.Errorf("oracle parameter IBCAssets %s must have origin chain id", a.Denom)
+	}
+	if err := sdk.ValidateDenom(a.BaseDenom); err != nil {
+		return fmt.Errorf("oracle parameter IBCAssets %s has invalid base denom: %w", a.Denom, err)
+	}
+	if len(a.Symbol) == 0 {
+		return fmt.Errorf("oracle parameter IBCAssets %s must have symbol", a.Denom)
+	}
+	if a.Decimals > MaxIBCAssetDecimals {
+		return fmt.Errorf("oracle parameter IBCAssets %s decimals must be at most %d", a.Denom, MaxIBCAssetDecimals)
+	}
+
+	return nil
+}
+
+// IBCAssetList is array of IBCAsset
+type IBCAssetList []IBCAsset
+
+// String implements fmt.Stringer interface
+func (al IBCAssetList) String() (out string) {
+	for _, a := range al {
+		out += a.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
+
+// Validate checks every asset and rejects duplicated denoms
+func (al IBCAssetList) Validate() error {
+	seen := make(map[string]bool, len(al))
+	for _, a := range al {
+		if err := a.Validate(); err != nil {
+			return err
+		}
+		if seen[a.Denom] {
+			return fmt.Errorf("oracle parameter IBCAssets has duplicated denom %s", a.Denom)
+		}
+		seen[a.Denom] = true
+	}
+
+	return nil
+}
+
+// Whitelist returns the assets as vote target denoms. IBC assets cannot be
+// swapped by the market module, so no tobin tax is applied to them.
+func (al IBCAssetList) Whitelist() DenomList {
+	whitelist := make(DenomList, len(al))
+	for i, a := range al {
+		whitelist[i] = Denom{Name: a.Denom, TobinTax: sdk.ZeroDec()}
+	}
+
+	return whitelist
+}
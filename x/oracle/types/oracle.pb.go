@@ -35,6 +35,7 @@ type Params struct {
 	SlashFraction            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=slash_fraction,json=slashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction" yaml:"slash_fraction"`
 	SlashWindow              uint64                                 `protobuf:"varint,7,opt,name=slash_window,json=slashWindow,proto3" json:"slash_window,omitempty" yaml:"slash_window"`
 	MinValidPerWindow        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=min_valid_per_window,json=minValidPerWindow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_valid_per_window" yaml:"min_valid_per_window"`
+	IbcAssets                IBCAssetList                           `protobuf:"bytes,9,rep,name=ibc_assets,json=ibcAssets,proto3,castrepeated=IBCAssetList" json:"ibc_assets" yaml:"ibc_assets"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -97,6 +98,13 @@ func (m *Params) GetSlashWindow() uint64 {
 	return 0
 }
 
+func (m *Params) GetIbcAssets() IBCAssetList {
+	if m != nil {
+		return m.IbcAssets
+	}
+	return nil
+}
+
 // Denom - the object to hold configurations of each denom
 type Denom struct {
 	Name     string                                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
@@ -135,6 +143,48 @@ func (m *Denom) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Denom proto.InternalMessageInfo
 
+// IBCAsset - an IBC voucher denom whose price is voted on by the oracle
+// together with the display metadata of the asset on its origin chain
+type IBCAsset struct {
+	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	OriginChainId string `protobuf:"bytes,2,opt,name=origin_chain_id,json=originChainId,proto3" json:"origin_chain_id,omitempty" yaml:"origin_chain_id"`
+	BaseDenom     string `protobuf:"bytes,3,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty" yaml:"base_denom"`
+	Symbol        string `protobuf:"bytes,4,opt,name=symbol,proto3" json:"symbol,omitempty" yaml:"symbol"`
+	Decimals      uint32 `protobuf:"varint,5,opt,name=decimals,proto3" json:"decimals,omitempty" yaml:"decimals"`
+}
+
+func (m *IBCAsset) Reset()      { *m = IBCAsset{} }
+func (*IBCAsset) ProtoMessage() {}
+func (*IBCAsset) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2a008582d55f197f, []int{2}
+}
+func (m *IBCAsset) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IBCAsset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IBCAsset.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IBCAsset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IBCAsset.Merge(m, src)
+}
+func (m *IBCAsset) XXX_Size() int {
+	return m.Size()
+}
+func (m *IBCAsset) XXX_DiscardUnknown() {
+	xxx_messageInfo_IBCAsset.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IBCAsset proto.InternalMessageInfo
+
 // struct for aggregate prevoting on the ExchangeRateVote.
 // The purpose of aggregate prevote is to hide vote exchange rates with hash
 // which is formatted as hex string in SHA256("{salt}:{exchange rate}{denom},...,{exchange rate}{denom}:{voter}")
@@ -147,7 +197,7 @@ type AggregateExchangeRatePrevote struct {
 func (m *AggregateExchangeRatePrevote) Reset()      { *m = AggregateExchangeRatePrevote{} }
 func (*AggregateExchangeRatePrevote) ProtoMessage() {}
 func (*AggregateExchangeRatePrevote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2a008582d55f197f, []int{2}
+	return fileDescriptor_2a008582d55f197f, []int{3}
 }
 func (m *AggregateExchangeRatePrevote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -186,7 +236,7 @@ type AggregateExchangeRateVote struct {
 func (m *AggregateExchangeRateVote) Reset()      { *m = AggregateExchangeRateVote{} }
 func (*AggregateExchangeRateVote) ProtoMessage() {}
 func (*AggregateExchangeRateVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2a008582d55f197f, []int{3}
+	return fileDescriptor_2a008582d55f197f, []int{4}
 }
 func (m *AggregateExchangeRateVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -224,7 +274,7 @@ type ExchangeRateTuple struct {
 func (m *ExchangeRateTuple) Reset()      { *m = ExchangeRateTuple{} }
 func (*ExchangeRateTuple) ProtoMessage() {}
 func (*ExchangeRateTuple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2a008582d55f197f, []int{4}
+	return fileDescriptor_2a008582d55f197f, []int{5}
 }
 func (m *ExchangeRateTuple) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -256,6 +306,7 @@ var xxx_messageInfo_ExchangeRateTuple proto.InternalMessageInfo
 func init() {
 	proto.RegisterType((*Params)(nil), "terra.oracle.v1beta1.Params")
 	proto.RegisterType((*Denom)(nil), "terra.oracle.v1beta1.Denom")
+	proto.RegisterType((*IBCAsset)(nil), "terra.oracle.v1beta1.IBCAsset")
 	proto.RegisterType((*AggregateExchangeRatePrevote)(nil), "terra.oracle.v1beta1.AggregateExchangeRatePrevote")
 	proto.RegisterType((*AggregateExchangeRateVote)(nil), "terra.oracle.v1beta1.AggregateExchangeRateVote")
 	proto.RegisterType((*ExchangeRateTuple)(nil), "terra.oracle.v1beta1.ExchangeRateTuple")
@@ -264,56 +315,66 @@ func init() {
 func init() { proto.RegisterFile("terra/oracle/v1beta1/oracle.proto", fileDescriptor_2a008582d55f197f) }
 
 var fileDescriptor_2a008582d55f197f = []byte{
-	// 783 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xbd, 0x6e, 0xdb, 0x48,
-	0x10, 0x16, 0xcf, 0xb6, 0xce, 0x5a, 0xc9, 0x77, 0x36, 0x4f, 0x77, 0x47, 0xdb, 0x07, 0xd1, 0xe6,
-	0xc1, 0x3e, 0x37, 0x96, 0xe0, 0x73, 0x71, 0x38, 0x75, 0x21, 0x94, 0x00, 0x01, 0x52, 0x08, 0x84,
-	0xe2, 0x00, 0x49, 0xc1, 0x2c, 0xc9, 0x8d, 0x48, 0x98, 0xe4, 0x0a, 0xbb, 0xab, 0x1f, 0x03, 0x79,
-	0x80, 0x14, 0x29, 0x52, 0xa4, 0x48, 0xe9, 0x36, 0xa9, 0x93, 0x37, 0x48, 0xe1, 0x2a, 0x30, 0x52,
-	0x05, 0x29, 0x98, 0xc0, 0x6e, 0x5c, 0xeb, 0x09, 0x82, 0x5d, 0xae, 0x6c, 0xea, 0xa7, 0x88, 0x90,
-	0x4a, 0x9a, 0xf9, 0x66, 0xbf, 0xf9, 0x66, 0x66, 0x87, 0x0b, 0xb6, 0x19, 0x22, 0x04, 0xd6, 0x30,
-	0x81, 0x6e, 0x88, 0x6a, 0xbd, 0x03, 0x07, 0x31, 0x78, 0x20, 0xcd, 0x6a, 0x87, 0x60, 0x86, 0xd5,
-	0xb2, 0x08, 0xa9, 0x4a, 0x9f, 0x0c, 0xd9, 0x58, 0x77, 0x31, 0x8d, 0x30, 0xb5, 0x45, 0x4c, 0x2d,
-	0x35, 0xd2, 0x03, 0x1b, 0xe5, 0x36, 0x6e, 0xe3, 0xd4, 0xcf, 0xff, 0xa5, 0x5e, 0xe3, 0x43, 0x1e,
-	0xe4, 0x9b, 0x90, 0xc0, 0x88, 0xaa, 0xff, 0x81, 0x62, 0x0f, 0x33, 0x64, 0x77, 0x10, 0x09, 0xb0,
-	0xa7, 0x29, 0x5b, 0xca, 0xde, 0xa2, 0xf9, 0xc7, 0x30, 0xd1, 0xd5, 0x13, 0x18, 0x85, 0x75, 0x23,
-	0x03, 0x1a, 0x16, 0xe0, 0x56, 0x53, 0x18, 0xea, 0x53, 0xf0, 0x8b, 0xc0, 0x98, 0x4f, 0x10, 0xf5,
-	0x71, 0xe8, 0x69, 0x3f, 0x6d, 0x29, 0x7b, 0x05, 0xf3, 0xfe, 0x59, 0xa2, 0xe7, 0x3e, 0x27, 0xfa,
-	0x6e, 0x3b, 0x60, 0x7e, 0xd7, 0xa9, 0xba, 0x38, 0x92, 0x92, 0xe4, 0xcf, 0x3e, 0xf5, 0x8e, 0x6b,
-	0xec, 0xa4, 0x83, 0x68, 0xb5, 0x81, 0xdc, 0x61, 0xa2, 0xff, 0x9e, 0xc9, 0x74, 0xcd, 0x66, 0x7c,
-	0x7c, 0xbb, 0x0f, 0x64, 0x29, 0x0d, 0xe4, 0x5a, 0x2b, 0x1c, 0x6e, 0x8d, 0x50, 0x95, 0x82, 0x22,
-	0x41, 0x7d, 0x48, 0x3c, 0xdb, 0x81, 0xb1, 0xa7, 0x2d, 0x88, 0xd4, 0xd6, 0xdc, 0xa9, 0x65, 0x91,
-	0x19, 0xaa, 0xc9, 0xbc, 0x20, 0xc5, 0x4c, 0x18, 0x7b, 0xaa, 0x0b, 0x36, 0x64, 0xa4, 0x17, 0x50,
-	0x46, 0x02, 0xa7, 0xcb, 0x02, 0x1c, 0xdb, 0xfd, 0x20, 0xf6, 0x70, 0x5f, 0x5b, 0x14, 0xad, 0xdb,
-	0x19, 0x26, 0xfa, 0xf6, 0x18, 0xeb, 0x8c, 0x58, 0xc3, 0xd2, 0x52, 0xb0, 0x91, 0xc1, 0x1e, 0x08,
-	0x48, 0x7d, 0x0c, 0x0a, 0x7d, 0x3f, 0x60, 0x28, 0x0c, 0x28, 0xd3, 0x96, 0xb6, 0x16, 0xf6, 0x8a,
-	0xff, 0x6e, 0x56, 0x67, 0x8d, 0xbd, 0xda, 0x40, 0x31, 0x8e, 0xcc, 0x1d, 0x5e, 0xf4, 0x30, 0xd1,
-	0x57, 0xd3, 0xa4, 0xd7, 0x67, 0x8d, 0x37, 0x5f, 0xf4, 0x82, 0x08, 0xb9, 0x17, 0x50, 0x66, 0xdd,
-	0x90, 0xf2, 0xc9, 0xd1, 0x10, 0x52, 0xdf, 0x7e, 0x42, 0xa0, 0xcb, 0x33, 0x6b, 0xf9, 0x1f, 0x9b,
-	0xdc, 0x38, 0xdb, 0xd4, 0xe4, 0x04, 0x7c, 0x47, 0xa2, 0x6a, 0x1d, 0x94, 0xd2, 0x78, 0xd9, 0xb6,
-	0x9f, 0x45, 0xdb, 0xfe, 0x1c, 0x26, 0xfa, 0x6f, 0x59, 0xb6, 0x51, 0xa3, 0x8a, 0xc2, 0x94, 0xbd,
-	0x79, 0xae, 0x80, 0x72, 0x14, 0xc4, 0x76, 0x0f, 0x86, 0x81, 0xc7, 0x6f, 0xe5, 0x88, 0x64, 0x59,
-	0x14, 0xf0, 0x68, 0xee, 0x02, 0x36, 0xd3, 0x94, 0xb3, 0x38, 0x27, 0xcb, 0x58, 0x8b, 0x82, 0xf8,
-	0x88, 0xc7, 0x34, 0x11, 0x49, 0xe5, 0xd4, 0x97, 0x5f, 0x9d, 0xea, 0xb9, 0xab, 0x53, 0x5d, 0x31,
-	0x5e, 0x2b, 0x60, 0x49, 0xf4, 0x5a, 0xfd, 0x1b, 0x2c, 0xc6, 0x30, 0x42, 0x62, 0x91, 0x0a, 0xe6,
-	0xaf, 0xc3, 0x44, 0x2f, 0xa6, 0x39, 0xb8, 0xd7, 0xb0, 0x04, 0xa8, 0x46, 0xa0, 0xc0, 0xb0, 0x13,
-	0xc4, 0x36, 0x83, 0x03, 0xb9, 0x36, 0xcd, 0xb9, 0xb5, 0xcb, 0x81, 0x5f, 0x13, 0x4d, 0x0a, 0x5e,
-	0x16, 0x48, 0x0b, 0x0e, 0xea, 0xa5, 0x67, 0xa7, 0x7a, 0x4e, 0x6a, 0xcd, 0x19, 0xef, 0x14, 0xf0,
-	0xd7, 0xad, 0x76, 0x9b, 0xa0, 0x36, 0x64, 0xe8, 0xf6, 0xc0, 0xf5, 0x61, 0xdc, 0x46, 0x16, 0x64,
-	0xa8, 0x49, 0x10, 0x5f, 0x32, 0x5e, 0x82, 0x0f, 0xa9, 0x3f, 0x5d, 0x02, 0xf7, 0x1a, 0x96, 0x00,
-	0xd5, 0x5d, 0xb0, 0xc4, 0x83, 0x89, 0x94, 0xbf, 0x3a, 0x4c, 0xf4, 0xd2, 0xcd, 0x1e, 0x13, 0xc3,
-	0x4a, 0x61, 0x31, 0xee, 0xae, 0x13, 0x05, 0xcc, 0x76, 0x42, 0xec, 0x1e, 0x8b, 0x4d, 0x1d, 0x1f,
-	0x77, 0x06, 0xe5, 0xe3, 0x16, 0xa6, 0xc9, 0xad, 0x09, 0xdd, 0x57, 0x0a, 0x58, 0x9f, 0xa9, 0xfb,
-	0x88, 0x8b, 0x7e, 0xa9, 0x80, 0x32, 0x92, 0x4e, 0x9b, 0x40, 0xfe, 0x29, 0xe9, 0x76, 0x42, 0x44,
-	0x35, 0x45, 0xac, 0xd0, 0x3f, 0xb3, 0x57, 0x28, 0x4b, 0xd3, 0xe2, 0xf1, 0xe6, 0xff, 0x72, 0x9d,
-	0xe4, 0xcd, 0x98, 0x45, 0xc9, 0x37, 0x4b, 0x9d, 0x3a, 0x49, 0x2d, 0x15, 0x4d, 0xf9, 0xbe, 0xb7,
-	0x4d, 0x13, 0xa5, 0xbe, 0x57, 0xc0, 0xda, 0x54, 0x02, 0xce, 0xe5, 0xf1, 0x3b, 0x26, 0x07, 0x93,
-	0xe1, 0x12, 0x6e, 0xc3, 0x4a, 0x61, 0xf5, 0x04, 0xac, 0x8c, 0xc9, 0x96, 0xb9, 0x5b, 0x73, 0xdf,
-	0xb0, 0xf2, 0x8c, 0x1e, 0x4c, 0xde, 0xb2, 0x52, 0xb6, 0xe8, 0xf1, 0x32, 0xcc, 0xbb, 0x67, 0x17,
-	0x15, 0xe5, 0xfc, 0xa2, 0xa2, 0x7c, 0xbd, 0xa8, 0x28, 0x2f, 0x2e, 0x2b, 0xb9, 0xf3, 0xcb, 0x4a,
-	0xee, 0xd3, 0x65, 0x25, 0xf7, 0xb0, 0x96, 0xd5, 0x10, 0x42, 0x4a, 0x03, 0x77, 0x3f, 0x7d, 0xfd,
-	0x5c, 0x4c, 0x50, 0xad, 0x77, 0x58, 0x1b, 0x8c, 0xde, 0x41, 0x21, 0xc8, 0xc9, 0x8b, 0x87, 0xeb,
-	0xf0, 0x5b, 0x00, 0x00, 0x00, 0xff, 0xff, 0xaa, 0x44, 0x2d, 0x65, 0x24, 0x07, 0x00, 0x00,
+	// 935 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4d, 0x6f, 0xdc, 0x44,
+	0x18, 0x5e, 0xe7, 0xab, 0xbb, 0xb3, 0xbb, 0xa4, 0x71, 0xb7, 0xc5, 0x4d, 0xd1, 0x3a, 0x1d, 0xd4,
+	0x92, 0x1e, 0xb2, 0xab, 0x52, 0x24, 0x44, 0x6e, 0x75, 0x03, 0x52, 0x24, 0x0e, 0x2b, 0x2b, 0x14,
+	0x09, 0x0e, 0x66, 0x6c, 0x0f, 0xeb, 0x51, 0x6d, 0x4f, 0x34, 0x33, 0xf9, 0x92, 0xf8, 0x01, 0x1c,
+	0x38, 0x70, 0x40, 0x88, 0x63, 0xae, 0x70, 0x06, 0x89, 0x1f, 0xc0, 0xa1, 0xc7, 0x8a, 0x13, 0xe2,
+	0x60, 0x50, 0x72, 0xe9, 0xd9, 0xbf, 0x00, 0xcd, 0xc7, 0x26, 0x8e, 0xd7, 0x87, 0x46, 0x9c, 0x76,
+	0xdf, 0xf7, 0x79, 0xe6, 0xfd, 0x78, 0x5e, 0xbf, 0xa3, 0x01, 0xf7, 0x05, 0x66, 0x0c, 0x8d, 0x29,
+	0x43, 0x51, 0x8a, 0xc7, 0x87, 0x8f, 0x43, 0x2c, 0xd0, 0x63, 0x63, 0x8e, 0xf6, 0x19, 0x15, 0xd4,
+	0x1e, 0x28, 0xca, 0xc8, 0xf8, 0x0c, 0x65, 0xfd, 0x6e, 0x44, 0x79, 0x46, 0x79, 0xa0, 0x38, 0x63,
+	0x6d, 0xe8, 0x03, 0xeb, 0x83, 0x29, 0x9d, 0x52, 0xed, 0x97, 0xff, 0xb4, 0x17, 0xfe, 0x7e, 0x03,
+	0xac, 0x4c, 0x10, 0x43, 0x19, 0xb7, 0x3f, 0x04, 0xdd, 0x43, 0x2a, 0x70, 0xb0, 0x8f, 0x19, 0xa1,
+	0xb1, 0x63, 0x6d, 0x58, 0x9b, 0x4b, 0xde, 0x9d, 0xb2, 0x70, 0xed, 0x13, 0x94, 0xa5, 0xdb, 0xb0,
+	0x02, 0x42, 0x1f, 0x48, 0x6b, 0xa2, 0x0c, 0xfb, 0x1b, 0xf0, 0x96, 0xc2, 0x44, 0xc2, 0x30, 0x4f,
+	0x68, 0x1a, 0x3b, 0x0b, 0x1b, 0xd6, 0x66, 0xc7, 0xfb, 0xec, 0x65, 0xe1, 0xb6, 0xfe, 0x2e, 0xdc,
+	0x87, 0x53, 0x22, 0x92, 0x83, 0x70, 0x14, 0xd1, 0xcc, 0x94, 0x64, 0x7e, 0xb6, 0x78, 0xfc, 0x62,
+	0x2c, 0x4e, 0xf6, 0x31, 0x1f, 0xed, 0xe0, 0xa8, 0x2c, 0xdc, 0xdb, 0x95, 0x4c, 0x17, 0xd1, 0xe0,
+	0x9f, 0xbf, 0x6e, 0x01, 0xd3, 0xca, 0x0e, 0x8e, 0xfc, 0xbe, 0x84, 0xf7, 0x66, 0xa8, 0xcd, 0x41,
+	0x97, 0xe1, 0x23, 0xc4, 0xe2, 0x20, 0x44, 0x79, 0xec, 0x2c, 0xaa, 0xd4, 0xfe, 0xb5, 0x53, 0x9b,
+	0x26, 0x2b, 0xa1, 0xea, 0x79, 0x81, 0xc6, 0x3c, 0x94, 0xc7, 0x76, 0x04, 0xd6, 0x0d, 0x33, 0x26,
+	0x5c, 0x30, 0x12, 0x1e, 0x08, 0x42, 0xf3, 0xe0, 0x88, 0xe4, 0x31, 0x3d, 0x72, 0x96, 0x94, 0x74,
+	0x0f, 0xca, 0xc2, 0xbd, 0x7f, 0x25, 0x6a, 0x03, 0x17, 0xfa, 0x8e, 0x06, 0x77, 0x2a, 0xd8, 0xe7,
+	0x0a, 0xb2, 0xbf, 0x02, 0x9d, 0xa3, 0x84, 0x08, 0x9c, 0x12, 0x2e, 0x9c, 0xe5, 0x8d, 0xc5, 0xcd,
+	0xee, 0xfb, 0xf7, 0x46, 0x4d, 0x63, 0x1f, 0xed, 0xe0, 0x9c, 0x66, 0xde, 0x03, 0xd9, 0x74, 0x59,
+	0xb8, 0x37, 0x75, 0xd2, 0x8b, 0xb3, 0xf0, 0x97, 0x7f, 0xdc, 0x8e, 0xa2, 0x7c, 0x4a, 0xb8, 0xf0,
+	0x2f, 0x83, 0xca, 0xc9, 0xf1, 0x14, 0xf1, 0x24, 0xf8, 0x9a, 0xa1, 0x48, 0x66, 0x76, 0x56, 0xfe,
+	0xdf, 0xe4, 0xae, 0x46, 0x9b, 0x9b, 0x9c, 0x82, 0x3f, 0x31, 0xa8, 0xbd, 0x0d, 0x7a, 0x9a, 0x6f,
+	0x64, 0xbb, 0xa1, 0x64, 0x7b, 0xbb, 0x2c, 0xdc, 0x5b, 0xd5, 0x68, 0x33, 0xa1, 0xba, 0xca, 0x34,
+	0xda, 0x7c, 0x67, 0x81, 0x41, 0x46, 0xf2, 0xe0, 0x10, 0xa5, 0x24, 0x96, 0x5f, 0xe5, 0x2c, 0x48,
+	0x5b, 0x35, 0xf0, 0xe5, 0xb5, 0x1b, 0xb8, 0xa7, 0x53, 0x36, 0xc5, 0xac, 0xb7, 0xb1, 0x96, 0x91,
+	0xfc, 0xb9, 0xe4, 0x4c, 0x30, 0x33, 0xe5, 0x24, 0x00, 0x90, 0x30, 0x0a, 0x10, 0xe7, 0x58, 0x70,
+	0xa7, 0xa3, 0x66, 0x35, 0x6c, 0x9e, 0xd5, 0xae, 0xf7, 0xec, 0xa9, 0xa4, 0x79, 0x8f, 0xcc, 0xb8,
+	0xd6, 0x74, 0xe6, 0xcb, 0xf3, 0x72, 0x5e, 0xbd, 0x19, 0x4d, 0x8f, 0x8c, 0x84, 0x91, 0xb2, 0xf8,
+	0x76, 0xfb, 0xa7, 0x53, 0xb7, 0xf5, 0xfa, 0xd4, 0xb5, 0xe0, 0xcf, 0x16, 0x58, 0x56, 0x53, 0xb5,
+	0xdf, 0x05, 0x4b, 0x39, 0xca, 0xb0, 0x5a, 0xd9, 0x8e, 0xb7, 0x5a, 0x16, 0x6e, 0x57, 0xc7, 0x94,
+	0x5e, 0xe8, 0x2b, 0xd0, 0xce, 0x40, 0x47, 0xd0, 0x90, 0xe4, 0x81, 0x40, 0xc7, 0x66, 0x41, 0x27,
+	0xd7, 0x56, 0xc9, 0x7c, 0x5a, 0x17, 0x81, 0xea, 0xd2, 0xb4, 0x15, 0xb2, 0x87, 0x8e, 0xb7, 0x7b,
+	0xdf, 0x9e, 0xba, 0x2d, 0x53, 0x6b, 0x0b, 0xfe, 0xb8, 0x00, 0xda, 0xb3, 0x8e, 0xec, 0x87, 0x60,
+	0x39, 0x96, 0x75, 0x9b, 0x7a, 0x6f, 0x96, 0x85, 0xdb, 0xd3, 0x71, 0x95, 0x1b, 0xfa, 0x1a, 0xb6,
+	0x3d, 0xb0, 0x4a, 0x19, 0x99, 0x92, 0x3c, 0x88, 0x12, 0x44, 0xf2, 0x80, 0xcc, 0x2e, 0x96, 0xf5,
+	0xb2, 0x70, 0xef, 0xe8, 0x13, 0x35, 0x02, 0xf4, 0xfb, 0xda, 0xf3, 0x4c, 0x3a, 0x76, 0x63, 0xfb,
+	0x03, 0x00, 0x42, 0xc4, 0x71, 0xa0, 0x13, 0xea, 0xcb, 0xe1, 0xf6, 0xa5, 0xe8, 0x97, 0x18, 0xf4,
+	0x3b, 0xd2, 0xd0, 0x82, 0x3e, 0x02, 0x2b, 0xfc, 0x24, 0x0b, 0x69, 0xaa, 0x56, 0xb9, 0xe3, 0xad,
+	0x95, 0x85, 0xdb, 0xd7, 0x27, 0xb4, 0x1f, 0xfa, 0x86, 0x60, 0x8f, 0x41, 0x3b, 0xc6, 0x11, 0xc9,
+	0x50, 0xca, 0x9d, 0xe5, 0x0d, 0x6b, 0xb3, 0xef, 0xdd, 0x2a, 0x0b, 0x77, 0x75, 0xd6, 0x8f, 0x46,
+	0xa0, 0x7f, 0x41, 0xaa, 0x09, 0xf3, 0x9b, 0x05, 0xde, 0x79, 0x3a, 0x9d, 0x32, 0x3c, 0x45, 0x02,
+	0x7f, 0x7c, 0x1c, 0x25, 0x28, 0x9f, 0x62, 0x1f, 0x09, 0x3c, 0x61, 0x58, 0xde, 0x73, 0x72, 0xb6,
+	0x09, 0xe2, 0xc9, 0xfc, 0x6c, 0xa5, 0x17, 0xfa, 0x0a, 0x94, 0x8a, 0x4a, 0x32, 0x73, 0x16, 0xea,
+	0x8a, 0x2a, 0x37, 0xf4, 0x35, 0xac, 0x36, 0xee, 0x20, 0xcc, 0x88, 0x08, 0xc2, 0x94, 0x46, 0x2f,
+	0x9c, 0xc5, 0xb9, 0x8d, 0xab, 0xa0, 0x72, 0xe3, 0x94, 0xe9, 0x49, 0xab, 0x56, 0xf7, 0x6b, 0x0b,
+	0xdc, 0x6d, 0xac, 0xfb, 0xb9, 0x2c, 0xfa, 0x07, 0x0b, 0x0c, 0xb0, 0x71, 0x06, 0x0c, 0xc9, 0xdb,
+	0xfc, 0x60, 0x3f, 0xc5, 0xdc, 0xb1, 0xd4, 0x66, 0xbc, 0xd7, 0xbc, 0x19, 0xd5, 0x30, 0x7b, 0x92,
+	0xef, 0x7d, 0x64, 0x56, 0xc4, 0x2c, 0x67, 0x53, 0x48, 0xb9, 0x2c, 0xf6, 0xdc, 0x49, 0xee, 0xdb,
+	0x78, 0xce, 0xf7, 0xa6, 0x32, 0xd5, 0x5a, 0xfd, 0xc3, 0x02, 0x6b, 0x73, 0x09, 0xde, 0xf8, 0x23,
+	0x3e, 0x01, 0xfd, 0x2b, 0x65, 0x9b, 0xdc, 0x7b, 0xd7, 0x5e, 0xbd, 0x41, 0x83, 0x06, 0xf5, 0xf5,
+	0xeb, 0x55, 0x9b, 0xbe, 0xda, 0x86, 0xb7, 0xfb, 0xf2, 0x6c, 0x68, 0xbd, 0x3a, 0x1b, 0x5a, 0xff,
+	0x9e, 0x0d, 0xad, 0xef, 0xcf, 0x87, 0xad, 0x57, 0xe7, 0xc3, 0xd6, 0x5f, 0xe7, 0xc3, 0xd6, 0x17,
+	0xe3, 0x6a, 0x0d, 0x29, 0xe2, 0x9c, 0x44, 0x5b, 0xfa, 0x01, 0x12, 0x51, 0x86, 0xc7, 0x87, 0x4f,
+	0xc6, 0xc7, 0xb3, 0xa7, 0x88, 0x2a, 0x28, 0x5c, 0x51, 0x6f, 0x87, 0x27, 0xff, 0x0d, 0x00, 0x69,
+	0xeb, 0xff, 0x2f, 0xa7, 0x08, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -364,6 +425,14 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.MinValidPerWindow.Equal(that1.MinValidPerWindow) {
 		return false
 	}
+	if len(this.IbcAssets) != len(that1.IbcAssets) {
+		return false
+	}
+	for i := range this.IbcAssets {
+		if !this.IbcAssets[i].Equal(&that1.IbcAssets[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -386,6 +455,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.IbcAssets) > 0 {
+		for iNdEx := len(m.IbcAssets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IbcAssets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	{
 		size := m.MinValidPerWindow.Size()
 		i -= size
@@ -498,6 +581,62 @@ func (m *Denom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *IBCAsset) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IBCAsset) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IBCAsset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Decimals != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OriginChainId) > 0 {
+		i -= len(m.OriginChainId)
+		copy(dAtA[i:], m.OriginChainId)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.OriginChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *AggregateExchangeRatePrevote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -664,6 +803,12 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.MinValidPerWindow.Size()
 	n += 1 + l + sovOracle(uint64(l))
+	if len(m.IbcAssets) > 0 {
+		for _, e := range m.IbcAssets {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -682,6 +827,34 @@ func (m *Denom) Size() (n int) {
 	return n
 }
 
+func (m *IBCAsset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.OriginChainId)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovOracle(uint64(m.Decimals))
+	}
+	return n
+}
+
 func (m *AggregateExchangeRatePrevote) Size() (n int) {
 	if m == nil {
 		return 0
@@ -998,6 +1171,40 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcAssets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcAssets = append(m.IbcAssets, IBCAsset{})
+			if err := m.IbcAssets[len(m.IbcAssets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
@@ -1135,6 +1342,203 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *IBCAsset) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IBCAsset: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IBCAsset: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
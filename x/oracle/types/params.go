@@ -21,6 +21,7 @@ var (
 	KeySlashFraction            = []byte("SlashFraction")
 	KeySlashWindow              = []byte("SlashWindow")
 	KeyMinValidPerWindow        = []byte("MinValidPerWindow")
+	KeyIBCAssets                = []byte("IbcAssets")
 )
 
 // Default parameter values
@@ -43,6 +44,7 @@ var (
 	}
 	DefaultSlashFraction     = sdk.NewDecWithPrec(1, 4) // 0.01%
 	DefaultMinValidPerWindow = sdk.NewDecWithPrec(5, 2) // 5%
+	DefaultIBCAssets         = IBCAssetList{}
 )
 
 var _ paramstypes.ParamSet = &Params{}
@@ -58,6 +60,7 @@ func DefaultParams() Params {
 		SlashFraction:            DefaultSlashFraction,
 		SlashWindow:              DefaultSlashWindow,
 		MinValidPerWindow:        DefaultMinValidPerWindow,
+		IbcAssets:                DefaultIBCAssets,
 	}
 }
 
@@ -78,6 +81,7 @@ func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 		paramstypes.NewParamSetPair(KeySlashFraction, &p.SlashFraction, validateSlashFraction),
 		paramstypes.NewParamSetPair(KeySlashWindow, &p.SlashWindow, validateSlashWindow),
 		paramstypes.NewParamSetPair(KeyMinValidPerWindow, &p.MinValidPerWindow, validateMinValidPerWindow),
+		paramstypes.NewParamSetPair(KeyIBCAssets, &p.IbcAssets, validateIBCAssets),
 	}
 }
 
@@ -124,6 +128,18 @@ func (p Params) Validate() error {
 			return fmt.Errorf("oracle parameter Whitelist Denom must have name")
 		}
 	}
+
+	if err := p.IbcAssets.Validate(); err != nil {
+		return err
+	}
+
+	for _, asset := range p.IbcAssets {
+		for _, denom := range p.Whitelist {
+			if asset.Denom == denom.Name {
+				return fmt.Errorf("oracle parameter IBCAssets denom %s is already in Whitelist", asset.Denom)
+			}
+		}
+	}
 	return nil
 }
 
@@ -251,3 +267,14 @@ func validateMinValidPerWindow(i interface{}) error {
 
 	return nil
 }
+
+// validateIBCAssets can not see the whitelist on a param change, assets which
+// are already whitelisted are ignored by the keeper when applying the whitelist.
+func validateIBCAssets(i interface{}) error {
+	v, ok := i.(IBCAssetList)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return v.Validate()
+}
@@ -75,6 +75,22 @@ func TestParamsEqual(t *testing.T) {
 	err = p10.Validate()
 	require.Error(t, err)
 
+	// ibc asset already whitelisted
+	p12 := types.DefaultParams()
+	p12.Whitelist = append(p12.Whitelist, types.Denom{
+		Name:     "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+		TobinTax: sdk.ZeroDec(),
+	})
+	p12.IbcAssets = types.IBCAssetList{{
+		Denom:         "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+		OriginChainId: "cosmoshub-4",
+		BaseDenom:     "uatom",
+		Symbol:        "ATOM",
+		Decimals:      6,
+	}}
+	err = p12.Validate()
+	require.Error(t, err)
+
 	p11 := types.DefaultParams()
 	require.NotNil(t, p11.ParamSetPairs())
 	require.NotNil(t, p11.String())
@@ -129,6 +145,41 @@ func TestValidate(t *testing.T) {
 					TobinTax: sdk.NewDecWithPrec(-1, 2),
 				},
 			}))
+		case bytes.Equal(types.KeyIBCAssets, pair.Key):
+			asset := types.IBCAsset{
+				Denom:         "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+				OriginChainId: "cosmoshub-4",
+				BaseDenom:     "uatom",
+				Symbol:        "ATOM",
+				Decimals:      6,
+			}
+			require.NoError(t, pair.ValidatorFn(types.IBCAssetList{asset}))
+			require.Error(t, pair.ValidatorFn("invalid"))
+			require.Error(t, pair.ValidatorFn(types.IBCAssetList{asset, asset}))
+
+			invalid := asset
+			invalid.Denom = "uatom"
+			require.Error(t, pair.ValidatorFn(types.IBCAssetList{invalid}))
+
+			invalid = asset
+			invalid.Denom = "ibc/xyz"
+			require.Error(t, pair.ValidatorFn(types.IBCAssetList{invalid}))
+
+			invalid = asset
+			invalid.OriginChainId = ""
+			require.Error(t, pair.ValidatorFn(types.IBCAssetList{invalid}))
+
+			invalid = asset
+			invalid.BaseDenom = ""
+			require.Error(t, pair.ValidatorFn(types.IBCAssetList{invalid}))
+
+			invalid = asset
+			invalid.Symbol = ""
+			require.Error(t, pair.ValidatorFn(types.IBCAssetList{invalid}))
+
+			invalid = asset
+			invalid.Decimals = 19
+			require.Error(t, pair.ValidatorFn(types.IBCAssetList{invalid}))
 		}
 	}
 }
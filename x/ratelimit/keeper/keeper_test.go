@@ -0,0 +1,236 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+
+	apptesting "github.com/classic-terra/core/v3/app/testing"
+	"github.com/classic-terra/core/v3/x/ratelimit/keeper"
+	ratelimit "github.com/classic-terra/core/v3/x/ratelimit/module"
+	"github.com/classic-terra/core/v3/x/ratelimit/types"
+)
+
+const (
+	channelID             = "channel-0"
+	counterpartyChannelID = "channel-7"
+)
+
+// mockICS4Wrapper assigns increasing sequences to the sent packets
+type mockICS4Wrapper struct {
+	porttypes.ICS4Wrapper
+
+	sequence uint64
+}
+
+func (m *mockICS4Wrapper) SendPacket(sdk.Context, *capabilitytypes.Capability, string, string, clienttypes.Height, uint64, []byte) (uint64, error) {
+	m.sequence++
+	return m.sequence, nil
+}
+
+// mockIBCModule acknowledges all received packets
+type mockIBCModule struct {
+	porttypes.IBCModule
+}
+
+func (mockIBCModule) OnRecvPacket(sdk.Context, channeltypes.Packet, sdk.AccAddress) ibcexported.Acknowledgement {
+	return channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+}
+
+type KeeperTestSuite struct {
+	apptesting.KeeperTestHelper
+
+	keeper keeper.Keeper
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.Setup(s.T(), apptesting.SimAppChainID)
+	s.keeper = keeper.NewKeeper(
+		s.App.AppCodec(),
+		s.App.GetKey(types.StoreKey),
+		s.App.BankKeeper,
+		&mockICS4Wrapper{},
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+// addRateLimit mints supply of denom and adds a rate limit of 10% sent and
+// 20% received per day
+func (s *KeeperTestSuite) addRateLimit(denom string, supply int64) types.Path {
+	s.FundAcc(s.TestAccs[0], sdk.NewCoins(sdk.NewInt64Coin(denom, supply)))
+
+	path := types.NewPath(denom, channelID)
+	err := s.keeper.AddRateLimit(s.Ctx, path, types.NewQuota(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(2, 1), 24))
+	s.Require().NoError(err)
+
+	return path
+}
+
+func (s *KeeperTestSuite) sendPacket(denom string, amount string) (uint64, error) {
+	data := transfertypes.NewFungibleTokenPacketData(denom, amount, s.TestAccs[0].String(), "receiver", "").GetBytes()
+	return s.keeper.SendPacket(s.Ctx, nil, transfertypes.PortID, channelID, clienttypes.ZeroHeight(), 0, data)
+}
+
+func (s *KeeperTestSuite) recvPacket(denom string, amount string) channeltypes.Packet {
+	data := transfertypes.NewFungibleTokenPacketData(denom, amount, "sender", s.TestAccs[0].String(), "").GetBytes()
+	return channeltypes.NewPacket(data, 1, transfertypes.PortID, counterpartyChannelID, transfertypes.PortID, channelID, clienttypes.ZeroHeight(), 0)
+}
+
+func (s *KeeperTestSuite) TestAddRateLimit() {
+	path := s.addRateLimit("ufoo", 1000)
+
+	rateLimit, found := s.keeper.GetRateLimit(s.Ctx, path.Denom, path.ChannelId)
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewInt(1000), rateLimit.Flow.ChannelValue)
+	s.Require().Equal(s.Ctx.BlockTime().UTC(), rateLimit.WindowStart)
+
+	quota := types.NewQuota(sdk.OneDec(), sdk.OneDec(), 1)
+	s.Require().ErrorIs(s.keeper.AddRateLimit(s.Ctx, path, quota), types.ErrRateLimitAlreadyExists)
+	s.Require().ErrorIs(s.keeper.AddRateLimit(s.Ctx, types.NewPath("ubar", channelID), quota), types.ErrZeroChannelValue)
+}
+
+func (s *KeeperTestSuite) TestSendPacket() {
+	path := s.addRateLimit("ufoo", 1000)
+
+	sequence, err := s.sendPacket("ufoo", "60")
+	s.Require().NoError(err)
+	_, err = s.sendPacket("ufoo", "41")
+	s.Require().ErrorIs(err, types.ErrQuotaExceeded)
+
+	// require other denoms not to be limited
+	_, err = s.sendPacket("ubar", "1000000")
+	s.Require().NoError(err)
+
+	// require the outflow of a failed packet to be reverted
+	data := transfertypes.NewFungibleTokenPacketData("ufoo", "60", "", "", "").GetBytes()
+	packet := channeltypes.NewPacket(data, sequence, transfertypes.PortID, channelID, transfertypes.PortID, counterpartyChannelID, clienttypes.ZeroHeight(), 0)
+	s.keeper.RevertSentPacket(s.Ctx, packet)
+
+	rateLimit, _ := s.keeper.GetRateLimit(s.Ctx, path.Denom, path.ChannelId)
+	s.Require().True(rateLimit.Flow.Outflow.IsZero())
+	_, found := s.keeper.GetPendingSendPacket(s.Ctx, channelID, sequence)
+	s.Require().False(found)
+
+	// require packets sent before a reset not to be reverted
+	sequence, err = s.sendPacket("ufoo", "100")
+	s.Require().NoError(err)
+	s.Require().Len(s.keeper.GetAllPendingSendPackets(s.Ctx), 1)
+
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(24 * time.Hour))
+	s.keeper.ResetExpiredRateLimits(s.Ctx)
+	s.Require().Empty(s.keeper.GetAllPendingSendPackets(s.Ctx))
+
+	_, err = s.sendPacket("ufoo", "100")
+	s.Require().NoError(err)
+	packet.Sequence = sequence
+	s.keeper.RevertSentPacket(s.Ctx, packet)
+	rateLimit, _ = s.keeper.GetRateLimit(s.Ctx, path.Denom, path.ChannelId)
+	s.Require().Equal(sdk.NewInt(100), rateLimit.Flow.Outflow)
+}
+
+func (s *KeeperTestSuite) TestReceivePacket() {
+	// vouchers of a counterparty denom
+	voucherDenom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(transfertypes.PortID, channelID, "uatom")).IBCDenom()
+	s.addRateLimit(voucherDenom, 1000)
+	s.Require().NoError(s.keeper.ReceivePacket(s.Ctx, s.recvPacket("uatom", "200")))
+	s.Require().ErrorIs(s.keeper.ReceivePacket(s.Ctx, s.recvPacket("uatom", "1")), types.ErrQuotaExceeded)
+
+	// native coins returning from the counterparty
+	s.addRateLimit("ufoo", 1000)
+	returning := transfertypes.GetPrefixedDenom(transfertypes.PortID, counterpartyChannelID, "ufoo")
+	s.Require().NoError(s.keeper.ReceivePacket(s.Ctx, s.recvPacket(returning, "200")))
+	s.Require().ErrorIs(s.keeper.ReceivePacket(s.Ctx, s.recvPacket(returning, "1")), types.ErrQuotaExceeded)
+
+	rateLimit, _ := s.keeper.GetRateLimit(s.Ctx, "ufoo", channelID)
+	s.Require().Equal(sdk.NewInt(200), rateLimit.Flow.Inflow)
+}
+
+func (s *KeeperTestSuite) TestOnRecvPacketErrorAcknowledgement() {
+	s.addRateLimit("ufoo", 1000)
+	middleware := ratelimit.NewIBCMiddleware(mockIBCModule{}, s.keeper)
+	returning := transfertypes.GetPrefixedDenom(transfertypes.PortID, counterpartyChannelID, "ufoo")
+
+	ack := middleware.OnRecvPacket(s.Ctx, s.recvPacket(returning, "200"), nil)
+	s.Require().True(ack.Success())
+
+	ack = middleware.OnRecvPacket(s.Ctx, s.recvPacket(returning, "1"), nil)
+	s.Require().False(ack.Success())
+}
+
+func (s *KeeperTestSuite) TestMsgServer() {
+	s.FundAcc(s.TestAccs[0], sdk.NewCoins(sdk.NewInt64Coin("ufoo", 1000)))
+	msgServer := keeper.NewMsgServerImpl(s.keeper)
+	goCtx := sdk.WrapSDKContext(s.Ctx)
+	path := types.NewPath("ufoo", channelID)
+	quota := types.NewQuota(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(1, 1), 24)
+
+	_, err := msgServer.AddRateLimit(goCtx, &types.MsgAddRateLimit{Authority: s.TestAccs[0].String(), Path: path, Quota: quota})
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	authority := s.keeper.GetAuthority()
+	_, err = msgServer.AddRateLimit(goCtx, &types.MsgAddRateLimit{Authority: authority, Path: path, Quota: quota})
+	s.Require().NoError(err)
+
+	_, err = s.sendPacket("ufoo", "100")
+	s.Require().NoError(err)
+
+	// require the update to replace the quota and reset the flow
+	quota.MaxSendRatio = sdk.NewDecWithPrec(5, 1)
+	_, err = msgServer.UpdateRateLimit(goCtx, &types.MsgUpdateRateLimit{Authority: authority, Path: path, Quota: quota})
+	s.Require().NoError(err)
+	rateLimit, _ := s.keeper.GetRateLimit(s.Ctx, path.Denom, path.ChannelId)
+	s.Require().Equal(quota, rateLimit.Quota)
+	s.Require().True(rateLimit.Flow.Outflow.IsZero())
+
+	_, err = msgServer.RemoveRateLimit(goCtx, &types.MsgRemoveRateLimit{Authority: authority, Path: path})
+	s.Require().NoError(err)
+	_, err = msgServer.ResetRateLimit(goCtx, &types.MsgResetRateLimit{Authority: authority, Path: path})
+	s.Require().ErrorIs(err, types.ErrRateLimitNotFound)
+}
+
+func (s *KeeperTestSuite) TestQueryRateLimit() {
+	path := s.addRateLimit("ufoo", 1000)
+	_, err := s.sendPacket("ufoo", "25")
+	s.Require().NoError(err)
+
+	querier := keeper.NewQuerier(s.keeper)
+	res, err := querier.RateLimit(sdk.WrapSDKContext(s.Ctx), &types.QueryRateLimitRequest{Denom: path.Denom, ChannelId: path.ChannelId})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDecWithPrec(25, 2), res.SendUsage)
+	s.Require().True(res.RecvUsage.IsZero())
+	s.Require().Equal(sdk.NewInt(75), res.RemainingSend)
+	s.Require().Equal(sdk.NewInt(225), res.RemainingRecv)
+
+	byChannel, err := querier.RateLimitsByChannel(sdk.WrapSDKContext(s.Ctx), &types.QueryRateLimitsByChannelRequest{ChannelId: "channel-1"})
+	s.Require().NoError(err)
+	s.Require().Empty(byChannel.RateLimits)
+
+	_, err = querier.RateLimit(sdk.WrapSDKContext(s.Ctx), &types.QueryRateLimitRequest{Denom: "ubar", ChannelId: path.ChannelId})
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestExportGenesis() {
+	s.addRateLimit("ufoo", 1000)
+	_, err := s.sendPacket("ufoo", "25")
+	s.Require().NoError(err)
+
+	genesis := s.keeper.ExportGenesis(s.Ctx)
+	s.Require().NoError(genesis.Validate())
+	s.Require().Len(genesis.RateLimits, 1)
+	s.Require().Len(genesis.PendingSendPackets, 1)
+}
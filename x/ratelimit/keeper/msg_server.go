@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/classic-terra/core/v3/x/ratelimit/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the ratelimit MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+func (k msgServer) AddRateLimit(goCtx context.Context, req *types.MsgAddRateLimit) (*types.MsgAddRateLimitResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.AddRateLimit(ctx, req.Path, req.Quota); err != nil {
+		return nil, err
+	}
+
+	emitRateLimitEvent(ctx, types.EventTypeAddRateLimit, req.Path)
+	return &types.MsgAddRateLimitResponse{}, nil
+}
+
+func (k msgServer) UpdateRateLimit(goCtx context.Context, req *types.MsgUpdateRateLimit) (*types.MsgUpdateRateLimitResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.UpdateRateLimit(ctx, req.Path, req.Quota); err != nil {
+		return nil, err
+	}
+
+	emitRateLimitEvent(ctx, types.EventTypeUpdateRateLimit, req.Path)
+	return &types.MsgUpdateRateLimitResponse{}, nil
+}
+
+func (k msgServer) RemoveRateLimit(goCtx context.Context, req *types.MsgRemoveRateLimit) (*types.MsgRemoveRateLimitResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.RemoveRateLimit(ctx, req.Path); err != nil {
+		return nil, err
+	}
+
+	emitRateLimitEvent(ctx, types.EventTypeRemoveRateLimit, req.Path)
+	return &types.MsgRemoveRateLimitResponse{}, nil
+}
+
+func (k msgServer) ResetRateLimit(goCtx context.Context, req *types.MsgResetRateLimit) (*types.MsgResetRateLimitResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.ResetRateLimit(ctx, req.Path); err != nil {
+		return nil, err
+	}
+
+	emitRateLimitEvent(ctx, types.EventTypeResetRateLimit, req.Path)
+	return &types.MsgResetRateLimitResponse{}, nil
+}
+
+func emitRateLimitEvent(ctx sdk.Context, eventType string, path types.Path) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyDenom, path.Denom),
+			sdk.NewAttribute(types.AttributeKeyChannel, path.ChannelId),
+		),
+	)
+}
@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+
+	"github.com/classic-terra/core/v3/x/ratelimit/types"
+)
+
+var _ porttypes.ICS4Wrapper = Keeper{}
+
+// SendPacket adds the amount of an outgoing transfer to the outflow of its
+// rate limit, failing if the send quota is exceeded, and sends the packet.
+func (k Keeper) SendPacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (uint64, error) {
+	packetData, amount, ok := parseTransferPacketData(data)
+	if !ok {
+		return k.ics4Wrapper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	}
+
+	// the packet denom is the full trace of the coins held on this chain
+	denom := transfertypes.ParseDenomTrace(packetData.Denom).IBCDenom()
+	rateLimit, found := k.GetRateLimit(ctx, denom, sourceChannel)
+	if found {
+		if err := rateLimit.AddOutflow(amount); err != nil {
+			return 0, err
+		}
+		k.SetRateLimit(ctx, rateLimit)
+	}
+
+	sequence, err := k.ics4Wrapper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	if err != nil {
+		return 0, err
+	}
+
+	if found {
+		k.SetPendingSendPacket(ctx, types.PendingSendPacket{ChannelId: sourceChannel, Sequence: sequence, Denom: denom})
+	}
+
+	return sequence, nil
+}
+
+// WriteAcknowledgement implements the ICS4Wrapper interface
+func (k Keeper) WriteAcknowledgement(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI, ack ibcexported.Acknowledgement) error {
+	return k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
+
+// GetAppVersion implements the ICS4Wrapper interface
+func (k Keeper) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return k.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
+
+// ReceivePacket adds the amount of an incoming transfer to the inflow of its
+// rate limit, failing if the recv quota is exceeded.
+func (k Keeper) ReceivePacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	packetData, amount, ok := parseTransferPacketData(packet.GetData())
+	if !ok {
+		return nil
+	}
+
+	// the denom of the vouchers minted or the coins unescrowed on this chain
+	var denomPath string
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), packetData.Denom) {
+		denomPath = packetData.Denom[len(transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())):]
+	} else {
+		denomPath = transfertypes.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel()) + packetData.Denom
+	}
+
+	denom := transfertypes.ParseDenomTrace(denomPath).IBCDenom()
+	rateLimit, found := k.GetRateLimit(ctx, denom, packet.GetDestChannel())
+	if !found {
+		return nil
+	}
+
+	if err := rateLimit.AddInflow(amount); err != nil {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeTransferDenied,
+				sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+				sdk.NewAttribute(types.AttributeKeyAction, types.AttributeValueRecv),
+				sdk.NewAttribute(types.AttributeKeyDenom, denom),
+				sdk.NewAttribute(types.AttributeKeyChannel, packet.GetDestChannel()),
+				sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			),
+		)
+		return err
+	}
+
+	k.SetRateLimit(ctx, rateLimit)
+	return nil
+}
+
+// RevertSentPacket removes the amount of a failed or timed out transfer from
+// the outflow of its rate limit if it was sent during the current window.
+func (k Keeper) RevertSentPacket(ctx sdk.Context, packet channeltypes.Packet) {
+	pending, found := k.GetPendingSendPacket(ctx, packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		return
+	}
+	k.DeletePendingSendPacket(ctx, pending.ChannelId, pending.Sequence)
+
+	_, amount, ok := parseTransferPacketData(packet.GetData())
+	if !ok {
+		return
+	}
+
+	rateLimit, found := k.GetRateLimit(ctx, pending.Denom, pending.ChannelId)
+	if !found {
+		return
+	}
+
+	rateLimit.RevertOutflow(amount)
+	k.SetRateLimit(ctx, rateLimit)
+}
+
+// CompleteSentPacket removes the pending send packet of a successful transfer
+func (k Keeper) CompleteSentPacket(ctx sdk.Context, packet channeltypes.Packet) {
+	k.DeletePendingSendPacket(ctx, packet.GetSourceChannel(), packet.GetSequence())
+}
+
+func parseTransferPacketData(data []byte) (packetData transfertypes.FungibleTokenPacketData, amount sdk.Int, ok bool) {
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(data, &packetData); err != nil {
+		return packetData, amount, false
+	}
+
+	amount, ok = sdk.NewIntFromString(packetData.Amount)
+	return packetData, amount, ok
+}
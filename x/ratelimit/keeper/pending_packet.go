@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/classic-terra/core/v3/x/ratelimit/types"
+)
+
+// SetPendingSendPacket stores a packet sent during the current window of its
+// rate limit
+func (k Keeper) SetPendingSendPacket(ctx sdk.Context, packet types.PendingSendPacket) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&packet)
+	store.Set(types.GetPendingSendPacketKey(packet.ChannelId, packet.Sequence), bz)
+}
+
+// GetPendingSendPacket returns the pending send packet of a channel and sequence
+func (k Keeper) GetPendingSendPacket(ctx sdk.Context, channelID string, sequence uint64) (packet types.PendingSendPacket, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPendingSendPacketKey(channelID, sequence))
+	if bz == nil {
+		return packet, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &packet)
+	return packet, true
+}
+
+// DeletePendingSendPacket removes the pending send packet of a channel and sequence
+func (k Keeper) DeletePendingSendPacket(ctx sdk.Context, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPendingSendPacketKey(channelID, sequence))
+}
+
+// DeletePendingSendPackets removes the pending send packets of a path
+func (k Keeper) DeletePendingSendPackets(ctx sdk.Context, path types.Path) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetPendingSendPacketChannelPrefix(path.ChannelId))
+	defer iter.Close()
+
+	keys := [][]byte{}
+	for ; iter.Valid(); iter.Next() {
+		var packet types.PendingSendPacket
+		k.cdc.MustUnmarshal(iter.Value(), &packet)
+		if packet.Denom == path.Denom {
+			keys = append(keys, iter.Key())
+		}
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetAllPendingSendPackets returns all pending send packets
+func (k Keeper) GetAllPendingSendPackets(ctx sdk.Context) []types.PendingSendPacket {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.PendingSendPacketKeyPrefix)
+	defer iter.Close()
+
+	packets := []types.PendingSendPacket{}
+	for ; iter.Valid(); iter.Next() {
+		var packet types.PendingSendPacket
+		k.cdc.MustUnmarshal(iter.Value(), &packet)
+		packets = append(packets, packet)
+	}
+
+	return packets
+}
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/classic-terra/core/v3/x/ratelimit/types"
+)
+
+// querier is used as Keeper will have duplicate methods if used directly, and gRPC names take precedence over q
+type querier struct {
+	Keeper
+}
+
+// NewQuerier returns an implementation of the ratelimit QueryServer interface
+// for the provided Keeper.
+func NewQuerier(keeper Keeper) types.QueryServer {
+	return &querier{Keeper: keeper}
+}
+
+var _ types.QueryServer = querier{}
+
+// RateLimits queries all rate limits
+func (q querier) RateLimits(c context.Context, _ *types.QueryRateLimitsRequest) (*types.QueryRateLimitsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryRateLimitsResponse{RateLimits: q.GetAllRateLimits(ctx)}, nil
+}
+
+// RateLimitsByChannel queries the rate limits of a channel
+func (q querier) RateLimitsByChannel(c context.Context, req *types.QueryRateLimitsByChannelRequest) (*types.QueryRateLimitsByChannelResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryRateLimitsByChannelResponse{RateLimits: q.GetRateLimitsByChannel(ctx, req.ChannelId)}, nil
+}
+
+// RateLimit queries the rate limit of a denom and channel with its quota usage
+func (q querier) RateLimit(c context.Context, req *types.QueryRateLimitRequest) (*types.QueryRateLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	rateLimit, found := q.GetRateLimit(ctx, req.Denom, req.ChannelId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no rate limit of %s over %s", req.Denom, req.ChannelId)
+	}
+
+	sendThreshold, recvThreshold := rateLimit.SendThreshold(), rateLimit.RecvThreshold()
+	netOutflow, netInflow := rateLimit.Flow.NetOutflow(), rateLimit.Flow.NetInflow()
+
+	return &types.QueryRateLimitResponse{
+		RateLimit:     rateLimit,
+		SendUsage:     types.Usage(netOutflow, sendThreshold),
+		RecvUsage:     types.Usage(netInflow, recvThreshold),
+		RemainingSend: sdk.MaxInt(sendThreshold.Sub(rateLimit.Flow.Outflow.Sub(rateLimit.Flow.Inflow)), sdk.ZeroInt()),
+		RemainingRecv: sdk.MaxInt(recvThreshold.Sub(rateLimit.Flow.Inflow.Sub(rateLimit.Flow.Outflow)), sdk.ZeroInt()),
+	}, nil
+}
@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/classic-terra/core/v3/x/ratelimit/types"
+)
+
+// SetRateLimit stores a rate limit
+func (k Keeper) SetRateLimit(ctx sdk.Context, rateLimit types.RateLimit) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&rateLimit)
+	store.Set(types.GetRateLimitKey(rateLimit.Path.ChannelId, rateLimit.Path.Denom), bz)
+}
+
+// GetRateLimit returns the rate limit of a denom and channel
+func (k Keeper) GetRateLimit(ctx sdk.Context, denom, channelID string) (rateLimit types.RateLimit, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetRateLimitKey(channelID, denom))
+	if bz == nil {
+		return rateLimit, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &rateLimit)
+	return rateLimit, true
+}
+
+// DeleteRateLimit removes the rate limit of a denom and channel
+func (k Keeper) DeleteRateLimit(ctx sdk.Context, denom, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetRateLimitKey(channelID, denom))
+}
+
+// IterateRateLimits iterates over the rate limits stored under prefix
+func (k Keeper) IterateRateLimits(ctx sdk.Context, prefix []byte, handler func(rateLimit types.RateLimit) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var rateLimit types.RateLimit
+		k.cdc.MustUnmarshal(iter.Value(), &rateLimit)
+		if handler(rateLimit) {
+			break
+		}
+	}
+}
+
+// GetAllRateLimits returns all rate limits
+func (k Keeper) GetAllRateLimits(ctx sdk.Context) []types.RateLimit {
+	rateLimits := []types.RateLimit{}
+	k.IterateRateLimits(ctx, types.RateLimitKeyPrefix, func(rateLimit types.RateLimit) bool {
+		rateLimits = append(rateLimits, rateLimit)
+		return false
+	})
+
+	return rateLimits
+}
+
+// GetRateLimitsByChannel returns the rate limits of a channel
+func (k Keeper) GetRateLimitsByChannel(ctx sdk.Context, channelID string) []types.RateLimit {
+	rateLimits := []types.RateLimit{}
+	k.IterateRateLimits(ctx, types.GetRateLimitChannelPrefix(channelID), func(rateLimit types.RateLimit) bool {
+		rateLimits = append(rateLimits, rateLimit)
+		return false
+	})
+
+	return rateLimits
+}
+
+// AddRateLimit adds a rate limit to a path, starting a window at the current
+// block time
+func (k Keeper) AddRateLimit(ctx sdk.Context, path types.Path, quota types.Quota) error {
+	if _, found := k.GetRateLimit(ctx, path.Denom, path.ChannelId); found {
+		return types.ErrRateLimitAlreadyExists.Wrapf("%s over %s", path.Denom, path.ChannelId)
+	}
+
+	channelValue := k.bankKeeper.GetSupply(ctx, path.Denom).Amount
+	if channelValue.IsZero() {
+		return types.ErrZeroChannelValue.Wrapf("no supply of %s", path.Denom)
+	}
+
+	k.SetRateLimit(ctx, types.NewRateLimit(path, quota, channelValue, ctx.BlockTime()))
+	return nil
+}
+
+// UpdateRateLimit replaces the quota of a rate limit and resets its flow
+func (k Keeper) UpdateRateLimit(ctx sdk.Context, path types.Path, quota types.Quota) error {
+	rateLimit, found := k.GetRateLimit(ctx, path.Denom, path.ChannelId)
+	if !found {
+		return types.ErrRateLimitNotFound.Wrapf("%s over %s", path.Denom, path.ChannelId)
+	}
+
+	rateLimit.Quota = quota
+	k.SetRateLimit(ctx, rateLimit)
+
+	return k.ResetRateLimit(ctx, path)
+}
+
+// RemoveRateLimit removes a rate limit and its pending send packets
+func (k Keeper) RemoveRateLimit(ctx sdk.Context, path types.Path) error {
+	if _, found := k.GetRateLimit(ctx, path.Denom, path.ChannelId); !found {
+		return types.ErrRateLimitNotFound.Wrapf("%s over %s", path.Denom, path.ChannelId)
+	}
+
+	k.DeleteRateLimit(ctx, path.Denom, path.ChannelId)
+	k.DeletePendingSendPackets(ctx, path)
+	return nil
+}
+
+// ResetRateLimit clears the flow of a rate limit and starts a new window at
+// the current block time with the current supply as channel value. The
+// packets sent during the previous window no longer revert the outflow.
+func (k Keeper) ResetRateLimit(ctx sdk.Context, path types.Path) error {
+	rateLimit, found := k.GetRateLimit(ctx, path.Denom, path.ChannelId)
+	if !found {
+		return types.ErrRateLimitNotFound.Wrapf("%s over %s", path.Denom, path.ChannelId)
+	}
+
+	rateLimit.Flow = types.NewFlow(k.bankKeeper.GetSupply(ctx, path.Denom).Amount)
+	rateLimit.WindowStart = ctx.BlockTime()
+	k.SetRateLimit(ctx, rateLimit)
+	k.DeletePendingSendPackets(ctx, path)
+
+	return nil
+}
+
+// ResetExpiredRateLimits resets the rate limits whose window ended
+func (k Keeper) ResetExpiredRateLimits(ctx sdk.Context) {
+	expired := []types.Path{}
+	k.IterateRateLimits(ctx, types.RateLimitKeyPrefix, func(rateLimit types.RateLimit) bool {
+		if rateLimit.IsWindowExpired(ctx.BlockTime()) {
+			expired = append(expired, rateLimit.Path)
+		}
+		return false
+	})
+
+	for _, path := range expired {
+		if err := k.ResetRateLimit(ctx, path); err != nil {
+			k.Logger(ctx).Error("failed to reset rate limit", "denom", path.Denom, "channel", path.ChannelId, "err", err)
+		}
+	}
+}
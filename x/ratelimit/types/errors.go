@@ -0,0 +1,14 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// Ratelimit module sentinel errors
+var (
+	ErrRateLimitNotFound      = errorsmod.Register(ModuleName, 2, "rate limit not found")
+	ErrRateLimitAlreadyExists = errorsmod.Register(ModuleName, 3, "rate limit already exists")
+	ErrInvalidQuota           = errorsmod.Register(ModuleName, 4, "invalid quota")
+	ErrZeroChannelValue       = errorsmod.Register(ModuleName, 5, "channel value is zero")
+	ErrQuotaExceeded          = errorsmod.Register(ModuleName, 6, "quota exceeded")
+)
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"fmt"
+)
+
+// DefaultGenesisState returns the default ratelimit genesis state.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		RateLimits:         []RateLimit{},
+		PendingSendPackets: []PendingSendPacket{},
+	}
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(rateLimits []RateLimit, pendingSendPackets []PendingSendPacket) *GenesisState {
+	return &GenesisState{
+		RateLimits:         rateLimits,
+		PendingSendPackets: pendingSendPackets,
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	paths := make(map[Path]bool, len(gs.RateLimits))
+	for _, rateLimit := range gs.RateLimits {
+		if err := rateLimit.Validate(); err != nil {
+			return err
+		}
+
+		if paths[rateLimit.Path] {
+			return fmt.Errorf("duplicate rate limit of %s over %s", rateLimit.Path.Denom, rateLimit.Path.ChannelId)
+		}
+		paths[rateLimit.Path] = true
+	}
+
+	for _, packet := range gs.PendingSendPackets {
+		if !paths[NewPath(packet.Denom, packet.ChannelId)] {
+			return fmt.Errorf("pending send packet %d over %s has no rate limit", packet.Sequence, packet.ChannelId)
+		}
+	}
+
+	return nil
+}
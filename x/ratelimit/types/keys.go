@@ -0,0 +1,53 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	ModuleName = "ratelimit"
+	StoreKey   = ModuleName
+
+	RouterKey = ModuleName
+
+	EventTypeTransferDenied  = "transfer_denied"
+	EventTypeAddRateLimit    = "add_rate_limit"
+	EventTypeUpdateRateLimit = "update_rate_limit"
+	EventTypeRemoveRateLimit = "remove_rate_limit"
+	EventTypeResetRateLimit  = "reset_rate_limit"
+	AttributeKeyReason       = "reason"
+	AttributeKeyAction       = "action"
+	AttributeKeyDenom        = "denom"
+	AttributeKeyChannel      = "channel"
+	AttributeKeyAmount       = "amount"
+	AttributeValueSend       = "send"
+	AttributeValueRecv       = "recv"
+)
+
+// Key defines the store key for ratelimit.
+var (
+	RateLimitKeyPrefix         = []byte{0x1}
+	PendingSendPacketKeyPrefix = []byte{0x2}
+)
+
+// GetRateLimitChannelPrefix returns the store prefix of the rate limits of a channel.
+func GetRateLimitChannelPrefix(channelID string) []byte {
+	return append(RateLimitKeyPrefix, address.MustLengthPrefix([]byte(channelID))...)
+}
+
+// GetRateLimitKey returns the store key of the rate limit of a denom and channel.
+func GetRateLimitKey(channelID, denom string) []byte {
+	return append(GetRateLimitChannelPrefix(channelID), []byte(denom)...)
+}
+
+// GetPendingSendPacketChannelPrefix returns the store prefix of the pending
+// send packets of a channel.
+func GetPendingSendPacketChannelPrefix(channelID string) []byte {
+	return append(PendingSendPacketKeyPrefix, address.MustLengthPrefix([]byte(channelID))...)
+}
+
+// GetPendingSendPacketKey returns the store key of a pending send packet.
+func GetPendingSendPacketKey(channelID string, sequence uint64) []byte {
+	return append(GetPendingSendPacketChannelPrefix(channelID), sdk.Uint64ToBigEndian(sequence)...)
+}
@@ -0,0 +1,101 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	TypeMsgAddRateLimit    = "add_rate_limit"
+	TypeMsgUpdateRateLimit = "update_rate_limit"
+	TypeMsgRemoveRateLimit = "remove_rate_limit"
+	TypeMsgResetRateLimit  = "reset_rate_limit"
+)
+
+var (
+	_ sdk.Msg = &MsgAddRateLimit{}
+	_ sdk.Msg = &MsgUpdateRateLimit{}
+	_ sdk.Msg = &MsgRemoveRateLimit{}
+	_ sdk.Msg = &MsgResetRateLimit{}
+)
+
+func (msg MsgAddRateLimit) Route() string { return ModuleName }
+func (msg MsgAddRateLimit) Type() string  { return TypeMsgAddRateLimit }
+func (msg MsgAddRateLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return err
+	}
+	if err := msg.Path.Validate(); err != nil {
+		return err
+	}
+
+	return msg.Quota.Validate()
+}
+
+func (msg MsgAddRateLimit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgAddRateLimit) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+func (msg MsgUpdateRateLimit) Route() string { return ModuleName }
+func (msg MsgUpdateRateLimit) Type() string  { return TypeMsgUpdateRateLimit }
+func (msg MsgUpdateRateLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return err
+	}
+	if err := msg.Path.Validate(); err != nil {
+		return err
+	}
+
+	return msg.Quota.Validate()
+}
+
+func (msg MsgUpdateRateLimit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgUpdateRateLimit) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+func (msg MsgRemoveRateLimit) Route() string { return ModuleName }
+func (msg MsgRemoveRateLimit) Type() string  { return TypeMsgRemoveRateLimit }
+func (msg MsgRemoveRateLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return err
+	}
+
+	return msg.Path.Validate()
+}
+
+func (msg MsgRemoveRateLimit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgRemoveRateLimit) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+func (msg MsgResetRateLimit) Route() string { return ModuleName }
+func (msg MsgResetRateLimit) Type() string  { return TypeMsgResetRateLimit }
+func (msg MsgResetRateLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return err
+	}
+
+	return msg.Path.Validate()
+}
+
+func (msg MsgResetRateLimit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgResetRateLimit) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
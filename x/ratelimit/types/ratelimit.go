@@ -0,0 +1,156 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+// NewPath returns a new Path instance
+func NewPath(denom, channelID string) Path {
+	return Path{Denom: denom, ChannelId: channelID}
+}
+
+// Validate checks that the denom and channel id are valid
+func (p Path) Validate() error {
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return err
+	}
+
+	return host.ChannelIdentifierValidator(p.ChannelId)
+}
+
+// NewQuota returns a new Quota instance
+func NewQuota(maxSendRatio, maxRecvRatio sdk.Dec, durationHours uint64) Quota {
+	return Quota{MaxSendRatio: maxSendRatio, MaxRecvRatio: maxRecvRatio, DurationHours: durationHours}
+}
+
+// Validate checks that the ratios are within [0, 1] and the duration is
+// positive. A zero ratio blocks the transfers in that direction, so at least
+// one of the ratios must be positive.
+func (q Quota) Validate() error {
+	if q.MaxSendRatio.IsNil() || q.MaxSendRatio.IsNegative() || q.MaxSendRatio.GT(sdk.OneDec()) {
+		return ErrInvalidQuota.Wrapf("max send ratio must be within [0, 1], got %s", q.MaxSendRatio)
+	}
+	if q.MaxRecvRatio.IsNil() || q.MaxRecvRatio.IsNegative() || q.MaxRecvRatio.GT(sdk.OneDec()) {
+		return ErrInvalidQuota.Wrapf("max recv ratio must be within [0, 1], got %s", q.MaxRecvRatio)
+	}
+	if q.MaxSendRatio.IsZero() && q.MaxRecvRatio.IsZero() {
+		return ErrInvalidQuota.Wrap("max send and recv ratios cannot both be zero")
+	}
+	if q.DurationHours == 0 {
+		return ErrInvalidQuota.Wrap("duration must be positive")
+	}
+
+	return nil
+}
+
+// Duration returns the length of a window
+func (q Quota) Duration() time.Duration {
+	return time.Duration(q.DurationHours) * time.Hour
+}
+
+// NewFlow returns a new Flow instance with no inflow and outflow
+func NewFlow(channelValue sdk.Int) Flow {
+	return Flow{Inflow: sdk.ZeroInt(), Outflow: sdk.ZeroInt(), ChannelValue: channelValue}
+}
+
+// NetOutflow returns the outflow minus the inflow, or zero if negative
+func (f Flow) NetOutflow() sdk.Int {
+	return sdk.MaxInt(f.Outflow.Sub(f.Inflow), sdk.ZeroInt())
+}
+
+// NetInflow returns the inflow minus the outflow, or zero if negative
+func (f Flow) NetInflow() sdk.Int {
+	return sdk.MaxInt(f.Inflow.Sub(f.Outflow), sdk.ZeroInt())
+}
+
+// NewRateLimit returns a new RateLimit instance starting a window at blockTime
+func NewRateLimit(path Path, quota Quota, channelValue sdk.Int, blockTime time.Time) RateLimit {
+	return RateLimit{
+		Path:        path,
+		Quota:       quota,
+		Flow:        NewFlow(channelValue),
+		WindowStart: blockTime,
+	}
+}
+
+// SendThreshold returns the maximum net outflow of the window
+func (rl RateLimit) SendThreshold() sdk.Int {
+	return sdk.NewDecFromInt(rl.Flow.ChannelValue).Mul(rl.Quota.MaxSendRatio).TruncateInt()
+}
+
+// RecvThreshold returns the maximum net inflow of the window
+func (rl RateLimit) RecvThreshold() sdk.Int {
+	return sdk.NewDecFromInt(rl.Flow.ChannelValue).Mul(rl.Quota.MaxRecvRatio).TruncateInt()
+}
+
+// IsWindowExpired returns true if the window of the rate limit ended at blockTime
+func (rl RateLimit) IsWindowExpired(blockTime time.Time) bool {
+	return !blockTime.Before(rl.WindowStart.Add(rl.Quota.Duration()))
+}
+
+// AddOutflow adds amount to the outflow, failing if the net outflow exceeds
+// the send quota
+func (rl *RateLimit) AddOutflow(amount sdk.Int) error {
+	outflow := rl.Flow.Outflow.Add(amount)
+	netOutflow := outflow.Sub(rl.Flow.Inflow)
+	if threshold := rl.SendThreshold(); netOutflow.GT(threshold) {
+		return ErrQuotaExceeded.Wrapf(
+			"outflow of %s%s over %s would exceed the send threshold of %s, try again after %s",
+			amount, rl.Path.Denom, rl.Path.ChannelId, threshold, rl.WindowStart.Add(rl.Quota.Duration()).UTC().Format(time.RFC3339),
+		)
+	}
+
+	rl.Flow.Outflow = outflow
+	return nil
+}
+
+// AddInflow adds amount to the inflow, failing if the net inflow exceeds the
+// recv quota
+func (rl *RateLimit) AddInflow(amount sdk.Int) error {
+	inflow := rl.Flow.Inflow.Add(amount)
+	netInflow := inflow.Sub(rl.Flow.Outflow)
+	if threshold := rl.RecvThreshold(); netInflow.GT(threshold) {
+		return ErrQuotaExceeded.Wrapf(
+			"inflow of %s%s over %s would exceed the recv threshold of %s, try again after %s",
+			amount, rl.Path.Denom, rl.Path.ChannelId, threshold, rl.WindowStart.Add(rl.Quota.Duration()).UTC().Format(time.RFC3339),
+		)
+	}
+
+	rl.Flow.Inflow = inflow
+	return nil
+}
+
+// RevertOutflow removes amount from the outflow of a packet which failed
+func (rl *RateLimit) RevertOutflow(amount sdk.Int) {
+	rl.Flow.Outflow = sdk.MaxInt(rl.Flow.Outflow.Sub(amount), sdk.ZeroInt())
+}
+
+// Validate performs basic validation of the rate limit
+func (rl RateLimit) Validate() error {
+	if err := rl.Path.Validate(); err != nil {
+		return err
+	}
+	if err := rl.Quota.Validate(); err != nil {
+		return err
+	}
+	if rl.Flow.Inflow.IsNil() || rl.Flow.Inflow.IsNegative() ||
+		rl.Flow.Outflow.IsNil() || rl.Flow.Outflow.IsNegative() ||
+		rl.Flow.ChannelValue.IsNil() || rl.Flow.ChannelValue.IsNegative() {
+		return fmt.Errorf("invalid flow of %s over %s: %s", rl.Path.Denom, rl.Path.ChannelId, rl.Flow.String())
+	}
+
+	return nil
+}
+
+// Usage returns the used ratio of a quota given the net flow and the threshold
+func Usage(netFlow, threshold sdk.Int) sdk.Dec {
+	if !threshold.IsPositive() {
+		return sdk.OneDec()
+	}
+
+	return sdk.NewDecFromInt(netFlow).QuoInt(threshold)
+}
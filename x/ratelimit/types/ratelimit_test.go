@@ -0,0 +1,71 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/classic-terra/core/v3/x/ratelimit/types"
+)
+
+func TestQuotaValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		quota   types.Quota
+		expPass bool
+	}{
+		{"valid", types.NewQuota(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(2, 1), 24), true},
+		{"send blocked", types.NewQuota(sdk.ZeroDec(), sdk.OneDec(), 1), true},
+		{"both blocked", types.NewQuota(sdk.ZeroDec(), sdk.ZeroDec(), 1), false},
+		{"negative ratio", types.NewQuota(sdk.NewDec(-1), sdk.OneDec(), 1), false},
+		{"ratio above one", types.NewQuota(sdk.OneDec(), sdk.NewDec(2), 1), false},
+		{"nil ratio", types.Quota{MaxRecvRatio: sdk.OneDec(), DurationHours: 1}, false},
+		{"zero duration", types.NewQuota(sdk.OneDec(), sdk.OneDec(), 0), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.quota.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestRateLimitFlow(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	rateLimit := types.NewRateLimit(
+		types.NewPath("uluna", "channel-0"),
+		types.NewQuota(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(2, 1), 24),
+		sdk.NewInt(1000),
+		now,
+	)
+	require.NoError(t, rateLimit.Validate())
+	require.Equal(t, sdk.NewInt(100), rateLimit.SendThreshold())
+	require.Equal(t, sdk.NewInt(200), rateLimit.RecvThreshold())
+
+	// require the net outflow to be capped
+	require.NoError(t, rateLimit.AddOutflow(sdk.NewInt(60)))
+	require.ErrorIs(t, rateLimit.AddOutflow(sdk.NewInt(41)), types.ErrQuotaExceeded)
+	require.NoError(t, rateLimit.AddOutflow(sdk.NewInt(40)))
+	require.Equal(t, sdk.NewInt(100), rateLimit.Flow.Outflow)
+
+	// require the inflow to be netted against the outflow
+	require.NoError(t, rateLimit.AddInflow(sdk.NewInt(300)))
+	require.ErrorIs(t, rateLimit.AddInflow(sdk.NewInt(1)), types.ErrQuotaExceeded)
+	require.Equal(t, sdk.NewInt(200), rateLimit.Flow.NetInflow())
+	require.True(t, rateLimit.Flow.NetOutflow().IsZero())
+	require.NoError(t, rateLimit.AddOutflow(sdk.NewInt(300)))
+
+	rateLimit.RevertOutflow(sdk.NewInt(1000))
+	require.True(t, rateLimit.Flow.Outflow.IsZero())
+
+	require.False(t, rateLimit.IsWindowExpired(now.Add(23*time.Hour)))
+	require.True(t, rateLimit.IsWindowExpired(now.Add(24*time.Hour)))
+}
@@ -0,0 +1,182 @@
+package keeper
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+
+	"github.com/classic-terra/core/v3/x/stargate/types"
+)
+
+type Keeper struct {
+	storeKey storetypes.StoreKey
+	cdc      codec.BinaryCodec
+
+	interfaceRegistry codectypes.InterfaceRegistry
+	queryRouter       types.QueryRouter
+
+	// the address capable of changing the whitelist. Typically, this should be
+	// the x/gov module account.
+	authority string
+}
+
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey storetypes.StoreKey,
+	interfaceRegistry codectypes.InterfaceRegistry,
+	queryRouter types.QueryRouter,
+	authority string,
+) Keeper {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(fmt.Errorf("invalid stargate authority address: %w", err))
+	}
+
+	return Keeper{
+		cdc:               cdc,
+		storeKey:          storeKey,
+		interfaceRegistry: interfaceRegistry,
+		queryRouter:       queryRouter,
+		authority:         authority,
+	}
+}
+
+// InitGenesis initializes the stargate module's state from a provided genesis
+// state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	if err := genState.Validate(); err != nil {
+		panic(err)
+	}
+
+	for _, q := range genState.WhitelistedQueries {
+		if err := k.SetWhitelistedQuery(ctx, q); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis returns the stargate module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return &types.GenesisState{
+		WhitelistedQueries: k.GetWhitelistedQueries(ctx),
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetAuthority returns the x/stargate module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// SetWhitelistedQuery adds or updates a whitelisted query. The response type
+// must be proto registered and the path must be routable.
+func (k Keeper) SetWhitelistedQuery(ctx sdk.Context, q types.WhitelistedQuery) error {
+	if err := q.Validate(); err != nil {
+		return err
+	}
+
+	if _, err := k.resolveResponseType(q.ResponseTypeUrl); err != nil {
+		return err
+	}
+
+	if k.queryRouter.Route(q.Path) == nil {
+		return errorsmod.Wrapf(types.ErrInvalidQueryPath, "no route to query %s", q.Path)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetWhitelistedQueryKey(q.Path), k.cdc.MustMarshal(&q))
+	return nil
+}
+
+// GetWhitelistedQuery returns the whitelisted query at path.
+func (k Keeper) GetWhitelistedQuery(ctx sdk.Context, path string) (types.WhitelistedQuery, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetWhitelistedQueryKey(path))
+	if bz == nil {
+		return types.WhitelistedQuery{}, false
+	}
+
+	var q types.WhitelistedQuery
+	k.cdc.MustUnmarshal(bz, &q)
+	return q, true
+}
+
+// DeleteWhitelistedQuery removes the whitelisted query at path.
+func (k Keeper) DeleteWhitelistedQuery(ctx sdk.Context, path string) error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetWhitelistedQueryKey(path)
+	if !store.Has(key) {
+		return errorsmod.Wrap(types.ErrQueryNotWhitelisted, path)
+	}
+
+	store.Delete(key)
+	return nil
+}
+
+// IterateWhitelistedQueries iterates over the whitelisted queries ordered by
+// path, it stops when the handler returns true.
+func (k Keeper) IterateWhitelistedQueries(ctx sdk.Context, handler func(q types.WhitelistedQuery) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.WhitelistedQueryKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var q types.WhitelistedQuery
+		k.cdc.MustUnmarshal(iter.Value(), &q)
+		if handler(q) {
+			break
+		}
+	}
+}
+
+// GetWhitelistedQueries returns all whitelisted queries ordered by path.
+func (k Keeper) GetWhitelistedQueries(ctx sdk.Context) []types.WhitelistedQuery {
+	queries := []types.WhitelistedQuery{}
+	k.IterateWhitelistedQueries(ctx, func(q types.WhitelistedQuery) bool {
+		queries = append(queries, q)
+		return false
+	})
+
+	return queries
+}
+
+// GetWhitelistedQueryResponse returns a new instance of the response type of
+// the whitelisted query at path.
+func (k Keeper) GetWhitelistedQueryResponse(ctx sdk.Context, path string) (codec.ProtoMarshaler, error) {
+	q, ok := k.GetWhitelistedQuery(ctx, path)
+	if !ok {
+		return nil, errorsmod.Wrap(types.ErrQueryNotWhitelisted, path)
+	}
+
+	return k.resolveResponseType(q.ResponseTypeUrl)
+}
+
+// resolveResponseType returns a new instance of the message registered at
+// typeURL. Query responses are usually not registered as interface
+// implementations, so the global proto registry is used as fallback.
+func (k Keeper) resolveResponseType(typeURL string) (codec.ProtoMarshaler, error) {
+	var msg gogoproto.Message
+	if resolved, err := k.interfaceRegistry.Resolve(typeURL); err == nil {
+		msg = resolved
+	} else if t := gogoproto.MessageType(strings.TrimPrefix(typeURL, "/")); t != nil && t.Kind() == reflect.Ptr {
+		msg, _ = reflect.New(t.Elem()).Interface().(gogoproto.Message)
+	}
+
+	pm, ok := msg.(codec.ProtoMarshaler)
+	if !ok {
+		return nil, errorsmod.Wrapf(types.ErrInvalidResponseType, "%s is not a registered proto message", typeURL)
+	}
+
+	return pm, nil
+}
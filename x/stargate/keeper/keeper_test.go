@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	markettypes "github.com/classic-terra/core/v3/x/market/types"
+	"github.com/classic-terra/core/v3/x/stargate/types"
+
+	// register the response types of the default whitelist
+	_ "github.com/classic-terra/core/v3/x/oracle/types"
+	_ "github.com/classic-terra/core/v3/x/treasury/types"
+)
+
+type testQueryRouter map[string]bool
+
+func (r testQueryRouter) Route(path string) baseapp.GRPCQueryHandler {
+	if !r[path] {
+		return nil
+	}
+
+	return func(sdk.Context, abci.RequestQuery) (abci.ResponseQuery, error) { return abci.ResponseQuery{}, nil }
+}
+
+func createTestKeeper(t *testing.T) (sdk.Context, Keeper) {
+	t.Helper()
+
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	router := testQueryRouter{}
+	for _, q := range types.DefaultWhitelistedQueries() {
+		router[q.Path] = true
+	}
+	router["/terra.market.v1beta1.Query/Params"] = true
+	router["/terra.mempool.v1beta1.Query/PendingTxs"] = true
+
+	registry := codectypes.NewInterfaceRegistry()
+	k := NewKeeper(
+		codec.NewProtoCodec(registry),
+		key,
+		registry,
+		router,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	ctx := sdk.NewContext(ms, tmproto.Header{}, false, log.NewNopLogger())
+	return ctx, k
+}
+
+func TestGenesis(t *testing.T) {
+	ctx, k := createTestKeeper(t)
+
+	k.InitGenesis(ctx, types.DefaultGenesisState())
+	exported := k.ExportGenesis(ctx)
+	require.NoError(t, exported.Validate())
+	require.ElementsMatch(t, types.DefaultWhitelistedQueries(), exported.WhitelistedQueries)
+
+	res, err := k.GetWhitelistedQueryResponse(ctx, "/terra.market.v1beta1.Query/Swap")
+	require.NoError(t, err)
+	require.IsType(t, &markettypes.QuerySwapResponse{}, res)
+}
+
+func TestMsgServer(t *testing.T) {
+	ctx, k := createTestKeeper(t)
+	msgServer := NewMsgServerImpl(k)
+	goCtx := sdk.WrapSDKContext(ctx)
+	path := "/terra.market.v1beta1.Query/Params"
+
+	// only the authority may change the whitelist
+	_, err := msgServer.AddWhitelistedQuery(goCtx, types.NewMsgAddWhitelistedQuery(
+		authtypes.NewModuleAddress(types.ModuleName).String(), path, "/terra.market.v1beta1.QueryParamsResponse"))
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	// the response type must be proto registered
+	_, err = msgServer.AddWhitelistedQuery(goCtx, types.NewMsgAddWhitelistedQuery(
+		k.GetAuthority(), path, "/terra.market.v1beta1.Unknown"))
+	require.ErrorIs(t, err, types.ErrInvalidResponseType)
+
+	// the path must be routable
+	_, err = msgServer.AddWhitelistedQuery(goCtx, types.NewMsgAddWhitelistedQuery(
+		k.GetAuthority(), "/terra.market.v1beta1.Query/Unknown", "/terra.market.v1beta1.QueryParamsResponse"))
+	require.ErrorIs(t, err, types.ErrInvalidQueryPath)
+
+	// node-local services are rejected even if the node routes them
+	_, err = msgServer.AddWhitelistedQuery(goCtx, types.NewMsgAddWhitelistedQuery(
+		k.GetAuthority(), "/terra.mempool.v1beta1.Query/PendingTxs", "/terra.mempool.v1beta1.QueryPendingTxsResponse"))
+	require.ErrorIs(t, err, types.ErrInvalidQueryPath)
+
+	_, err = msgServer.AddWhitelistedQuery(goCtx, types.NewMsgAddWhitelistedQuery(
+		k.GetAuthority(), path, "/terra.market.v1beta1.QueryParamsResponse"))
+	require.NoError(t, err)
+
+	res, err := k.GetWhitelistedQueryResponse(ctx, path)
+	require.NoError(t, err)
+	require.IsType(t, &markettypes.QueryParamsResponse{}, res)
+
+	queries, err := k.WhitelistedQueries(goCtx, &types.QueryWhitelistedQueriesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.WhitelistedQuery{
+		types.NewWhitelistedQuery(path, "/terra.market.v1beta1.QueryParamsResponse"),
+	}, queries.WhitelistedQueries)
+
+	_, err = msgServer.RemoveWhitelistedQuery(goCtx, types.NewMsgRemoveWhitelistedQuery(k.GetAuthority(), path))
+	require.NoError(t, err)
+
+	_, err = k.GetWhitelistedQueryResponse(ctx, path)
+	require.ErrorIs(t, err, types.ErrQueryNotWhitelisted)
+
+	_, err = msgServer.RemoveWhitelistedQuery(goCtx, types.NewMsgRemoveWhitelistedQuery(k.GetAuthority(), path))
+	require.ErrorIs(t, err, types.ErrQueryNotWhitelisted)
+}
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/classic-terra/core/v3/x/stargate/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the stargate MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+func (k msgServer) AddWhitelistedQuery(goCtx context.Context, req *types.MsgAddWhitelistedQuery) (*types.MsgAddWhitelistedQueryResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetWhitelistedQuery(ctx, types.NewWhitelistedQuery(req.Path, req.ResponseTypeUrl)); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAddWhitelistedQuery,
+			sdk.NewAttribute(types.AttributeKeyPath, req.Path),
+			sdk.NewAttribute(types.AttributeKeyResponseTypeURL, req.ResponseTypeUrl),
+		),
+	)
+
+	return &types.MsgAddWhitelistedQueryResponse{}, nil
+}
+
+func (k msgServer) RemoveWhitelistedQuery(goCtx context.Context, req *types.MsgRemoveWhitelistedQuery) (*types.MsgRemoveWhitelistedQueryResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.DeleteWhitelistedQuery(ctx, req.Path); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveWhitelistedQuery,
+			sdk.NewAttribute(types.AttributeKeyPath, req.Path),
+		),
+	)
+
+	return &types.MsgRemoveWhitelistedQueryResponse{}, nil
+}
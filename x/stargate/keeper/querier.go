@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/classic-terra/core/v3/x/stargate/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// WhitelistedQueries queries the stargate queries contracts may send
+func (k Keeper) WhitelistedQueries(c context.Context, req *types.QueryWhitelistedQueriesRequest) (*types.QueryWhitelistedQueriesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.WhitelistedQueryKeyPrefix)
+
+	queries := []types.WhitelistedQuery{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var q types.WhitelistedQuery
+		if err := k.cdc.Unmarshal(value, &q); err != nil {
+			return err
+		}
+
+		queries = append(queries, q)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryWhitelistedQueriesResponse{WhitelistedQueries: queries, Pagination: pageRes}, nil
+}
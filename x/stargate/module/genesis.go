@@ -0,0 +1,13 @@
+package module
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/classic-terra/core/v3/x/stargate/keeper"
+	"github.com/classic-terra/core/v3/x/stargate/types"
+)
+
+// InitGenesis initializes the whitelisted stargate queries
+func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState) {
+	keeper.InitGenesis(ctx, data)
+}
@@ -0,0 +1,13 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// Stargate module sentinel errors
+var (
+	ErrInvalidQueryPath       = errorsmod.Register(ModuleName, 2, "invalid query path")
+	ErrInvalidResponseType    = errorsmod.Register(ModuleName, 3, "invalid response type")
+	ErrQueryNotWhitelisted    = errorsmod.Register(ModuleName, 4, "query is not whitelisted")
+	ErrDuplicateWhitelistPath = errorsmod.Register(ModuleName, 5, "duplicate whitelisted query path")
+)
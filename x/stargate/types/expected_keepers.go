@@ -0,0 +1,10 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/baseapp"
+)
+
+// QueryRouter defines the expected gRPC query router (baseapp.GRPCQueryRouter).
+type QueryRouter interface {
+	Route(path string) baseapp.GRPCQueryHandler
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// DefaultWhitelistedQueries returns the queries contracts could send before the
+// whitelist was moved into state.
+func DefaultWhitelistedQueries() []WhitelistedQuery {
+	return []WhitelistedQuery{
+		// market
+		NewWhitelistedQuery("/terra.market.v1beta1.Query/Swap", "/terra.market.v1beta1.QuerySwapResponse"),
+
+		// treasury
+		NewWhitelistedQuery("/terra.treasury.v1beta1.Query/TaxCap", "/terra.treasury.v1beta1.QueryTaxCapResponse"),
+		NewWhitelistedQuery("/terra.treasury.v1beta1.Query/TaxRate", "/terra.treasury.v1beta1.QueryTaxRateResponse"),
+
+		// oracle
+		NewWhitelistedQuery("/terra.oracle.v1beta1.Query/ExchangeRate", "/terra.oracle.v1beta1.QueryExchangeRateResponse"),
+	}
+}
+
+// DefaultGenesisState returns the default stargate genesis state.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		WhitelistedQueries: DefaultWhitelistedQueries(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	paths := make(map[string]struct{}, len(gs.WhitelistedQueries))
+	for _, q := range gs.WhitelistedQueries {
+		if err := q.Validate(); err != nil {
+			return err
+		}
+
+		if _, ok := paths[q.Path]; ok {
+			return errorsmod.Wrap(ErrDuplicateWhitelistPath, q.Path)
+		}
+		paths[q.Path] = struct{}{}
+	}
+
+	return nil
+}
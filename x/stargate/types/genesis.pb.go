@@ -0,0 +1,594 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: terra/stargate/v1beta1/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// WhitelistedQuery defines a gRPC query contracts may send as stargate query
+// and the proto type its response is decoded into before it is returned to the
+// contract as JSON.
+type WhitelistedQuery struct {
+	// path is the full gRPC method, e.g. /terra.market.v1beta1.Query/Swap.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty" yaml:"path"`
+	// response_type_url is the type url of the response, e.g.
+	// /terra.market.v1beta1.QuerySwapResponse.
+	ResponseTypeUrl string `protobuf:"bytes,2,opt,name=response_type_url,json=responseTypeUrl,proto3" json:"response_type_url,omitempty" yaml:"response_type_url"`
+}
+
+func (m *WhitelistedQuery) Reset()         { *m = WhitelistedQuery{} }
+func (m *WhitelistedQuery) String() string { return proto.CompactTextString(m) }
+func (*WhitelistedQuery) ProtoMessage()    {}
+func (*WhitelistedQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_42e9b918e02c8bb0, []int{0}
+}
+func (m *WhitelistedQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WhitelistedQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WhitelistedQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WhitelistedQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WhitelistedQuery.Merge(m, src)
+}
+func (m *WhitelistedQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *WhitelistedQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_WhitelistedQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WhitelistedQuery proto.InternalMessageInfo
+
+func (m *WhitelistedQuery) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *WhitelistedQuery) GetResponseTypeUrl() string {
+	if m != nil {
+		return m.ResponseTypeUrl
+	}
+	return ""
+}
+
+// GenesisState defines the stargate module's genesis state.
+type GenesisState struct {
+	// whitelisted_queries contains the queries contracts may send.
+	WhitelistedQueries []WhitelistedQuery `protobuf:"bytes,1,rep,name=whitelisted_queries,json=whitelistedQueries,proto3" json:"whitelisted_queries"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_42e9b918e02c8bb0, []int{1}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetWhitelistedQueries() []WhitelistedQuery {
+	if m != nil {
+		return m.WhitelistedQueries
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*WhitelistedQuery)(nil), "terra.stargate.v1beta1.WhitelistedQuery")
+	proto.RegisterType((*GenesisState)(nil), "terra.stargate.v1beta1.GenesisState")
+}
+
+func init() {
+	proto.RegisterFile("terra/stargate/v1beta1/genesis.proto", fileDescriptor_42e9b918e02c8bb0)
+}
+
+var fileDescriptor_42e9b918e02c8bb0 = []byte{
+	// 318 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0x3f, 0x4b, 0xfb, 0x40,
+	0x1c, 0xc6, 0x73, 0xbf, 0x5f, 0x11, 0x4d, 0x85, 0x6a, 0x14, 0x09, 0x22, 0x97, 0x12, 0x1d, 0xba,
+	0x78, 0x47, 0xed, 0xd6, 0xb1, 0x8b, 0x82, 0x93, 0x55, 0x11, 0x5c, 0xc2, 0x35, 0x7e, 0x49, 0x0f,
+	0xd2, 0x5e, 0xbc, 0xfb, 0xa6, 0x35, 0xaf, 0xc1, 0xc5, 0xd1, 0xb1, 0x2f, 0xa7, 0x63, 0x47, 0xa7,
+	0x22, 0xed, 0xe2, 0xdc, 0x57, 0x20, 0x4d, 0x2c, 0xc5, 0x3f, 0xdb, 0xf1, 0xdc, 0xe7, 0x81, 0xfb,
+	0x3c, 0x67, 0x9f, 0x20, 0x68, 0x2d, 0xb8, 0x41, 0xa1, 0x23, 0x81, 0xc0, 0x07, 0xf5, 0x0e, 0xa0,
+	0xa8, 0xf3, 0x08, 0xfa, 0x60, 0xa4, 0x61, 0x89, 0x56, 0xa8, 0x9c, 0x83, 0x9c, 0x62, 0x2b, 0x8a,
+	0x7d, 0x51, 0x87, 0xfb, 0x91, 0x8a, 0x54, 0x8e, 0xf0, 0xe5, 0xa9, 0xa0, 0xfd, 0x67, 0x62, 0xef,
+	0xdc, 0x75, 0x25, 0x42, 0x2c, 0x0d, 0xc2, 0xc3, 0x55, 0x0a, 0x3a, 0x73, 0x8e, 0xed, 0x52, 0x22,
+	0xb0, 0xeb, 0x92, 0x2a, 0xa9, 0x6d, 0xb5, 0x2a, 0x8b, 0xa9, 0x57, 0xce, 0x44, 0x2f, 0x6e, 0xfa,
+	0xcb, 0xd4, 0x6f, 0xe7, 0x97, 0xce, 0x85, 0xbd, 0xab, 0xc1, 0x24, 0xaa, 0x6f, 0x20, 0xc0, 0x2c,
+	0x81, 0x20, 0xd5, 0xb1, 0xfb, 0x2f, 0x6f, 0x1c, 0x2d, 0xa6, 0x9e, 0x5b, 0x34, 0x7e, 0x21, 0x7e,
+	0xbb, 0xb2, 0xca, 0x6e, 0xb2, 0x04, 0x6e, 0x75, 0xdc, 0xdc, 0x7c, 0x1d, 0x79, 0xe4, 0x63, 0xe4,
+	0x11, 0x5f, 0xd9, 0xdb, 0xe7, 0x85, 0xcc, 0x35, 0x0a, 0x04, 0x27, 0xb0, 0xf7, 0x86, 0xeb, 0xc7,
+	0x05, 0x8f, 0x29, 0x68, 0x09, 0xc6, 0x25, 0xd5, 0xff, 0xb5, 0xf2, 0x59, 0x8d, 0xfd, 0x6d, 0xca,
+	0x7e, 0xfa, 0xb4, 0x4a, 0xe3, 0xa9, 0x67, 0xb5, 0x9d, 0xe1, 0xf7, 0x5c, 0x82, 0x69, 0x5d, 0x8e,
+	0x67, 0x94, 0x4c, 0x66, 0x94, 0xbc, 0xcf, 0x28, 0x79, 0x99, 0x53, 0x6b, 0x32, 0xa7, 0xd6, 0xdb,
+	0x9c, 0x5a, 0xf7, 0xf5, 0x48, 0x62, 0x37, 0xed, 0xb0, 0x50, 0xf5, 0x78, 0x18, 0x0b, 0x63, 0x64,
+	0x78, 0x5a, 0xec, 0x1f, 0x2a, 0x0d, 0x7c, 0xd0, 0xe0, 0x4f, 0xeb, 0x9f, 0x58, 0xca, 0x99, 0xce,
+	0x46, 0x3e, 0x69, 0xe3, 0x73, 0x00, 0xbc, 0x1a, 0x0d, 0x28, 0xa8, 0x01, 0x00, 0x00,
+}
+
+func (this *WhitelistedQuery) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WhitelistedQuery)
+	if !ok {
+		that2, ok := that.(WhitelistedQuery)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Path != that1.Path {
+		return false
+	}
+	if this.ResponseTypeUrl != that1.ResponseTypeUrl {
+		return false
+	}
+	return true
+}
+func (m *WhitelistedQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WhitelistedQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WhitelistedQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ResponseTypeUrl) > 0 {
+		i -= len(m.ResponseTypeUrl)
+		copy(dAtA[i:], m.ResponseTypeUrl)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ResponseTypeUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WhitelistedQueries) > 0 {
+		for iNdEx := len(m.WhitelistedQueries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WhitelistedQueries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *WhitelistedQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ResponseTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.WhitelistedQueries) > 0 {
+		for _, e := range m.WhitelistedQueries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *WhitelistedQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WhitelistedQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WhitelistedQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResponseTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistedQueries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WhitelistedQueries = append(m.WhitelistedQueries, WhitelistedQuery{})
+			if err := m.WhitelistedQueries[len(m.WhitelistedQueries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,21 @@
+package types
+
+const (
+	ModuleName = "stargate"
+	StoreKey   = ModuleName
+
+	RouterKey = ModuleName
+
+	EventTypeAddWhitelistedQuery    = "add_whitelisted_query"
+	EventTypeRemoveWhitelistedQuery = "remove_whitelisted_query"
+	AttributeKeyPath                = "path"
+	AttributeKeyResponseTypeURL     = "response_type_url"
+)
+
+// Key defines the store key for stargate.
+var WhitelistedQueryKeyPrefix = []byte{0x1}
+
+// GetWhitelistedQueryKey returns the store key of a whitelisted query path.
+func GetWhitelistedQueryKey(path string) []byte {
+	return append(WhitelistedQueryKeyPrefix, []byte(path)...)
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	TypeMsgAddWhitelistedQuery    = "add_whitelisted_query"
+	TypeMsgRemoveWhitelistedQuery = "remove_whitelisted_query"
+)
+
+var (
+	_ sdk.Msg = &MsgAddWhitelistedQuery{}
+	_ sdk.Msg = &MsgRemoveWhitelistedQuery{}
+)
+
+// NewMsgAddWhitelistedQuery returns a new MsgAddWhitelistedQuery.
+func NewMsgAddWhitelistedQuery(authority, path, responseTypeURL string) *MsgAddWhitelistedQuery {
+	return &MsgAddWhitelistedQuery{Authority: authority, Path: path, ResponseTypeUrl: responseTypeURL}
+}
+
+func (msg MsgAddWhitelistedQuery) Route() string { return ModuleName }
+func (msg MsgAddWhitelistedQuery) Type() string  { return TypeMsgAddWhitelistedQuery }
+func (msg MsgAddWhitelistedQuery) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return err
+	}
+
+	return NewWhitelistedQuery(msg.Path, msg.ResponseTypeUrl).Validate()
+}
+
+func (msg MsgAddWhitelistedQuery) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgAddWhitelistedQuery) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// NewMsgRemoveWhitelistedQuery returns a new MsgRemoveWhitelistedQuery.
+func NewMsgRemoveWhitelistedQuery(authority, path string) *MsgRemoveWhitelistedQuery {
+	return &MsgRemoveWhitelistedQuery{Authority: authority, Path: path}
+}
+
+func (msg MsgRemoveWhitelistedQuery) Route() string { return ModuleName }
+func (msg MsgRemoveWhitelistedQuery) Type() string  { return TypeMsgRemoveWhitelistedQuery }
+func (msg MsgRemoveWhitelistedQuery) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return err
+	}
+
+	return ValidateQueryPath(msg.Path)
+}
+
+func (msg MsgRemoveWhitelistedQuery) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgRemoveWhitelistedQuery) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
@@ -0,0 +1,676 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: terra/stargate/v1beta1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryWhitelistedQueriesRequest is the request type for the Query/WhitelistedQueries RPC method.
+type QueryWhitelistedQueriesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryWhitelistedQueriesRequest) Reset()         { *m = QueryWhitelistedQueriesRequest{} }
+func (m *QueryWhitelistedQueriesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWhitelistedQueriesRequest) ProtoMessage()    {}
+func (*QueryWhitelistedQueriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68669744d0774d92, []int{0}
+}
+func (m *QueryWhitelistedQueriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWhitelistedQueriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWhitelistedQueriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWhitelistedQueriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWhitelistedQueriesRequest.Merge(m, src)
+}
+func (m *QueryWhitelistedQueriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWhitelistedQueriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWhitelistedQueriesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWhitelistedQueriesRequest proto.InternalMessageInfo
+
+func (m *QueryWhitelistedQueriesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryWhitelistedQueriesResponse is the response type for the Query/WhitelistedQueries RPC method.
+type QueryWhitelistedQueriesResponse struct {
+	WhitelistedQueries []WhitelistedQuery `protobuf:"bytes,1,rep,name=whitelisted_queries,json=whitelistedQueries,proto3" json:"whitelisted_queries"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryWhitelistedQueriesResponse) Reset()         { *m = QueryWhitelistedQueriesResponse{} }
+func (m *QueryWhitelistedQueriesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWhitelistedQueriesResponse) ProtoMessage()    {}
+func (*QueryWhitelistedQueriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68669744d0774d92, []int{1}
+}
+func (m *QueryWhitelistedQueriesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWhitelistedQueriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWhitelistedQueriesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWhitelistedQueriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWhitelistedQueriesResponse.Merge(m, src)
+}
+func (m *QueryWhitelistedQueriesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWhitelistedQueriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWhitelistedQueriesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWhitelistedQueriesResponse proto.InternalMessageInfo
+
+func (m *QueryWhitelistedQueriesResponse) GetWhitelistedQueries() []WhitelistedQuery {
+	if m != nil {
+		return m.WhitelistedQueries
+	}
+	return nil
+}
+
+func (m *QueryWhitelistedQueriesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryWhitelistedQueriesRequest)(nil), "terra.stargate.v1beta1.QueryWhitelistedQueriesRequest")
+	proto.RegisterType((*QueryWhitelistedQueriesResponse)(nil), "terra.stargate.v1beta1.QueryWhitelistedQueriesResponse")
+}
+
+func init() {
+	proto.RegisterFile("terra/stargate/v1beta1/query.proto", fileDescriptor_68669744d0774d92)
+}
+
+var fileDescriptor_68669744d0774d92 = []byte{
+	// 386 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x4d, 0x6b, 0xe2, 0x40,
+	0x18, 0xc7, 0x33, 0xee, 0xcb, 0x61, 0xbc, 0xcd, 0x2e, 0x8b, 0xc8, 0x12, 0x25, 0x2c, 0xbb, 0xb2,
+	0x8b, 0x33, 0xa8, 0xb0, 0xbd, 0x7b, 0x68, 0x0f, 0xbd, 0xb4, 0x5e, 0x0a, 0xbd, 0xc8, 0x24, 0x7d,
+	0x18, 0x07, 0x34, 0x13, 0x33, 0xa3, 0xd6, 0x6b, 0x3f, 0x41, 0xa1, 0xdf, 0xa6, 0xe7, 0x1e, 0x84,
+	0x5e, 0x84, 0x5e, 0x7a, 0x2a, 0x45, 0xfb, 0x41, 0x4a, 0x32, 0x69, 0xb5, 0xd5, 0xb4, 0xf4, 0x96,
+	0xf0, 0xfc, 0x9e, 0xff, 0xcb, 0x93, 0x60, 0xcf, 0x40, 0x1c, 0x73, 0xa6, 0x0d, 0x8f, 0x05, 0x37,
+	0xc0, 0xc6, 0x0d, 0x1f, 0x0c, 0x6f, 0xb0, 0xe1, 0x08, 0xe2, 0x29, 0x8d, 0x62, 0x65, 0x14, 0xf9,
+	0x91, 0x32, 0xf4, 0x89, 0xa1, 0x19, 0x53, 0xfe, 0x2e, 0x94, 0x50, 0x29, 0xc2, 0x92, 0x27, 0x4b,
+	0x97, 0x7f, 0x0a, 0xa5, 0x44, 0x1f, 0x18, 0x8f, 0x24, 0xe3, 0x61, 0xa8, 0x0c, 0x37, 0x52, 0x85,
+	0x3a, 0x9b, 0xfe, 0x0d, 0x94, 0x1e, 0x28, 0xcd, 0x7c, 0xae, 0xc1, 0x9a, 0x3c, 0x5b, 0x46, 0x5c,
+	0xc8, 0x30, 0x85, 0x33, 0xf6, 0x57, 0x4e, 0x36, 0x01, 0x21, 0x68, 0x99, 0x29, 0x7a, 0x3d, 0xec,
+	0x1e, 0x26, 0x3a, 0x47, 0x3d, 0x69, 0xa0, 0x2f, 0xb5, 0x81, 0x93, 0xe4, 0x5d, 0x82, 0xee, 0xc0,
+	0x70, 0x04, 0xda, 0x90, 0x5d, 0x8c, 0x57, 0xda, 0x25, 0x54, 0x45, 0xb5, 0x62, 0xf3, 0x37, 0xb5,
+	0x41, 0x68, 0x12, 0x84, 0xda, 0xb6, 0x99, 0x3e, 0x3d, 0xe0, 0x02, 0xb2, 0xdd, 0xce, 0xda, 0xa6,
+	0x77, 0x8d, 0x70, 0x25, 0xd7, 0x4a, 0x47, 0x2a, 0xd4, 0x40, 0xba, 0xf8, 0xdb, 0x64, 0x35, 0xed,
+	0x0e, 0xed, 0xb8, 0x84, 0xaa, 0x9f, 0x6a, 0xc5, 0x66, 0x8d, 0x6e, 0xbf, 0x24, 0x7d, 0x25, 0x38,
+	0x6d, 0x7f, 0x9e, 0xdd, 0x55, 0x9c, 0x0e, 0x99, 0x6c, 0x18, 0x91, 0xbd, 0x17, 0x65, 0x0a, 0x69,
+	0x99, 0x3f, 0xef, 0x96, 0xb1, 0xe9, 0xd6, 0xdb, 0x34, 0xaf, 0x10, 0xfe, 0x92, 0x9a, 0x91, 0x4b,
+	0x84, 0xc9, 0x66, 0x25, 0xf2, 0x3f, 0x2f, 0xed, 0xdb, 0xe7, 0x2e, 0xef, 0x7c, 0x78, 0xcf, 0xa6,
+	0xf3, 0x5a, 0x67, 0x37, 0x0f, 0x17, 0x85, 0x3a, 0xf9, 0xc7, 0x72, 0x3e, 0xfc, 0x96, 0xcb, 0xb6,
+	0xf7, 0x67, 0x0b, 0x17, 0xcd, 0x17, 0x2e, 0xba, 0x5f, 0xb8, 0xe8, 0x7c, 0xe9, 0x3a, 0xf3, 0xa5,
+	0xeb, 0xdc, 0x2e, 0x5d, 0xe7, 0xb8, 0x21, 0xa4, 0xe9, 0x8d, 0x7c, 0x1a, 0xa8, 0x01, 0x0b, 0xfa,
+	0x5c, 0x6b, 0x19, 0xd4, 0xad, 0x70, 0xa0, 0x62, 0x60, 0xe3, 0x16, 0x3b, 0x5d, 0x59, 0x98, 0x69,
+	0x04, 0xda, 0xff, 0x9a, 0xfe, 0x52, 0xad, 0xc7, 0x01, 0x00, 0x4e, 0xec, 0xc6, 0x1d, 0x16, 0x03,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// WhitelistedQueries returns the stargate queries contracts may send.
+	WhitelistedQueries(ctx context.Context, in *QueryWhitelistedQueriesRequest, opts ...grpc.CallOption) (*QueryWhitelistedQueriesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) WhitelistedQueries(ctx context.Context, in *QueryWhitelistedQueriesRequest, opts ...grpc.CallOption) (*QueryWhitelistedQueriesResponse, error) {
+	out := new(QueryWhitelistedQueriesResponse)
+	err := c.cc.Invoke(ctx, "/terra.stargate.v1beta1.Query/WhitelistedQueries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// WhitelistedQueries returns the stargate queries contracts may send.
+	WhitelistedQueries(context.Context, *QueryWhitelistedQueriesRequest) (*QueryWhitelistedQueriesResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) WhitelistedQueries(ctx context.Context, req *QueryWhitelistedQueriesRequest) (*QueryWhitelistedQueriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WhitelistedQueries not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_WhitelistedQueries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWhitelistedQueriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WhitelistedQueries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.stargate.v1beta1.Query/WhitelistedQueries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WhitelistedQueries(ctx, req.(*QueryWhitelistedQueriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "terra.stargate.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "WhitelistedQueries",
+			Handler:    _Query_WhitelistedQueries_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "terra/stargate/v1beta1/query.proto",
+}
+
+func (m *QueryWhitelistedQueriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWhitelistedQueriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWhitelistedQueriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWhitelistedQueriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWhitelistedQueriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWhitelistedQueriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.WhitelistedQueries) > 0 {
+		for iNdEx := len(m.WhitelistedQueries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WhitelistedQueries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryWhitelistedQueriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWhitelistedQueriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.WhitelistedQueries) > 0 {
+		for _, e := range m.WhitelistedQueries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryWhitelistedQueriesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWhitelistedQueriesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWhitelistedQueriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWhitelistedQueriesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWhitelistedQueriesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWhitelistedQueriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistedQueries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WhitelistedQueries = append(m.WhitelistedQueries, WhitelistedQuery{})
+			if err := m.WhitelistedQueries[len(m.WhitelistedQueries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,1033 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: terra/stargate/v1beta1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgAddWhitelistedQuery is the Msg/AddWhitelistedQuery request type.
+type MsgAddWhitelistedQuery struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// path is the full gRPC method, e.g. /terra.market.v1beta1.Query/Swap.
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// response_type_url is the type url of the response, it must be registered
+	// in the proto registry of the chain.
+	ResponseTypeUrl string `protobuf:"bytes,3,opt,name=response_type_url,json=responseTypeUrl,proto3" json:"response_type_url,omitempty"`
+}
+
+func (m *MsgAddWhitelistedQuery) Reset()         { *m = MsgAddWhitelistedQuery{} }
+func (m *MsgAddWhitelistedQuery) String() string { return proto.CompactTextString(m) }
+func (*MsgAddWhitelistedQuery) ProtoMessage()    {}
+func (*MsgAddWhitelistedQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c26136e5660d6d7a, []int{0}
+}
+func (m *MsgAddWhitelistedQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddWhitelistedQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddWhitelistedQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddWhitelistedQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddWhitelistedQuery.Merge(m, src)
+}
+func (m *MsgAddWhitelistedQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddWhitelistedQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddWhitelistedQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddWhitelistedQuery proto.InternalMessageInfo
+
+func (m *MsgAddWhitelistedQuery) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgAddWhitelistedQuery) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *MsgAddWhitelistedQuery) GetResponseTypeUrl() string {
+	if m != nil {
+		return m.ResponseTypeUrl
+	}
+	return ""
+}
+
+// MsgAddWhitelistedQueryResponse defines the response structure for executing a
+// MsgAddWhitelistedQuery message.
+type MsgAddWhitelistedQueryResponse struct {
+}
+
+func (m *MsgAddWhitelistedQueryResponse) Reset()         { *m = MsgAddWhitelistedQueryResponse{} }
+func (m *MsgAddWhitelistedQueryResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddWhitelistedQueryResponse) ProtoMessage()    {}
+func (*MsgAddWhitelistedQueryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c26136e5660d6d7a, []int{1}
+}
+func (m *MsgAddWhitelistedQueryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddWhitelistedQueryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddWhitelistedQueryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddWhitelistedQueryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddWhitelistedQueryResponse.Merge(m, src)
+}
+func (m *MsgAddWhitelistedQueryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddWhitelistedQueryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddWhitelistedQueryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddWhitelistedQueryResponse proto.InternalMessageInfo
+
+// MsgRemoveWhitelistedQuery is the Msg/RemoveWhitelistedQuery request type.
+type MsgRemoveWhitelistedQuery struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// path is the full gRPC method to remove.
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (m *MsgRemoveWhitelistedQuery) Reset()         { *m = MsgRemoveWhitelistedQuery{} }
+func (m *MsgRemoveWhitelistedQuery) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveWhitelistedQuery) ProtoMessage()    {}
+func (*MsgRemoveWhitelistedQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c26136e5660d6d7a, []int{2}
+}
+func (m *MsgRemoveWhitelistedQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveWhitelistedQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveWhitelistedQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveWhitelistedQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveWhitelistedQuery.Merge(m, src)
+}
+func (m *MsgRemoveWhitelistedQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveWhitelistedQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveWhitelistedQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveWhitelistedQuery proto.InternalMessageInfo
+
+func (m *MsgRemoveWhitelistedQuery) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRemoveWhitelistedQuery) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+// MsgRemoveWhitelistedQueryResponse defines the response structure for executing a
+// MsgRemoveWhitelistedQuery message.
+type MsgRemoveWhitelistedQueryResponse struct {
+}
+
+func (m *MsgRemoveWhitelistedQueryResponse) Reset()         { *m = MsgRemoveWhitelistedQueryResponse{} }
+func (m *MsgRemoveWhitelistedQueryResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveWhitelistedQueryResponse) ProtoMessage()    {}
+func (*MsgRemoveWhitelistedQueryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c26136e5660d6d7a, []int{3}
+}
+func (m *MsgRemoveWhitelistedQueryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveWhitelistedQueryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveWhitelistedQueryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveWhitelistedQueryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveWhitelistedQueryResponse.Merge(m, src)
+}
+func (m *MsgRemoveWhitelistedQueryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveWhitelistedQueryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveWhitelistedQueryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveWhitelistedQueryResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgAddWhitelistedQuery)(nil), "terra.stargate.v1beta1.MsgAddWhitelistedQuery")
+	proto.RegisterType((*MsgAddWhitelistedQueryResponse)(nil), "terra.stargate.v1beta1.MsgAddWhitelistedQueryResponse")
+	proto.RegisterType((*MsgRemoveWhitelistedQuery)(nil), "terra.stargate.v1beta1.MsgRemoveWhitelistedQuery")
+	proto.RegisterType((*MsgRemoveWhitelistedQueryResponse)(nil), "terra.stargate.v1beta1.MsgRemoveWhitelistedQueryResponse")
+}
+
+func init() { proto.RegisterFile("terra/stargate/v1beta1/tx.proto", fileDescriptor_c26136e5660d6d7a) }
+
+var fileDescriptor_c26136e5660d6d7a = []byte{
+	// 421 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0x3f, 0xcb, 0xd3, 0x40,
+	0x1c, 0xee, 0xb5, 0x2a, 0xf4, 0x16, 0x69, 0x94, 0x9a, 0x66, 0x88, 0x35, 0x0e, 0x4a, 0xa0, 0x39,
+	0x62, 0xa1, 0x60, 0xc1, 0xa1, 0x5d, 0xa5, 0x83, 0x51, 0x11, 0x5c, 0xca, 0x35, 0x39, 0x92, 0x40,
+	0xd2, 0x0b, 0x77, 0x97, 0xd0, 0x0c, 0x82, 0x38, 0x38, 0x38, 0x39, 0xf8, 0x19, 0x9c, 0x3b, 0xf8,
+	0x21, 0x1c, 0x1c, 0x8a, 0xd3, 0x3b, 0xbe, 0xb4, 0x43, 0xbf, 0xc6, 0x4b, 0xf3, 0x87, 0xbe, 0xf0,
+	0x5e, 0x5f, 0xe8, 0xf0, 0x2e, 0x49, 0x2e, 0xcf, 0xf3, 0x5c, 0x9e, 0xe7, 0xf9, 0x5d, 0xe0, 0x53,
+	0x41, 0x18, 0xc3, 0x88, 0x0b, 0xcc, 0x7c, 0x2c, 0x08, 0xca, 0xec, 0x05, 0x11, 0xd8, 0x46, 0x62,
+	0x65, 0x25, 0x8c, 0x0a, 0xaa, 0x74, 0x0b, 0x82, 0x55, 0x13, 0xac, 0x8a, 0xa0, 0x3d, 0x71, 0x29,
+	0x8f, 0x29, 0x47, 0x31, 0xf7, 0x51, 0x66, 0x1f, 0x6e, 0xa5, 0x40, 0xeb, 0xe0, 0x38, 0x5c, 0x52,
+	0x54, 0x5c, 0xab, 0x57, 0xbd, 0x92, 0x3b, 0x2f, 0x56, 0xa8, 0x5c, 0x94, 0x90, 0xf1, 0x0f, 0xc0,
+	0xee, 0x8c, 0xfb, 0x13, 0xcf, 0xfb, 0x14, 0x84, 0x82, 0x44, 0x21, 0x17, 0xc4, 0x7b, 0x97, 0x12,
+	0x96, 0x2b, 0x23, 0xd8, 0xc6, 0xa9, 0x08, 0x28, 0x0b, 0x45, 0xae, 0x82, 0x3e, 0x78, 0xd9, 0x9e,
+	0xaa, 0xff, 0xff, 0x0c, 0x1e, 0x57, 0xfa, 0x89, 0xe7, 0x31, 0xc2, 0xf9, 0x7b, 0xc1, 0xc2, 0xa5,
+	0xef, 0x1c, 0xa9, 0x8a, 0x02, 0xef, 0x25, 0x58, 0x04, 0x6a, 0xf3, 0x20, 0x71, 0x8a, 0x67, 0xc5,
+	0x84, 0x1d, 0x46, 0x78, 0x42, 0x97, 0x9c, 0xcc, 0x45, 0x9e, 0x90, 0x79, 0xca, 0x22, 0xb5, 0x55,
+	0x10, 0x1e, 0xd6, 0xc0, 0x87, 0x3c, 0x21, 0x1f, 0x59, 0x34, 0x1e, 0x7f, 0xdb, 0xaf, 0xcd, 0xe3,
+	0x7e, 0x3f, 0xf6, 0x6b, 0xf3, 0x45, 0xd9, 0xd2, 0xea, 0xd8, 0x93, 0xdc, 0xb3, 0xd1, 0x87, 0xba,
+	0x1c, 0x71, 0xaa, 0x8f, 0x18, 0xbf, 0x01, 0xec, 0xcd, 0xb8, 0xef, 0x90, 0x98, 0x66, 0xe4, 0x2e,
+	0x33, 0x8f, 0xdf, 0xdc, 0xcc, 0x61, 0xca, 0x72, 0xc8, 0xad, 0x18, 0xcf, 0xe1, 0xb3, 0x93, 0x60,
+	0x9d, 0xe6, 0xd5, 0xaf, 0x26, 0x6c, 0xcd, 0xb8, 0xaf, 0x7c, 0x81, 0x8f, 0x64, 0x23, 0xb4, 0x2c,
+	0xf9, 0xe9, 0xb1, 0xe4, 0x25, 0x69, 0xa3, 0xf3, 0xf8, 0xb5, 0x0d, 0xe5, 0x3b, 0x80, 0xdd, 0x13,
+	0x8d, 0xda, 0xb7, 0x6c, 0x29, 0x97, 0x68, 0xaf, 0xcf, 0x96, 0xd4, 0x46, 0xb4, 0xfb, 0x5f, 0xf7,
+	0x6b, 0x13, 0x4c, 0xdf, 0xfe, 0xdd, 0xea, 0x60, 0xb3, 0xd5, 0xc1, 0xe5, 0x56, 0x07, 0x3f, 0x77,
+	0x7a, 0x63, 0xb3, 0xd3, 0x1b, 0x17, 0x3b, 0xbd, 0xf1, 0xd9, 0xf6, 0x43, 0x11, 0xa4, 0x0b, 0xcb,
+	0xa5, 0x31, 0x72, 0x23, 0xcc, 0x79, 0xe8, 0x0e, 0xca, 0xa1, 0xb8, 0x94, 0x11, 0x94, 0x0d, 0xaf,
+	0x0f, 0xe7, 0x70, 0x52, 0xf9, 0xe2, 0x41, 0xf1, 0xa7, 0x0c, 0xaf, 0x06, 0x00, 0x6a, 0xf1, 0x30,
+	0x78, 0xab, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// AddWhitelistedQuery adds or updates a stargate query contracts may send.
+	AddWhitelistedQuery(ctx context.Context, in *MsgAddWhitelistedQuery, opts ...grpc.CallOption) (*MsgAddWhitelistedQueryResponse, error)
+	// RemoveWhitelistedQuery removes a stargate query from the whitelist.
+	RemoveWhitelistedQuery(ctx context.Context, in *MsgRemoveWhitelistedQuery, opts ...grpc.CallOption) (*MsgRemoveWhitelistedQueryResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) AddWhitelistedQuery(ctx context.Context, in *MsgAddWhitelistedQuery, opts ...grpc.CallOption) (*MsgAddWhitelistedQueryResponse, error) {
+	out := new(MsgAddWhitelistedQueryResponse)
+	err := c.cc.Invoke(ctx, "/terra.stargate.v1beta1.Msg/AddWhitelistedQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveWhitelistedQuery(ctx context.Context, in *MsgRemoveWhitelistedQuery, opts ...grpc.CallOption) (*MsgRemoveWhitelistedQueryResponse, error) {
+	out := new(MsgRemoveWhitelistedQueryResponse)
+	err := c.cc.Invoke(ctx, "/terra.stargate.v1beta1.Msg/RemoveWhitelistedQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// AddWhitelistedQuery adds or updates a stargate query contracts may send.
+	AddWhitelistedQuery(context.Context, *MsgAddWhitelistedQuery) (*MsgAddWhitelistedQueryResponse, error)
+	// RemoveWhitelistedQuery removes a stargate query from the whitelist.
+	RemoveWhitelistedQuery(context.Context, *MsgRemoveWhitelistedQuery) (*MsgRemoveWhitelistedQueryResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) AddWhitelistedQuery(ctx context.Context, req *MsgAddWhitelistedQuery) (*MsgAddWhitelistedQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddWhitelistedQuery not implemented")
+}
+func (*UnimplementedMsgServer) RemoveWhitelistedQuery(ctx context.Context, req *MsgRemoveWhitelistedQuery) (*MsgRemoveWhitelistedQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveWhitelistedQuery not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_AddWhitelistedQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddWhitelistedQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddWhitelistedQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.stargate.v1beta1.Msg/AddWhitelistedQuery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddWhitelistedQuery(ctx, req.(*MsgAddWhitelistedQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveWhitelistedQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveWhitelistedQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveWhitelistedQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.stargate.v1beta1.Msg/RemoveWhitelistedQuery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveWhitelistedQuery(ctx, req.(*MsgRemoveWhitelistedQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "terra.stargate.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddWhitelistedQuery",
+			Handler:    _Msg_AddWhitelistedQuery_Handler,
+		},
+		{
+			MethodName: "RemoveWhitelistedQuery",
+			Handler:    _Msg_RemoveWhitelistedQuery_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "terra/stargate/v1beta1/tx.proto",
+}
+
+func (m *MsgAddWhitelistedQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddWhitelistedQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddWhitelistedQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ResponseTypeUrl) > 0 {
+		i -= len(m.ResponseTypeUrl)
+		copy(dAtA[i:], m.ResponseTypeUrl)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ResponseTypeUrl)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddWhitelistedQueryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddWhitelistedQueryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddWhitelistedQueryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveWhitelistedQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveWhitelistedQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveWhitelistedQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveWhitelistedQueryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveWhitelistedQueryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveWhitelistedQueryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAddWhitelistedQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ResponseTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddWhitelistedQueryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveWhitelistedQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveWhitelistedQueryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgAddWhitelistedQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddWhitelistedQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddWhitelistedQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResponseTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddWhitelistedQueryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddWhitelistedQueryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddWhitelistedQueryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveWhitelistedQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveWhitelistedQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveWhitelistedQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveWhitelistedQueryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveWhitelistedQueryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveWhitelistedQueryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// nodeLocalServices are registered on the query router only if the API or
+// gRPC server of a node is enabled and answer from node-local state, so they
+// can never be whitelisted without breaking consensus.
+var nodeLocalServices = map[string]bool{
+	"cosmos.base.tendermint.v1beta1.Service": true,
+	"cosmos.base.node.v1beta1.Service":       true,
+	"cosmos.tx.v1beta1.Service":              true,
+	"terra.tx.v1beta1.Service":               true,
+	"terra.mempool.v1beta1.Query":            true,
+}
+
+// NewWhitelistedQuery returns a new WhitelistedQuery.
+func NewWhitelistedQuery(path, responseTypeURL string) WhitelistedQuery {
+	return WhitelistedQuery{Path: path, ResponseTypeUrl: responseTypeURL}
+}
+
+// Validate checks the format of the path and response type url. Whether the
+// response type is registered is checked by the keeper.
+func (q WhitelistedQuery) Validate() error {
+	if err := ValidateQueryPath(q.Path); err != nil {
+		return err
+	}
+
+	return ValidateResponseTypeURL(q.ResponseTypeUrl)
+}
+
+// ValidateQueryPath checks that path is a full gRPC method, e.g.
+// /terra.market.v1beta1.Query/Swap, of a service registered on every node.
+func ValidateQueryPath(path string) error {
+	parts := strings.Split(path, "/")
+	if len(parts) != 3 || parts[0] != "" || parts[1] == "" || parts[2] == "" || strings.ContainsAny(path, " \t\n") {
+		return errorsmod.Wrapf(ErrInvalidQueryPath, "expected /<service>/<method>, got %q", path)
+	}
+
+	if nodeLocalServices[parts[1]] {
+		return errorsmod.Wrapf(ErrInvalidQueryPath, "%s is a node-local service", parts[1])
+	}
+
+	return nil
+}
+
+// ValidateResponseTypeURL checks that typeURL is of the form /<proto message name>.
+func ValidateResponseTypeURL(typeURL string) error {
+	if len(typeURL) < 2 || typeURL[0] != '/' || strings.ContainsAny(typeURL[1:], "/ \t\n") {
+		return errorsmod.Wrapf(ErrInvalidResponseType, "expected /<message name>, got %q", typeURL)
+	}
+
+	return nil
+}
@@ -25,6 +25,9 @@ func GetQueryCmd() *cobra.Command {
 	taxQueryCmd.AddCommand(
 		GetCmdQueryParams(),
 		GetCmdBurnTaxRate(),
+		GetCmdGasDiscounts(),
+		GetCmdContractTaxPolicies(),
+		GetCmdContractTaxPolicy(),
 	)
 
 	return taxQueryCmd
@@ -87,3 +90,84 @@ func GetCmdBurnTaxRate() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdGasDiscounts implements a command to return the active gas discounts.
+func GetCmdGasDiscounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gas-discounts",
+		Short: "Query the gas price discounts per message type",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.GasDiscounts(context.Background(), &types.QueryGasDiscountsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdContractTaxPolicies implements a command to return the registered contract tax policies.
+func GetCmdContractTaxPolicies() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-tax-policies",
+		Short: "Query the tax policies registered for contracts and codes",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ContractTaxPolicies(context.Background(), &types.QueryContractTaxPoliciesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdContractTaxPolicy implements a command to return the tax policy applied to a contract.
+func GetCmdContractTaxPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-tax-policy [contract-address]",
+		Short: "Query the tax policy applied to the messages dispatched by a contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ContractTaxPolicy(context.Background(), &types.QueryContractTaxPolicyRequest{ContractAddress: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
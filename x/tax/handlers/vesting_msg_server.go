@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"context"
+
+	taxkeeper "github.com/classic-terra/core/v3/x/tax/keeper"
+	taxtypes "github.com/classic-terra/core/v3/x/tax/types"
+	treasurykeeper "github.com/classic-terra/core/v3/x/treasury/keeper"
+	vestingkeeper "github.com/classic-terra/core/v3/x/vesting/keeper"
+	vestingtypes "github.com/classic-terra/core/v3/x/vesting/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type VestingMsgServer struct {
+	vestingtypes.UnimplementedMsgServer
+	taxKeeper      taxkeeper.Keeper
+	vestingKeeper  vestingkeeper.Keeper
+	treasuryKeeper treasurykeeper.Keeper
+	messageServer  vestingtypes.MsgServer
+}
+
+func NewVestingMsgServer(vestingKeeper vestingkeeper.Keeper, treasuryKeeper treasurykeeper.Keeper, taxKeeper taxkeeper.Keeper, messageServer vestingtypes.MsgServer) vestingtypes.MsgServer {
+	return &VestingMsgServer{
+		taxKeeper:      taxKeeper,
+		vestingKeeper:  vestingKeeper,
+		treasuryKeeper: treasuryKeeper,
+		messageServer:  messageServer,
+	}
+}
+
+// CreateLazyGradedVestingAccount handles MsgCreateLazyGradedVestingAccount with tax deduction
+func (s *VestingMsgServer) CreateLazyGradedVestingAccount(ctx context.Context, msg *vestingtypes.MsgCreateLazyGradedVestingAccount) (*vestingtypes.MsgCreateLazyGradedVestingAccountResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if !s.taxKeeper.IsReverseCharge(sdkCtx, true) {
+		return s.messageServer.CreateLazyGradedVestingAccount(ctx, msg)
+	}
+
+	fromAddr := sdk.MustAccAddressFromBech32(msg.FromAddress)
+
+	if !s.treasuryKeeper.HasBurnTaxExemptionAddress(sdkCtx, msg.FromAddress, msg.ToAddress) {
+		netAmount, err := s.taxKeeper.DeductTax(sdkCtx, fromAddr, msg.Amount, false)
+		if err != nil {
+			return nil, err
+		}
+		msg.Amount = netAmount
+	}
+
+	return s.messageServer.CreateLazyGradedVestingAccount(ctx, msg)
+}
+
+// Clawback handles MsgClawback with tax deduction. The clawed back coins are
+// only known once the vesting schedules are stopped, so the destination pays
+// the tax when it is neither the funder nor the community pool.
+func (s *VestingMsgServer) Clawback(ctx context.Context, msg *vestingtypes.MsgClawback) (*vestingtypes.MsgClawbackResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	vestingAccount, err := s.vestingKeeper.GetLazyGradedVestingAccount(sdkCtx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.messageServer.Clawback(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.DestAddress == "" || msg.DestAddress == vestingAccount.FunderAddress ||
+		s.treasuryKeeper.HasBurnTaxExemptionAddress(sdkCtx, msg.Address, msg.DestAddress) {
+		return res, nil
+	}
+
+	// the ante handler cannot compute the tax of the clawed back coins, it is
+	// always deducted here
+	reverseChargeCtx := sdkCtx.WithValue(taxtypes.ContextKeyTaxReverseCharge, true)
+	if _, err := s.taxKeeper.DeductTax(reverseChargeCtx, sdk.MustAccAddressFromBech32(msg.DestAddress), res.Coins, false); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// ExtendVestingSchedule moves no coins and is not taxed
+func (s *VestingMsgServer) ExtendVestingSchedule(ctx context.Context, msg *vestingtypes.MsgExtendVestingSchedule) (*vestingtypes.MsgExtendVestingScheduleResponse, error) {
+	return s.messageServer.ExtendVestingSchedule(ctx, msg)
+}
@@ -0,0 +1,91 @@
+package handlers_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	apptesting "github.com/classic-terra/core/v3/app/testing"
+	core "github.com/classic-terra/core/v3/types"
+	"github.com/classic-terra/core/v3/x/tax/handlers"
+	taxtypes "github.com/classic-terra/core/v3/x/tax/types"
+	vestingkeeper "github.com/classic-terra/core/v3/x/vesting/keeper"
+	vestingtypes "github.com/classic-terra/core/v3/x/vesting/types"
+)
+
+type VestingMsgServerTestSuite struct {
+	apptesting.KeeperTestHelper
+
+	msgServer vestingtypes.MsgServer
+}
+
+func TestVestingMsgServerTestSuite(t *testing.T) {
+	suite.Run(t, new(VestingMsgServerTestSuite))
+}
+
+func (s *VestingMsgServerTestSuite) SetupTest() {
+	s.Setup(s.T(), apptesting.SimAppChainID)
+
+	params := s.App.TaxKeeper.GetParams(s.Ctx)
+	params.BurnTaxRate = sdk.NewDecWithPrec(5, 3) // 0.5%
+	s.Require().NoError(s.App.TaxKeeper.SetParams(s.Ctx, params))
+
+	s.msgServer = handlers.NewVestingMsgServer(s.App.VestingKeeper, s.App.TreasuryKeeper, s.App.TaxKeeper, vestingkeeper.NewMsgServerImpl(s.App.VestingKeeper))
+}
+
+func (s *VestingMsgServerTestSuite) createVestingAccount(funder, addr sdk.AccAddress, amount sdk.Coins) {
+	now := s.Ctx.BlockTime()
+	schedules := vestingtypes.VestingSchedules{
+		vestingtypes.NewVestingSchedule(core.MicroSDRDenom, vestingtypes.Schedules{
+			vestingtypes.NewSchedule(now.Unix(), now.Add(100*time.Hour).Unix(), sdk.OneDec()),
+		}),
+	}
+
+	s.FundAcc(funder, amount)
+	_, err := s.msgServer.CreateLazyGradedVestingAccount(sdk.WrapSDKContext(s.Ctx),
+		vestingtypes.NewMsgCreateLazyGradedVestingAccount(funder, addr, amount, schedules))
+	s.Require().NoError(err)
+}
+
+// go test -v -run ^TestVestingMsgServerTestSuite/TestCreateLazyGradedVestingAccountTax$ github.com/classic-terra/core/v3/x/tax/handlers
+func (s *VestingMsgServerTestSuite) TestCreateLazyGradedVestingAccountTax() {
+	funder, addr := s.TestAccs[0], s.TestAccs[1]
+	amount := sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 100000))
+
+	// the tax is deducted from the vested coins on reverse charge
+	s.Ctx = s.Ctx.WithValue(taxtypes.ContextKeyTaxReverseCharge, true)
+	s.createVestingAccount(funder, addr, amount)
+
+	netAmount := sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 99500))
+	vestingAccount, err := s.App.VestingKeeper.GetLazyGradedVestingAccount(s.Ctx, addr)
+	s.Require().NoError(err)
+	s.Require().Equal(netAmount, vestingAccount.OriginalVesting)
+	s.Require().Equal(netAmount, s.App.BankKeeper.GetAllBalances(s.Ctx, addr))
+	s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, funder).IsZero())
+}
+
+// go test -v -run ^TestVestingMsgServerTestSuite/TestClawbackTax$ github.com/classic-terra/core/v3/x/tax/handlers
+func (s *VestingMsgServerTestSuite) TestClawbackTax() {
+	funder, addr, dest := s.TestAccs[0], s.TestAccs[1], s.TestAccs[2]
+	amount := sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 100000))
+
+	s.Ctx = s.Ctx.WithValue(taxtypes.ContextKeyTaxReverseCharge, false)
+	s.createVestingAccount(funder, addr, amount)
+
+	// the tax of the clawed back coins is deducted from the destination
+	res, err := s.msgServer.Clawback(sdk.WrapSDKContext(s.Ctx), vestingtypes.NewMsgClawback(funder, addr, dest))
+	s.Require().NoError(err)
+	s.Require().Equal(amount, res.Coins)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 99500)), s.App.BankKeeper.GetAllBalances(s.Ctx, dest))
+
+	// coins clawed back to the funder are not taxed again
+	addr = s.RandomAccountAddresses(1)[0]
+	s.createVestingAccount(funder, addr, amount)
+
+	_, err = s.msgServer.Clawback(sdk.WrapSDKContext(s.Ctx), vestingtypes.NewMsgClawback(funder, addr, nil))
+	s.Require().NoError(err)
+	s.Require().Equal(amount, s.App.BankKeeper.GetAllBalances(s.Ctx, funder))
+}
@@ -0,0 +1,187 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/classic-terra/core/v3/x/tax/types"
+)
+
+// SetContractTaxPolicy adds or replaces the tax policy of a contract or code id.
+func (k Keeper) SetContractTaxPolicy(ctx sdk.Context, policy types.ContractTaxPolicy) error {
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(contractTaxPolicyKey(policy.ContractAddress, policy.CodeId), k.cdc.MustMarshal(&policy))
+	return nil
+}
+
+// DeleteContractTaxPolicy removes the tax policy of a contract or code id.
+func (k Keeper) DeleteContractTaxPolicy(ctx sdk.Context, contractAddress string, codeID uint64) error {
+	if err := types.ValidateContractTaxPolicyTarget(contractAddress, codeID); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	key := contractTaxPolicyKey(contractAddress, codeID)
+	if !store.Has(key) {
+		return fmt.Errorf("no tax policy registered for contract %q or code %d", contractAddress, codeID)
+	}
+
+	store.Delete(key)
+	return nil
+}
+
+// GetContractTaxPolicies returns the policies of all contracts followed by the
+// policies of all codes.
+func (k Keeper) GetContractTaxPolicies(ctx sdk.Context) []types.ContractTaxPolicy {
+	store := ctx.KVStore(k.storeKey)
+	policies := []types.ContractTaxPolicy{}
+	for _, prefix := range [][]byte{types.ContractTaxPolicyKeyPrefix, types.CodeTaxPolicyKeyPrefix} {
+		iter := sdk.KVStorePrefixIterator(store, prefix)
+		for ; iter.Valid(); iter.Next() {
+			var policy types.ContractTaxPolicy
+			k.cdc.MustUnmarshal(iter.Value(), &policy)
+			policies = append(policies, policy)
+		}
+		iter.Close()
+	}
+
+	return policies
+}
+
+// GetEffectiveContractTaxPolicy returns the policy applied to the messages
+// dispatched by contract and where it is registered. A contract policy takes
+// precedence over the policy of its code, the default is reverse charge.
+func (k Keeper) GetEffectiveContractTaxPolicy(ctx sdk.Context, contract sdk.AccAddress) (types.ContractTaxPolicy, string) {
+	store := ctx.KVStore(k.storeKey)
+	if bz := store.Get(types.GetContractTaxPolicyKey(contract)); bz != nil {
+		var policy types.ContractTaxPolicy
+		k.cdc.MustUnmarshal(bz, &policy)
+		return policy, types.ContractTaxPolicySourceContract
+	}
+
+	if k.wasmKeeper != nil {
+		if info := k.wasmKeeper.GetContractInfo(ctx, contract); info != nil {
+			if bz := store.Get(types.GetCodeTaxPolicyKey(info.CodeID)); bz != nil {
+				var policy types.ContractTaxPolicy
+				k.cdc.MustUnmarshal(bz, &policy)
+				return policy, types.ContractTaxPolicySourceCode
+			}
+		}
+	}
+
+	return types.DefaultContractTaxPolicy(contract.String()), types.ContractTaxPolicySourceDefault
+}
+
+// WithContractTaxPolicy returns the context the messages dispatched by
+// contract are handled with according to its tax policy.
+func (k Keeper) WithContractTaxPolicy(ctx sdk.Context, contract sdk.AccAddress) sdk.Context {
+	policy, source := k.GetEffectiveContractTaxPolicy(ctx, contract)
+
+	reverseCharge := true
+	cappedContract := ""
+	upfrontContract := ""
+	switch policy.Mode {
+	case types.TaxPolicyModeExempt:
+		reverseCharge = false
+	case types.TaxPolicyModeSenderPaysUpfront:
+		// only the tax prepaid with the fee for the funds sent to the contract
+		// is not charged again
+		upfrontContract = contract.String()
+	case types.TaxPolicyModeCappedPerBlock:
+		cappedContract = contract.String()
+	}
+
+	if source != types.ContractTaxPolicySourceDefault {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeContractTaxPolicy,
+				sdk.NewAttribute(types.AttributeKeyContractAddress, contract.String()),
+				sdk.NewAttribute(types.AttributeKeyTaxPolicyMode, policy.Mode.String()),
+				sdk.NewAttribute(types.AttributeKeyReverseCharge, fmt.Sprintf("%t", reverseCharge)),
+			),
+		)
+	}
+
+	return ctx.
+		WithValue(types.ContextKeyTaxReverseCharge, reverseCharge).
+		WithValue(types.ContextKeyTaxCappedContract, cappedContract).
+		WithValue(types.ContextKeyTaxUpfrontContract, upfrontContract)
+}
+
+// IsSenderPaysUpfrontContract returns true if the effective tax policy of
+// contract is sender pays upfront.
+func (k Keeper) IsSenderPaysUpfrontContract(ctx sdk.Context, contract sdk.AccAddress) bool {
+	policy, _ := k.GetEffectiveContractTaxPolicy(ctx, contract)
+	return policy.Mode == types.TaxPolicyModeSenderPaysUpfront
+}
+
+// coverPrepaidTax deducts the tax prepaid for the sender pays upfront
+// contract dispatching the messages of ctx from taxes.
+func coverPrepaidTax(ctx sdk.Context, taxes sdk.Coins) sdk.Coins {
+	contract, ok := ctx.Value(types.ContextKeyTaxUpfrontContract).(string)
+	if !ok || contract == "" {
+		return taxes
+	}
+
+	prepaid, ok := ctx.Value(types.ContextKeyTaxPrepaid).(*types.PrepaidTax)
+	if !ok {
+		return taxes
+	}
+
+	return prepaid.Cover(contract, taxes)
+}
+
+// capContractTax limits taxes to what is left of the cap per block of a capped
+// contract and records the charged taxes.
+func (k Keeper) capContractTax(ctx sdk.Context, contract sdk.AccAddress, taxes sdk.Coins) sdk.Coins {
+	policy, _ := k.GetEffectiveContractTaxPolicy(ctx, contract)
+	if policy.Mode != types.TaxPolicyModeCappedPerBlock {
+		return taxes
+	}
+
+	usage := k.getCurrentContractTaxUsage(ctx, contract)
+	capped := sdk.Coins{}
+	for _, tax := range taxes {
+		remaining := policy.CapPerBlock.AmountOf(tax.Denom).Sub(usage.Taxes.AmountOf(tax.Denom))
+		if amount := sdk.MinInt(tax.Amount, remaining); amount.IsPositive() {
+			capped = capped.Add(sdk.NewCoin(tax.Denom, amount))
+		}
+	}
+
+	if !capped.IsZero() {
+		usage.Taxes = usage.Taxes.Add(capped...)
+		ctx.KVStore(k.storeKey).Set(types.GetContractTaxUsageKey(contract), k.cdc.MustMarshal(&usage))
+	}
+
+	return capped
+}
+
+func (k Keeper) getCurrentContractTaxUsage(ctx sdk.Context, contract sdk.AccAddress) types.ContractTaxUsage {
+	usage := types.ContractTaxUsage{Height: ctx.BlockHeight()}
+	bz := ctx.KVStore(k.storeKey).Get(types.GetContractTaxUsageKey(contract))
+	if bz == nil {
+		return usage
+	}
+
+	var stored types.ContractTaxUsage
+	k.cdc.MustUnmarshal(bz, &stored)
+	if stored.Height != ctx.BlockHeight() {
+		// the cap is reset every block
+		return usage
+	}
+
+	return stored
+}
+
+func contractTaxPolicyKey(contractAddress string, codeID uint64) []byte {
+	if contractAddress != "" {
+		return types.GetContractTaxPolicyKey(sdk.MustAccAddressFromBech32(contractAddress))
+	}
+
+	return types.GetCodeTaxPolicyKey(codeID)
+}
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/classic-terra/core/v3/x/tax/types"
+)
+
+// GetFeeDenom returns the fee denom entry for the given denom, if governance
+// whitelisted it for paying gas fees.
+func (k Keeper) GetFeeDenom(ctx sdk.Context, denom string) (types.FeeDenom, bool) {
+	for _, feeDenom := range k.GetParams(ctx).FeeDenoms {
+		if feeDenom.Denom == denom {
+			return feeDenom, true
+		}
+	}
+
+	return types.FeeDenom{}, false
+}
+
+// GetFeeDenomConversionRate returns the amount of native denom worth one unit
+// of the fee denom. The governance set conversion rate takes precedence over
+// the oracle exchange rates.
+func (k Keeper) GetFeeDenomConversionRate(ctx sdk.Context, feeDenom types.FeeDenom) (sdk.Dec, bool) {
+	if feeDenom.ConversionRate.IsPositive() {
+		return feeDenom.ConversionRate, true
+	}
+
+	// oracle rates are quoted as denom per luna
+	denomRate, err := k.oracleKeeper.GetLunaExchangeRate(ctx, feeDenom.Denom)
+	if err != nil || !denomRate.IsPositive() {
+		return sdk.ZeroDec(), false
+	}
+
+	nativeRate, err := k.oracleKeeper.GetLunaExchangeRate(ctx, feeDenom.NativeDenom)
+	if err != nil || !nativeRate.IsPositive() {
+		return sdk.ZeroDec(), false
+	}
+
+	return nativeRate.Quo(denomRate), true
+}
+
+// ConvertFeeToNative replaces every whitelisted fee denom in fees by its
+// native equivalent. Coins which are not whitelisted or cannot be priced are
+// returned unchanged.
+func (k Keeper) ConvertFeeToNative(ctx sdk.Context, fees sdk.Coins) sdk.Coins {
+	params := k.GetParams(ctx)
+	if len(params.FeeDenoms) == 0 {
+		return fees
+	}
+
+	converted := sdk.NewCoins()
+	for _, fee := range fees {
+		feeDenom, found := k.GetFeeDenom(ctx, fee.Denom)
+		if !found {
+			converted = converted.Add(fee)
+			continue
+		}
+
+		rate, ok := k.GetFeeDenomConversionRate(ctx, feeDenom)
+		if !ok {
+			converted = converted.Add(fee)
+			continue
+		}
+
+		nativeAmount := rate.MulInt(fee.Amount).TruncateInt()
+		if nativeAmount.IsPositive() {
+			converted = converted.Add(sdk.NewCoin(feeDenom.NativeDenom, nativeAmount))
+		}
+	}
+
+	return converted
+}
@@ -0,0 +1,167 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/classic-terra/core/v3/x/tax/types"
+)
+
+// GetGasDiscount returns the gas discount entry of the given message type url.
+func (k Keeper) GetGasDiscount(ctx sdk.Context, msgTypeURL string) (types.GasDiscount, bool) {
+	for _, discount := range k.GetParams(ctx).GasDiscounts {
+		if discount.MsgTypeUrl == msgTypeURL {
+			return discount, true
+		}
+	}
+
+	return types.GasDiscount{}, false
+}
+
+// GetGasPriceMultiplier returns the multiplier applied to the gas prices of a
+// tx with the given messages. A tx is only discounted if every message is
+// discounted for its signer and the signer is within its rate limit. The
+// highest multiplier of all messages applies.
+func (k Keeper) GetGasPriceMultiplier(ctx sdk.Context, msgs []sdk.Msg) sdk.Dec {
+	multiplier, discounted := k.getGasDiscountMultiplier(ctx, msgs)
+	if !discounted {
+		return sdk.OneDec()
+	}
+
+	return multiplier
+}
+
+// RecordGasDiscountUsage counts a discounted tx against the rate limits of its
+// signers, txs which do not get a discount are not counted.
+func (k Keeper) RecordGasDiscountUsage(ctx sdk.Context, msgs []sdk.Msg) {
+	if multiplier, discounted := k.getGasDiscountMultiplier(ctx, msgs); !discounted || multiplier.GTE(sdk.OneDec()) {
+		return
+	}
+
+	for _, msg := range msgs {
+		msgTypeURL := sdk.MsgTypeURL(msg)
+		discount, found := k.GetGasDiscount(ctx, msgTypeURL)
+		if !found || discount.MaxTxsPerWindow == 0 {
+			continue
+		}
+
+		for _, signer := range msg.GetSigners() {
+			usage := k.getCurrentGasDiscountUsage(ctx, discount, signer)
+			if usage.Count == 0 {
+				k.setGasDiscountUsageExpiry(ctx, signer, discount, usage)
+			}
+			usage.Count++
+			k.SetGasDiscountUsage(ctx, signer, msgTypeURL, usage)
+		}
+	}
+}
+
+// GetGasDiscountUsage returns the stored gas discount usage of a signer.
+func (k Keeper) GetGasDiscountUsage(ctx sdk.Context, signer sdk.AccAddress, msgTypeURL string) (usage types.GasDiscountUsage) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetGasDiscountUsageKey(signer, msgTypeURL))
+	if bz == nil {
+		return usage
+	}
+
+	k.cdc.MustUnmarshal(bz, &usage)
+	return usage
+}
+
+// SetGasDiscountUsage stores the gas discount usage of a signer.
+func (k Keeper) SetGasDiscountUsage(ctx sdk.Context, signer sdk.AccAddress, msgTypeURL string, usage types.GasDiscountUsage) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetGasDiscountUsageKey(signer, msgTypeURL), k.cdc.MustMarshal(&usage))
+}
+
+// PruneGasDiscountUsages deletes the usages whose window ended by the current
+// height. The usages are indexed by the end of their window, so only the
+// expired ones are visited.
+func (k Keeper) PruneGasDiscountUsages(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.GasDiscountUsageExpiryKeyPrefix, types.GetGasDiscountUsageExpiryPrefix(ctx.BlockHeight()+1))
+
+	var expired [][]byte
+	for ; iter.Valid(); iter.Next() {
+		expired = append(expired, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range expired {
+		store.Delete(key)
+
+		signer, msgTypeURL := types.ParseGasDiscountUsageExpiryKey(key)
+		discount, found := k.GetGasDiscount(ctx, msgTypeURL)
+		usage := k.GetGasDiscountUsage(ctx, signer, msgTypeURL)
+		if found && discount.MaxTxsPerWindow > 0 && ctx.BlockHeight() < usage.WindowStart+int64(discount.WindowBlocks) {
+			// the window was restarted or extended, it is pruned at its new end
+			k.setGasDiscountUsageExpiry(ctx, signer, discount, usage)
+			continue
+		}
+
+		store.Delete(types.GetGasDiscountUsageKey(signer, msgTypeURL))
+	}
+}
+
+func (k Keeper) setGasDiscountUsageExpiry(ctx sdk.Context, signer sdk.AccAddress, discount types.GasDiscount, usage types.GasDiscountUsage) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetGasDiscountUsageExpiryKey(usage.WindowStart+int64(discount.WindowBlocks), signer, discount.MsgTypeUrl), []byte{})
+}
+
+// getGasDiscountMultiplier returns the highest multiplier of the messages and
+// whether every message is discounted for its signers.
+func (k Keeper) getGasDiscountMultiplier(ctx sdk.Context, msgs []sdk.Msg) (sdk.Dec, bool) {
+	if len(msgs) == 0 {
+		return sdk.OneDec(), false
+	}
+
+	multiplier := sdk.ZeroDec()
+	for _, msg := range msgs {
+		discount, found := k.GetGasDiscount(ctx, sdk.MsgTypeURL(msg))
+		if !found {
+			return sdk.OneDec(), false
+		}
+
+		for _, signer := range msg.GetSigners() {
+			if !k.isGasDiscountAllowed(ctx, discount, signer) {
+				return sdk.OneDec(), false
+			}
+		}
+
+		multiplier = sdk.MaxDec(multiplier, discount.GasPriceMultiplier)
+	}
+
+	return multiplier, true
+}
+
+func (k Keeper) isGasDiscountAllowed(ctx sdk.Context, discount types.GasDiscount, signer sdk.AccAddress) bool {
+	if len(discount.AllowedSigners) > 0 {
+		allowed := false
+		for _, allowedSigner := range discount.AllowedSigners {
+			if allowedSigner == signer.String() {
+				allowed = true
+				break
+			}
+		}
+
+		if !allowed {
+			return false
+		}
+	}
+
+	if discount.MaxTxsPerWindow == 0 {
+		return true
+	}
+
+	return k.getCurrentGasDiscountUsage(ctx, discount, signer).Count < discount.MaxTxsPerWindow
+}
+
+// getCurrentGasDiscountUsage returns the usage of the current window, a usage
+// of an expired window is reset.
+func (k Keeper) getCurrentGasDiscountUsage(ctx sdk.Context, discount types.GasDiscount, signer sdk.AccAddress) types.GasDiscountUsage {
+	usage := k.GetGasDiscountUsage(ctx, signer, discount.MsgTypeUrl)
+	if usage.Count == 0 || ctx.BlockHeight() >= usage.WindowStart+int64(discount.WindowBlocks) {
+		return types.GasDiscountUsage{WindowStart: ctx.BlockHeight()}
+	}
+
+	return usage
+}
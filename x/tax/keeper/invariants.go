@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/classic-terra/core/v3/x/tax/types"
+)
+
+// RegisterInvariants registers all tax invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "params", ParamsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "contract-tax-policies", ContractTaxPoliciesInvariant(k))
+}
+
+// AllInvariants runs all invariants of the tax module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := ParamsInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return ContractTaxPoliciesInvariant(k)(ctx)
+	}
+}
+
+// ParamsInvariant checks that the stored params are valid
+func ParamsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		if err := k.GetParams(ctx).Validate(); err != nil {
+			broken = true
+			msg += fmt.Sprintf("\tinvalid params: %s\n", err)
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "params", msg), broken
+	}
+}
+
+// ContractTaxPoliciesInvariant checks that all contract tax policies are valid
+func ContractTaxPoliciesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		for _, policy := range k.GetContractTaxPolicies(ctx) {
+			if err := policy.Validate(); err != nil {
+				broken = true
+				msg += fmt.Sprintf("\tinvalid contract tax policy %s: %s\n", policy.String(), err)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "contract-tax-policies", msg), broken
+	}
+}
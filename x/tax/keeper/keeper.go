@@ -22,6 +22,8 @@ type Keeper struct {
 	bankKeeper         bankkeeper.Keeper
 	treasuryKeeper     treasurykeeper.Keeper
 	distributionKeeper distributionKeeper.Keeper
+	oracleKeeper       types.OracleKeeper
+	wasmKeeper         types.WasmKeeper
 
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
@@ -34,13 +36,15 @@ func NewKeeper(
 	bankKeeper bankkeeper.Keeper,
 	treasuryKeeper treasurykeeper.Keeper,
 	distributionKeeper distributionKeeper.Keeper,
+	oracleKeeper types.OracleKeeper,
+	wasmKeeper types.WasmKeeper,
 	authority string,
 ) Keeper {
 	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
 		panic(fmt.Errorf("invalid bank authority address: %w", err))
 	}
 
-	return Keeper{cdc: cdc, storeKey: storeKey, bankKeeper: bankKeeper, treasuryKeeper: treasuryKeeper, distributionKeeper: distributionKeeper, authority: authority}
+	return Keeper{cdc: cdc, storeKey: storeKey, bankKeeper: bankKeeper, treasuryKeeper: treasuryKeeper, distributionKeeper: distributionKeeper, oracleKeeper: oracleKeeper, wasmKeeper: wasmKeeper, authority: authority}
 }
 
 // InitGenesis initializes the tax module's state from a provided genesis
@@ -51,12 +55,19 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	}
 
 	k.SetParams(ctx, genState.Params)
+
+	for _, policy := range genState.ContractTaxPolicies {
+		if err := k.SetContractTaxPolicy(ctx, policy); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns the tax module's exported genesis.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	return &types.GenesisState{
-		Params: k.GetParams(ctx),
+		Params:              k.GetParams(ctx),
+		ContractTaxPolicies: k.GetContractTaxPolicies(ctx),
 	}
 }
 
@@ -106,6 +117,10 @@ func (k Keeper) DeductTax(
 	}
 
 	taxes := k.ComputeTax(ctx, amount)
+	if contract, ok := ctx.Value(types.ContextKeyTaxCappedContract).(string); ok && contract != "" {
+		taxes = k.capContractTax(ctx, sdk.MustAccAddressFromBech32(contract), taxes)
+	}
+	taxes = coverPrepaidTax(ctx, taxes)
 	netAmount := amount.Sub(taxes...)
 
 	if !taxes.IsZero() && !skipDeduct {
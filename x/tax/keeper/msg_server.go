@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -34,3 +35,47 @@ func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParam
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+func (k msgServer) SetContractTaxPolicy(goCtx context.Context, req *types.MsgSetContractTaxPolicy) (*types.MsgSetContractTaxPolicyResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.SetContractTaxPolicy(ctx, req.Policy); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetContractTaxPolicy,
+			sdk.NewAttribute(types.AttributeKeyContractAddress, req.Policy.ContractAddress),
+			sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(req.Policy.CodeId, 10)),
+			sdk.NewAttribute(types.AttributeKeyTaxPolicyMode, req.Policy.Mode.String()),
+			sdk.NewAttribute(types.AttributeKeyCapPerBlock, req.Policy.CapPerBlock.String()),
+		),
+	)
+
+	return &types.MsgSetContractTaxPolicyResponse{}, nil
+}
+
+func (k msgServer) RemoveContractTaxPolicy(goCtx context.Context, req *types.MsgRemoveContractTaxPolicy) (*types.MsgRemoveContractTaxPolicyResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.DeleteContractTaxPolicy(ctx, req.ContractAddress, req.CodeId); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveContractTaxPolicy,
+			sdk.NewAttribute(types.AttributeKeyContractAddress, req.ContractAddress),
+			sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(req.CodeId, 10)),
+		),
+	)
+
+	return &types.MsgRemoveContractTaxPolicyResponse{}, nil
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/classic-terra/core/v3/x/tax/types"
 )
@@ -21,3 +23,31 @@ func (k Keeper) BurnTaxRate(c context.Context, _ *types.QueryBurnTaxRateRequest)
 	ctx := sdk.UnwrapSDKContext(c)
 	return &types.QueryBurnTaxRateResponse{TaxRate: k.GetBurnTaxRate(ctx)}, nil
 }
+
+// GasDiscounts queries the active gas discounts of tax module
+func (k Keeper) GasDiscounts(c context.Context, _ *types.QueryGasDiscountsRequest) (*types.QueryGasDiscountsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryGasDiscountsResponse{GasDiscounts: k.GetParams(ctx).GasDiscounts}, nil
+}
+
+// ContractTaxPolicies queries the registered contract and code tax policies
+func (k Keeper) ContractTaxPolicies(c context.Context, _ *types.QueryContractTaxPoliciesRequest) (*types.QueryContractTaxPoliciesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryContractTaxPoliciesResponse{Policies: k.GetContractTaxPolicies(ctx)}, nil
+}
+
+// ContractTaxPolicy queries the tax policy applied to a contract
+func (k Keeper) ContractTaxPolicy(c context.Context, req *types.QueryContractTaxPolicyRequest) (*types.QueryContractTaxPolicyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	contract, err := sdk.AccAddressFromBech32(req.ContractAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	policy, source := k.GetEffectiveContractTaxPolicy(ctx, contract)
+	return &types.QueryContractTaxPolicyResponse{Policy: policy, Source: source}, nil
+}
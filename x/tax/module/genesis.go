@@ -10,5 +10,5 @@ import (
 // InitGenesis initializes default parameters
 // and the keeper's address to pubkey map
 func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState) {
-	keeper.SetParams(ctx, data.Params)
+	keeper.InitGenesis(ctx, data)
 }
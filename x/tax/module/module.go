@@ -5,8 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 
-	core "github.com/classic-terra/core/v3/types"
-	"github.com/classic-terra/core/v3/x/market/simulation"
+	wasmsim "github.com/CosmWasm/wasmd/x/wasm/simulation"
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -18,6 +17,7 @@ import (
 
 	"github.com/classic-terra/core/v3/x/tax/client/cli"
 	"github.com/classic-terra/core/v3/x/tax/keeper"
+	"github.com/classic-terra/core/v3/x/tax/simulation"
 	"github.com/classic-terra/core/v3/x/tax/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 )
@@ -26,6 +26,7 @@ var (
 	_ module.AppModule           = AppModule{}
 	_ module.AppModuleBasic      = AppModuleBasic{}
 	_ module.AppModuleSimulation = AppModule{}
+	_ module.HasProposalMsgs     = AppModule{}
 )
 
 type AppModuleBasic struct {
@@ -75,7 +76,11 @@ func (AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry)
 type AppModule struct {
 	AppModuleBasic
 
-	k keeper.Keeper
+	k              keeper.Keeper
+	accountKeeper  types.AccountKeeper
+	bankKeeper     types.BankKeeper
+	treasuryKeeper types.TreasuryKeeper
+	wasmKeeper     wasmsim.WasmKeeper
 }
 
 func (am AppModule) RegisterServices(cfg module.Configurator) {
@@ -84,32 +89,36 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterQueryServer(cfg.QueryServer(), am.k)
 }
 
-func NewAppModule(cdc codec.Codec, taxKeeper keeper.Keeper) AppModule {
+func NewAppModule(
+	cdc codec.Codec,
+	taxKeeper keeper.Keeper,
+	accountKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper,
+	treasuryKeeper types.TreasuryKeeper,
+	wasmKeeper wasmsim.WasmKeeper,
+) AppModule {
 	return AppModule{
 		AppModuleBasic: AppModuleBasic{cdc},
 		k:              taxKeeper,
+		accountKeeper:  accountKeeper,
+		bankKeeper:     bankKeeper,
+		treasuryKeeper: treasuryKeeper,
+		wasmKeeper:     wasmKeeper,
 	}
 }
 
-func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.k)
 }
 
-// GenerateGenesisState creates a randomized GenState of the dyncomm module.
+// GenerateGenesisState creates a randomized GenState of the tax module.
 func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
-	// workaround so that the staking module
-	// simulation would not fail
-	taxGenesis := types.DefaultGenesisState()
-	params := types.DefaultParams()
-	params.BurnTaxRate = sdk.NewDecWithPrec(1, 2)
-	params.GasPrices = sdk.NewDecCoins(sdk.NewDecCoin(core.MicroSDRDenom, sdk.ZeroInt())) // tests normally rely on zero gas price, so we are setting it here and fall back to the normal ctx.MinGasPrices
-	taxGenesis.Params = params
-	bz, err := json.MarshalIndent(&taxGenesis, "", " ")
-	if err != nil {
-		panic(err)
-	}
+	simulation.RandomizedGenState(simState)
+}
 
-	fmt.Printf("Selected default tax parameters:\n%s\n", bz)
-	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(taxGenesis)
+// ProposalMsgs returns msgs used for governance proposals for simulations.
+func (AppModule) ProposalMsgs(_ module.SimulationState) []simtypes.WeightedProposalMsg {
+	return simulation.ProposalMsgs()
 }
 
 // QuerierRoute returns the tax module's querier route name.
@@ -132,21 +141,25 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(genState)
 }
 
-// RegisterStoreDecoder registers a decoder for dyncomm module's types
+// RegisterStoreDecoder registers a decoder for tax module's types
 func (am AppModule) RegisterStoreDecoder(sdr sdk.StoreDecoderRegistry) {
 	sdr[types.StoreKey] = simulation.NewDecodeStore(am.cdc)
 }
 
-// WeightedOperations returns the all the dyncomm module operations with their respective weights.
-func (am AppModule) WeightedOperations(module.SimulationState) []simtypes.WeightedOperation {
-	return nil
+// WeightedOperations returns the all the tax module operations with their respective weights.
+func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	return simulation.WeightedOperations(
+		simState.AppParams, simState.Cdc,
+		am.accountKeeper, am.bankKeeper, am.k, am.treasuryKeeper, am.wasmKeeper,
+	)
 }
 
 // BeginBlock performs TODO.
 func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 
-// EndBlock performs TODO.
-func (am AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+// EndBlock returns the end blocker for the tax module.
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	EndBlocker(ctx, am.k)
 	return []abci.ValidatorUpdate{}
 }
 
@@ -0,0 +1,48 @@
+package vesting
+
+import (
+	"github.com/classic-terra/core/v3/x/tax/handlers"
+
+	taxkeeper "github.com/classic-terra/core/v3/x/tax/keeper"
+	treasurykeeper "github.com/classic-terra/core/v3/x/treasury/keeper"
+	"github.com/classic-terra/core/v3/x/vesting"
+	"github.com/classic-terra/core/v3/x/vesting/keeper"
+	"github.com/classic-terra/core/v3/x/vesting/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+var (
+	_ module.AppModule      = AppModule{}
+	_ module.AppModuleBasic = AppModuleBasic{}
+)
+
+// AppModuleBasic defines the basic application module used by the vesting module.
+type AppModuleBasic struct {
+	*vesting.AppModuleBasic
+}
+
+// AppModule implements an application module for the vesting module.
+type AppModule struct {
+	*vesting.AppModule
+
+	keeper         keeper.Keeper
+	treasuryKeeper treasurykeeper.Keeper
+	taxKeeper      taxkeeper.Keeper
+}
+
+// NewAppModule creates a new AppModule object
+func NewAppModule(keeper keeper.Keeper, treasuryKeeper treasurykeeper.Keeper, taxKeeper taxkeeper.Keeper) AppModule {
+	vm := vesting.NewAppModule(keeper)
+	return AppModule{
+		AppModule:      &vm,
+		keeper:         keeper,
+		treasuryKeeper: treasuryKeeper,
+		taxKeeper:      taxKeeper,
+	}
+}
+
+func (am AppModule) RegisterServices(cfg module.Configurator) {
+	origMsgServer := keeper.NewMsgServerImpl(am.keeper)
+	types.RegisterMsgServer(cfg.MsgServer(), handlers.NewVestingMsgServer(am.keeper, am.treasuryKeeper, am.taxKeeper, origMsgServer))
+	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQuerier(am.keeper))
+}
@@ -0,0 +1,47 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/classic-terra/core/v3/x/tax/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding tax type.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.ParamsKey):
+			var paramsA, paramsB types.Params
+			cdc.MustUnmarshal(kvA.Value, &paramsA)
+			cdc.MustUnmarshal(kvB.Value, &paramsB)
+			return fmt.Sprintf("%v\n%v", paramsA, paramsB)
+		case bytes.Equal(kvA.Key[:1], types.GasDiscountUsageKeyPrefix):
+			var usageA, usageB types.GasDiscountUsage
+			cdc.MustUnmarshal(kvA.Value, &usageA)
+			cdc.MustUnmarshal(kvB.Value, &usageB)
+			return fmt.Sprintf("%v\n%v", usageA, usageB)
+		case bytes.Equal(kvA.Key[:1], types.GasDiscountUsageExpiryKeyPrefix):
+			signerA, msgTypeURLA := types.ParseGasDiscountUsageExpiryKey(kvA.Key)
+			signerB, msgTypeURLB := types.ParseGasDiscountUsageExpiryKey(kvB.Key)
+			return fmt.Sprintf("%v %v\n%v %v", signerA, msgTypeURLA, signerB, msgTypeURLB)
+		case bytes.Equal(kvA.Key[:1], types.ContractTaxPolicyKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.CodeTaxPolicyKeyPrefix):
+			var policyA, policyB types.ContractTaxPolicy
+			cdc.MustUnmarshal(kvA.Value, &policyA)
+			cdc.MustUnmarshal(kvB.Value, &policyB)
+			return fmt.Sprintf("%v\n%v", policyA, policyB)
+		case bytes.Equal(kvA.Key[:1], types.ContractTaxUsageKeyPrefix):
+			var usageA, usageB types.ContractTaxUsage
+			cdc.MustUnmarshal(kvA.Value, &usageA)
+			cdc.MustUnmarshal(kvB.Value, &usageB)
+			return fmt.Sprintf("%v\n%v", usageA, usageB)
+		default:
+			panic(fmt.Sprintf("invalid tax key prefix %X", kvA.Key[:1]))
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package simulation
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	core "github.com/classic-terra/core/v3/types"
+	"github.com/classic-terra/core/v3/x/tax/types"
+)
+
+var (
+	signerAddr   = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	contractAddr = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+)
+
+func TestDecodeTaxStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+	dec := NewDecodeStore(cdc)
+
+	params := types.DefaultParams()
+	gasDiscountUsage := types.GasDiscountUsage{WindowStart: 10, Count: 3}
+	contractTaxPolicy := types.NewContractTaxPolicy(contractAddr.String(), types.TaxPolicyModeExempt, nil)
+	codeTaxPolicy := types.NewCodeTaxPolicy(7, types.TaxPolicyModeCappedPerBlock, sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 1000)))
+	contractTaxUsage := types.ContractTaxUsage{Height: 12, Taxes: sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 123))}
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.ParamsKey, Value: cdc.MustMarshal(&params)},
+			{Key: types.GetGasDiscountUsageKey(signerAddr, "/cosmos.bank.v1beta1.MsgSend"), Value: cdc.MustMarshal(&gasDiscountUsage)},
+			{Key: types.GetGasDiscountUsageExpiryKey(20, signerAddr, "/cosmos.bank.v1beta1.MsgSend"), Value: []byte{}},
+			{Key: types.GetContractTaxPolicyKey(contractAddr), Value: cdc.MustMarshal(&contractTaxPolicy)},
+			{Key: types.GetCodeTaxPolicyKey(7), Value: cdc.MustMarshal(&codeTaxPolicy)},
+			{Key: types.GetContractTaxUsageKey(contractAddr), Value: cdc.MustMarshal(&contractTaxUsage)},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"Params", fmt.Sprintf("%v\n%v", params, params)},
+		{"GasDiscountUsage", fmt.Sprintf("%v\n%v", gasDiscountUsage, gasDiscountUsage)},
+		{"GasDiscountUsageExpiry", fmt.Sprintf("%v %v\n%v %v", signerAddr, "/cosmos.bank.v1beta1.MsgSend", signerAddr, "/cosmos.bank.v1beta1.MsgSend")},
+		{"ContractTaxPolicy", fmt.Sprintf("%v\n%v", contractTaxPolicy, contractTaxPolicy)},
+		{"CodeTaxPolicy", fmt.Sprintf("%v\n%v", codeTaxPolicy, codeTaxPolicy)},
+		{"ContractTaxUsage", fmt.Sprintf("%v\n%v", contractTaxUsage, contractTaxUsage)},
+		{"other", ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	core "github.com/classic-terra/core/v3/types"
+	"github.com/classic-terra/core/v3/x/tax/types"
+)
+
+// Simulation parameter constants
+const (
+	burnTaxRateKey  = "burn_tax_rate"
+	gasDiscountsKey = "gas_discounts"
+)
+
+// discountedMsgTypeURLs are the message types which may get a gas discount
+var discountedMsgTypeURLs = []string{
+	sdk.MsgTypeURL(&banktypes.MsgSend{}),
+	sdk.MsgTypeURL(&banktypes.MsgMultiSend{}),
+	sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}),
+	sdk.MsgTypeURL(&stakingtypes.MsgEditValidator{}),
+}
+
+// GenBurnTaxRate randomized BurnTaxRate
+func GenBurnTaxRate(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(50)+1), 3)
+}
+
+// GenGasPrices returns zero gas prices, the operations of the other modules
+// pay random fees which do not cover any gas price
+func GenGasPrices(*rand.Rand) sdk.DecCoins {
+	return sdk.NewDecCoins(sdk.NewDecCoin(core.MicroSDRDenom, sdk.ZeroInt()))
+}
+
+// GenGasDiscounts randomized GasDiscounts
+func GenGasDiscounts(r *rand.Rand) []types.GasDiscount {
+	var discounts []types.GasDiscount
+	for _, msgTypeURL := range discountedMsgTypeURLs {
+		if r.Intn(2) == 0 {
+			continue
+		}
+
+		discount := types.GasDiscount{
+			MsgTypeUrl:         msgTypeURL,
+			GasPriceMultiplier: simtypes.RandomDecAmount(r, sdk.OneDec()),
+		}
+		if r.Intn(2) == 0 {
+			discount.MaxTxsPerWindow = uint64(1 + r.Intn(10))
+			discount.WindowBlocks = uint64(1 + r.Intn(100))
+		}
+
+		discounts = append(discounts, discount)
+	}
+
+	return discounts
+}
+
+// RandomizedGenState generates a random GenesisState for tax
+func RandomizedGenState(simState *module.SimulationState) {
+	var burnTaxRate sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, burnTaxRateKey, &burnTaxRate, simState.Rand,
+		func(r *rand.Rand) { burnTaxRate = GenBurnTaxRate(r) },
+	)
+
+	var gasDiscounts []types.GasDiscount
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, gasDiscountsKey, &gasDiscounts, simState.Rand,
+		func(r *rand.Rand) { gasDiscounts = GenGasDiscounts(r) },
+	)
+
+	taxGenesis := types.DefaultGenesisState()
+	taxGenesis.Params = types.Params{
+		GasPrices:    GenGasPrices(simState.Rand),
+		BurnTaxRate:  burnTaxRate,
+		GasDiscounts: gasDiscounts,
+	}
+
+	bz, err := json.MarshalIndent(&taxGenesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("Selected randomly generated tax parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(taxGenesis)
+}
@@ -0,0 +1,283 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"math/rand"
+
+	simappparams "cosmossdk.io/simapp/params"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	wasmsim "github.com/CosmWasm/wasmd/x/wasm/simulation"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	core "github.com/classic-terra/core/v3/types"
+	"github.com/classic-terra/core/v3/x/tax/keeper"
+	"github.com/classic-terra/core/v3/x/tax/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgSend                   = "op_weight_msg_taxed_send"                      //#nosec
+	OpWeightMsgSendAndExecuteContract = "op_weight_msg_taxed_send_and_execute_contract" //#nosec
+
+	DefaultWeightMsgSend                   int = 100
+	DefaultWeightMsgSendAndExecuteContract int = 50
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams,
+	cdc codec.JSONCodec,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	tk keeper.Keeper,
+	trk types.TreasuryKeeper,
+	wk wasmsim.WasmKeeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgSend                   int
+		weightMsgSendAndExecuteContract int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgSend, &weightMsgSend, nil,
+		func(*rand.Rand) {
+			weightMsgSend = DefaultWeightMsgSend
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgSendAndExecuteContract, &weightMsgSendAndExecuteContract, nil,
+		func(*rand.Rand) {
+			weightMsgSendAndExecuteContract = DefaultWeightMsgSendAndExecuteContract
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgSend,
+			SimulateMsgSend(ak, bk, tk, trk),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSendAndExecuteContract,
+			SimulateMsgSendAndExecuteContract(ak, bk, tk, trk, wk),
+		),
+	}
+}
+
+// SimulateMsgSend generates a taxed MsgSend of luna. The fees randomly pay the
+// tax upfront or leave it to be reverse charged from the sent amount, the
+// balances of sender and recipient are checked against the charged tax.
+// nolint: funlen
+func SimulateMsgSend(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	tk keeper.Keeper,
+	trk types.TreasuryKeeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		from, _ := simtypes.RandomAcc(r, accs)
+		to, _ := simtypes.RandomAcc(r, accs)
+		if from.Address.Equals(to.Address) {
+			return simtypes.NoOpMsg(types.ModuleName, banktypes.TypeMsgSend, "sender is the recipient"), nil, nil
+		}
+
+		if trk.HasBurnTaxExemptionAddress(ctx, from.Address.String(), to.Address.String()) {
+			return simtypes.NoOpMsg(types.ModuleName, banktypes.TypeMsgSend, "burn tax exempted"), nil, nil
+		}
+
+		amount, ok := randomLunaAmount(r, ctx, bk, from.Address, 2)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, banktypes.TypeMsgSend, "not enough luna to send"), nil, nil
+		}
+
+		coins := sdk.NewCoins(sdk.NewCoin(core.MicroLunaDenom, amount))
+		fees := cappedTax(ctx, tk, trk, coins)
+		// without any tax due there is nothing to reverse charge
+		reverseCharge := !fees.IsZero() && r.Intn(2) == 0
+		if reverseCharge {
+			fees = sdk.Coins{}
+		}
+
+		msg := banktypes.NewMsgSend(from.Address, to.Address, coins)
+
+		fromBalance := bk.GetBalance(ctx, from.Address, core.MicroLunaDenom).Amount
+		toBalance := bk.GetBalance(ctx, to.Address, core.MicroLunaDenom).Amount
+
+		if err := deliver(r, app, ctx, ak, from, chainID, fees, msg); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, banktypes.TypeMsgSend, "unable to deliver tx"), nil, err
+		}
+
+		received := amount
+		if reverseCharge {
+			received = amount.Sub(tk.ComputeTax(ctx, coins).AmountOf(core.MicroLunaDenom))
+		}
+
+		if got := bk.GetBalance(ctx, to.Address, core.MicroLunaDenom).Amount.Sub(toBalance); !got.Equal(received) {
+			return simtypes.NoOpMsg(types.ModuleName, banktypes.TypeMsgSend, "recipient balance mismatch"), nil,
+				fmt.Errorf("recipient received %s%s instead of %s%s", got, core.MicroLunaDenom, received, core.MicroLunaDenom)
+		}
+
+		spent := amount.Add(fees.AmountOf(core.MicroLunaDenom))
+		if got := fromBalance.Sub(bk.GetBalance(ctx, from.Address, core.MicroLunaDenom).Amount); !got.Equal(spent) {
+			return simtypes.NoOpMsg(types.ModuleName, banktypes.TypeMsgSend, "sender balance mismatch"), nil,
+				fmt.Errorf("sender spent %s%s instead of %s%s", got, core.MicroLunaDenom, spent, core.MicroLunaDenom)
+		}
+
+		return simtypes.NewOperationMsg(msg, true, "", nil), nil, nil
+	}
+}
+
+// SimulateMsgSendAndExecuteContract generates a tx mixing a taxed MsgSend of
+// luna with a MsgExecuteContract on a reflect contract, which sends the funds
+// back to the sender. The funds of the contract execution must not be taxed
+// on top of the send.
+// nolint: funlen
+func SimulateMsgSendAndExecuteContract(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	tk keeper.Keeper,
+	trk types.TreasuryKeeper,
+	wk wasmsim.WasmKeeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := wasmtypes.MsgExecuteContract{}.Type()
+
+		contract := wasmsim.DefaultSimulationExecuteContractSelector(ctx, wk)
+		if contract == nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no contract instance available"), nil, nil
+		}
+
+		from, err := wasmsim.DefaultSimulationExecuteSenderSelector(wk, ctx, contract, accs)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "contract is no reflect contract"), nil, nil
+		}
+
+		to, _ := simtypes.RandomAcc(r, accs)
+		if from.Address.Equals(to.Address) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "sender is the recipient"), nil, nil
+		}
+
+		if trk.HasBurnTaxExemptionAddress(ctx, from.Address.String(), to.Address.String()) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "burn tax exempted"), nil, nil
+		}
+
+		amount, ok := randomLunaAmount(r, ctx, bk, from.Address, 4)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "not enough luna to send"), nil, nil
+		}
+
+		deposit, ok := randomLunaAmount(r, ctx, bk, from.Address, 4)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "not enough luna to deposit"), nil, nil
+		}
+
+		coins := sdk.NewCoins(sdk.NewCoin(core.MicroLunaDenom, amount))
+		fees := cappedTax(ctx, tk, trk, coins)
+		// without any tax due there is nothing to reverse charge
+		reverseCharge := !fees.IsZero() && r.Intn(2) == 0
+		if reverseCharge {
+			fees = sdk.Coins{}
+		}
+
+		sendMsg := banktypes.NewMsgSend(from.Address, to.Address, coins)
+		executeMsg := &wasmtypes.MsgExecuteContract{
+			Sender:   from.Address.String(),
+			Contract: contract.String(),
+			Funds:    sdk.NewCoins(sdk.NewCoin(core.MicroLunaDenom, deposit)),
+		}
+		if err := wasmsim.DefaultSimulationExecutePayloader(executeMsg); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "contract execute payload"), nil, err
+		}
+
+		toBalance := bk.GetBalance(ctx, to.Address, core.MicroLunaDenom).Amount
+		contractBalance := bk.GetBalance(ctx, contract, core.MicroLunaDenom).Amount
+
+		if err := deliver(r, app, ctx, ak, from, chainID, fees, sendMsg, executeMsg); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to deliver tx"), nil, err
+		}
+
+		received := amount
+		if reverseCharge {
+			received = amount.Sub(tk.ComputeTax(ctx, coins).AmountOf(core.MicroLunaDenom))
+		}
+
+		if got := bk.GetBalance(ctx, to.Address, core.MicroLunaDenom).Amount.Sub(toBalance); !got.Equal(received) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "recipient balance mismatch"), nil,
+				fmt.Errorf("recipient received %s%s instead of %s%s", got, core.MicroLunaDenom, received, core.MicroLunaDenom)
+		}
+
+		if got := bk.GetBalance(ctx, contract, core.MicroLunaDenom).Amount; !got.Equal(contractBalance) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "contract balance mismatch"), nil,
+				fmt.Errorf("contract balance changed from %s%s to %s%s", contractBalance, core.MicroLunaDenom, got, core.MicroLunaDenom)
+		}
+
+		return simtypes.NewOperationMsg(executeMsg, true, "", nil), nil, nil
+	}
+}
+
+// randomLunaAmount returns a random amount of at most the fraction 1/divisor
+// of the spendable luna of the account
+func randomLunaAmount(r *rand.Rand, ctx sdk.Context, bk types.BankKeeper, addr sdk.AccAddress, divisor int64) (sdk.Int, bool) {
+	spendable := bk.SpendableCoins(ctx, addr).AmountOf(core.MicroLunaDenom).QuoRaw(divisor)
+	if !spendable.IsPositive() {
+		return sdk.ZeroInt(), false
+	}
+
+	amount, err := simtypes.RandPositiveInt(r, spendable)
+	if err != nil {
+		return sdk.ZeroInt(), false
+	}
+
+	return amount, true
+}
+
+// cappedTax returns the tax of the coins capped by the tax cap, which is
+// the tax the ante handler expects the fees to pay upfront
+func cappedTax(ctx sdk.Context, tk keeper.Keeper, trk types.TreasuryKeeper, coins sdk.Coins) sdk.Coins {
+	taxes := sdk.Coins{}
+	for _, tax := range tk.ComputeTax(ctx, coins) {
+		if taxCap := trk.GetTaxCap(ctx, tax.Denom); tax.Amount.GT(taxCap) {
+			tax.Amount = taxCap
+		}
+
+		taxes = taxes.Add(tax)
+	}
+
+	return taxes
+}
+
+func deliver(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, ak types.AccountKeeper,
+	simAccount simtypes.Account, chainID string, fees sdk.Coins, msgs ...sdk.Msg,
+) error {
+	account := ak.GetAccount(ctx, simAccount.Address)
+	txGen := simappparams.MakeTestEncodingConfig().TxConfig
+	tx, err := simtestutil.GenSignedMockTx(
+		r,
+		txGen,
+		msgs,
+		fees,
+		simtestutil.DefaultGenTxGas,
+		chainID,
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence()},
+		simAccount.PrivKey,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = app.SimDeliver(txGen.TxEncoder(), tx)
+	return err
+}
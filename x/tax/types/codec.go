@@ -12,12 +12,16 @@ import (
 // on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "terra/tax/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgSetContractTaxPolicy{}, "terra/x/tax/MsgSetContractTaxPolicy", nil)
+	cdc.RegisterConcrete(&MsgRemoveContractTaxPolicy{}, "terra/x/tax/MsgRemoveContractTaxPolicy", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgSetContractTaxPolicy{},
+		&MsgRemoveContractTaxPolicy{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,78 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ContractTaxPolicySourceContract is the source of a policy registered for the contract address.
+	ContractTaxPolicySourceContract = "contract"
+	// ContractTaxPolicySourceCode is the source of a policy registered for the code id of the contract.
+	ContractTaxPolicySourceCode = "code"
+	// ContractTaxPolicySourceDefault is the source of the reverse charge default.
+	ContractTaxPolicySourceDefault = "default"
+)
+
+// NewContractTaxPolicy returns a policy for a contract address.
+func NewContractTaxPolicy(contractAddress string, mode TaxPolicyMode, capPerBlock sdk.Coins) ContractTaxPolicy {
+	return ContractTaxPolicy{ContractAddress: contractAddress, Mode: mode, CapPerBlock: capPerBlock}
+}
+
+// NewCodeTaxPolicy returns a policy for all contracts of a code id.
+func NewCodeTaxPolicy(codeID uint64, mode TaxPolicyMode, capPerBlock sdk.Coins) ContractTaxPolicy {
+	return ContractTaxPolicy{CodeId: codeID, Mode: mode, CapPerBlock: capPerBlock}
+}
+
+// Validate checks the policy is registered for either a contract address or
+// a code id and only capped policies define a cap.
+func (p ContractTaxPolicy) Validate() error {
+	if err := ValidateContractTaxPolicyTarget(p.ContractAddress, p.CodeId); err != nil {
+		return err
+	}
+
+	if _, ok := TaxPolicyMode_name[int32(p.Mode)]; !ok {
+		return fmt.Errorf("invalid tax policy mode: %d", p.Mode)
+	}
+
+	if p.Mode != TaxPolicyModeCappedPerBlock {
+		if !p.CapPerBlock.Empty() {
+			return fmt.Errorf("only %s policies define a cap per block", TaxPolicyModeCappedPerBlock)
+		}
+
+		return nil
+	}
+
+	if p.CapPerBlock.Empty() {
+		return fmt.Errorf("%s policies must define a cap per block", TaxPolicyModeCappedPerBlock)
+	}
+
+	if err := p.CapPerBlock.Validate(); err != nil {
+		return fmt.Errorf("invalid cap per block: %w", err)
+	}
+
+	return nil
+}
+
+// ValidateContractTaxPolicyTarget checks that exactly one of contract address
+// and code id is set.
+func ValidateContractTaxPolicyTarget(contractAddress string, codeID uint64) error {
+	if (contractAddress == "") == (codeID == 0) {
+		return fmt.Errorf("exactly one of contract address and code id must be set")
+	}
+
+	if contractAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(contractAddress); err != nil {
+			return fmt.Errorf("invalid contract address: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// DefaultContractTaxPolicy returns the policy of contracts without a
+// registered policy.
+func DefaultContractTaxPolicy(contractAddress string) ContractTaxPolicy {
+	return NewContractTaxPolicy(contractAddress, TaxPolicyModeReverseCharge, nil)
+}
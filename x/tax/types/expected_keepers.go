@@ -1,10 +1,37 @@
 package types
 
 import (
+	"cosmossdk.io/math"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
+// expected AccountKeeper, only used for simulation
+type AccountKeeper interface {
+	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
+}
+
+// expected BankKeeper, only used for simulation
+type BankKeeper interface {
+	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+}
+
 // expected TreasuryKeeper
 type TreasuryKeeper interface {
 	HasBurnTaxExemptionAddress(ctx sdk.Context, addresses ...string) bool
+
+	// only used for simulation
+	GetTaxCap(ctx sdk.Context, denom string) math.Int
+}
+
+// expected OracleKeeper
+type OracleKeeper interface {
+	GetLunaExchangeRate(ctx sdk.Context, denom string) (sdk.Dec, error)
+}
+
+// expected WasmKeeper
+type WasmKeeper interface {
+	GetContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo
 }
@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // DefaultGenesis returns the default tax genesis state.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
@@ -10,5 +12,27 @@ func DefaultGenesisState() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	contracts := make(map[string]bool, len(gs.ContractTaxPolicies))
+	codes := make(map[uint64]bool, len(gs.ContractTaxPolicies))
+	for _, policy := range gs.ContractTaxPolicies {
+		if err := policy.Validate(); err != nil {
+			return err
+		}
+
+		if contracts[policy.ContractAddress] || codes[policy.CodeId] {
+			return fmt.Errorf("duplicate contract tax policy: %s", policy.String())
+		}
+
+		if policy.ContractAddress != "" {
+			contracts[policy.ContractAddress] = true
+		} else {
+			codes[policy.CodeId] = true
+		}
+	}
+
+	return nil
 }
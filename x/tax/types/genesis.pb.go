@@ -26,9 +26,53 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// TaxPolicyMode defines how the messages dispatched by a contract are taxed.
+type TaxPolicyMode int32
+
+const (
+	// TAX_POLICY_MODE_REVERSE_CHARGE taxes every dispatched message in the
+	// message route, it is the default of every contract.
+	TaxPolicyModeReverseCharge TaxPolicyMode = 0
+	// TAX_POLICY_MODE_EXEMPT never taxes dispatched messages.
+	TaxPolicyModeExempt TaxPolicyMode = 1
+	// TAX_POLICY_MODE_SENDER_PAYS_UPFRONT taxes the funds sent to the contract
+	// with the tx fee, dispatched messages are reverse charged beyond the tax
+	// prepaid this way.
+	TaxPolicyModeSenderPaysUpfront TaxPolicyMode = 2
+	// TAX_POLICY_MODE_CAPPED_PER_BLOCK reverse charges dispatched messages until
+	// the taxes of the contract reach cap_per_block within a block.
+	TaxPolicyModeCappedPerBlock TaxPolicyMode = 3
+)
+
+var TaxPolicyMode_name = map[int32]string{
+	0: "TAX_POLICY_MODE_REVERSE_CHARGE",
+	1: "TAX_POLICY_MODE_EXEMPT",
+	2: "TAX_POLICY_MODE_SENDER_PAYS_UPFRONT",
+	3: "TAX_POLICY_MODE_CAPPED_PER_BLOCK",
+}
+
+var TaxPolicyMode_value = map[string]int32{
+	"TAX_POLICY_MODE_REVERSE_CHARGE":      0,
+	"TAX_POLICY_MODE_EXEMPT":              1,
+	"TAX_POLICY_MODE_SENDER_PAYS_UPFRONT": 2,
+	"TAX_POLICY_MODE_CAPPED_PER_BLOCK":    3,
+}
+
+func (x TaxPolicyMode) String() string {
+	return proto.EnumName(TaxPolicyMode_name, int32(x))
+}
+
+func (TaxPolicyMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2613d9f939b57990, []int{0}
+}
+
 type Params struct {
 	GasPrices   github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=gas_prices,json=gasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"gas_prices" yaml:"gas_prices"`
 	BurnTaxRate github_com_cosmos_cosmos_sdk_types.Dec      `protobuf:"bytes,2,opt,name=burn_tax_rate,json=burnTaxRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"burn_tax_rate"`
+	// fee_denoms lists non-native (typically IBC) denoms accepted for gas fees.
+	FeeDenoms []FeeDenom `protobuf:"bytes,3,rep,name=fee_denoms,json=feeDenoms,proto3" json:"fee_denoms" yaml:"fee_denoms"`
+	// gas_discounts lists message types which are charged a reduced gas price.
+	GasDiscounts []GasDiscount `protobuf:"bytes,4,rep,name=gas_discounts,json=gasDiscounts,proto3" json:"gas_discounts" yaml:"gas_discounts"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -71,17 +115,353 @@ func (m *Params) GetGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
 	return nil
 }
 
+func (m *Params) GetFeeDenoms() []FeeDenom {
+	if m != nil {
+		return m.FeeDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetGasDiscounts() []GasDiscount {
+	if m != nil {
+		return m.GasDiscounts
+	}
+	return nil
+}
+
+// FeeDenom defines a denom that can be used to pay gas fees and how it is
+// valued against a native gas price denom.
+type FeeDenom struct {
+	// denom is the accepted fee denom, e.g. ibc/<hash>.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// native_denom is the native denom the fee is converted into when checking
+	// it against the gas prices.
+	NativeDenom string `protobuf:"bytes,2,opt,name=native_denom,json=nativeDenom,proto3" json:"native_denom,omitempty" yaml:"native_denom"`
+	// conversion_rate is the amount of native_denom worth one unit of denom.
+	// A zero rate means the rate is derived from the oracle exchange rates.
+	ConversionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=conversion_rate,json=conversionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"conversion_rate" yaml:"conversion_rate"`
+}
+
+func (m *FeeDenom) Reset()         { *m = FeeDenom{} }
+func (m *FeeDenom) String() string { return proto.CompactTextString(m) }
+func (*FeeDenom) ProtoMessage()    {}
+func (*FeeDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2613d9f939b57990, []int{1}
+}
+func (m *FeeDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeDenom.Merge(m, src)
+}
+func (m *FeeDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeDenom proto.InternalMessageInfo
+
+func (m *FeeDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *FeeDenom) GetNativeDenom() string {
+	if m != nil {
+		return m.NativeDenom
+	}
+	return ""
+}
+
+// GasDiscount defines a gas price multiplier for a message type. A tx is only
+// discounted if all of its messages are discounted, the highest multiplier
+// of its messages is applied.
+type GasDiscount struct {
+	// msg_type_url is the type url of the discounted message.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty" yaml:"msg_type_url"`
+	// gas_price_multiplier is applied to the gas prices, zero makes the message free.
+	GasPriceMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=gas_price_multiplier,json=gasPriceMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"gas_price_multiplier" yaml:"gas_price_multiplier"`
+	// max_txs_per_window limits the discounted txs per signer within a window,
+	// zero means unlimited.
+	MaxTxsPerWindow uint64 `protobuf:"varint,3,opt,name=max_txs_per_window,json=maxTxsPerWindow,proto3" json:"max_txs_per_window,omitempty" yaml:"max_txs_per_window"`
+	// window_blocks is the length of the rate limit window in blocks.
+	WindowBlocks uint64 `protobuf:"varint,4,opt,name=window_blocks,json=windowBlocks,proto3" json:"window_blocks,omitempty" yaml:"window_blocks"`
+	// allowed_signers restricts the discount to the given signers, e.g.
+	// registered relayers. An empty list allows every signer.
+	AllowedSigners []string `protobuf:"bytes,5,rep,name=allowed_signers,json=allowedSigners,proto3" json:"allowed_signers,omitempty" yaml:"allowed_signers"`
+}
+
+func (m *GasDiscount) Reset()         { *m = GasDiscount{} }
+func (m *GasDiscount) String() string { return proto.CompactTextString(m) }
+func (*GasDiscount) ProtoMessage()    {}
+func (*GasDiscount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2613d9f939b57990, []int{2}
+}
+func (m *GasDiscount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasDiscount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasDiscount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasDiscount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasDiscount.Merge(m, src)
+}
+func (m *GasDiscount) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasDiscount) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasDiscount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasDiscount proto.InternalMessageInfo
+
+func (m *GasDiscount) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *GasDiscount) GetMaxTxsPerWindow() uint64 {
+	if m != nil {
+		return m.MaxTxsPerWindow
+	}
+	return 0
+}
+
+func (m *GasDiscount) GetWindowBlocks() uint64 {
+	if m != nil {
+		return m.WindowBlocks
+	}
+	return 0
+}
+
+func (m *GasDiscount) GetAllowedSigners() []string {
+	if m != nil {
+		return m.AllowedSigners
+	}
+	return nil
+}
+
+// GasDiscountUsage tracks the discounted txs of a signer in the current window.
+type GasDiscountUsage struct {
+	WindowStart int64  `protobuf:"varint,1,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	Count       uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *GasDiscountUsage) Reset()         { *m = GasDiscountUsage{} }
+func (m *GasDiscountUsage) String() string { return proto.CompactTextString(m) }
+func (*GasDiscountUsage) ProtoMessage()    {}
+func (*GasDiscountUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2613d9f939b57990, []int{3}
+}
+func (m *GasDiscountUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasDiscountUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasDiscountUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasDiscountUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasDiscountUsage.Merge(m, src)
+}
+func (m *GasDiscountUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasDiscountUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasDiscountUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasDiscountUsage proto.InternalMessageInfo
+
+func (m *GasDiscountUsage) GetWindowStart() int64 {
+	if m != nil {
+		return m.WindowStart
+	}
+	return 0
+}
+
+func (m *GasDiscountUsage) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// ContractTaxPolicy defines the tax handling of the messages dispatched by a
+// contract. A policy applies either to a contract address or to all contracts
+// of a code id, a contract address policy takes precedence.
+type ContractTaxPolicy struct {
+	// contract_address is the contract the policy applies to.
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty" yaml:"contract_address"`
+	// code_id is the code whose contracts the policy applies to.
+	CodeId uint64        `protobuf:"varint,2,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty" yaml:"code_id"`
+	Mode   TaxPolicyMode `protobuf:"varint,3,opt,name=mode,proto3,enum=terra.tax.v1beta1.TaxPolicyMode" json:"mode,omitempty" yaml:"mode"`
+	// cap_per_block is the maximum tax charged per contract and block in
+	// TAX_POLICY_MODE_CAPPED_PER_BLOCK, denoms without a cap are not taxed.
+	CapPerBlock github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=cap_per_block,json=capPerBlock,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"cap_per_block" yaml:"cap_per_block"`
+}
+
+func (m *ContractTaxPolicy) Reset()         { *m = ContractTaxPolicy{} }
+func (m *ContractTaxPolicy) String() string { return proto.CompactTextString(m) }
+func (*ContractTaxPolicy) ProtoMessage()    {}
+func (*ContractTaxPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2613d9f939b57990, []int{4}
+}
+func (m *ContractTaxPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractTaxPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractTaxPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractTaxPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractTaxPolicy.Merge(m, src)
+}
+func (m *ContractTaxPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractTaxPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractTaxPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractTaxPolicy proto.InternalMessageInfo
+
+func (m *ContractTaxPolicy) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *ContractTaxPolicy) GetCodeId() uint64 {
+	if m != nil {
+		return m.CodeId
+	}
+	return 0
+}
+
+func (m *ContractTaxPolicy) GetMode() TaxPolicyMode {
+	if m != nil {
+		return m.Mode
+	}
+	return TaxPolicyModeReverseCharge
+}
+
+func (m *ContractTaxPolicy) GetCapPerBlock() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CapPerBlock
+	}
+	return nil
+}
+
+// ContractTaxUsage tracks the taxes charged to a capped contract in a block.
+type ContractTaxUsage struct {
+	Height int64                                    `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Taxes  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=taxes,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"taxes"`
+}
+
+func (m *ContractTaxUsage) Reset()         { *m = ContractTaxUsage{} }
+func (m *ContractTaxUsage) String() string { return proto.CompactTextString(m) }
+func (*ContractTaxUsage) ProtoMessage()    {}
+func (*ContractTaxUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2613d9f939b57990, []int{5}
+}
+func (m *ContractTaxUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractTaxUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractTaxUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractTaxUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractTaxUsage.Merge(m, src)
+}
+func (m *ContractTaxUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractTaxUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractTaxUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractTaxUsage proto.InternalMessageInfo
+
+func (m *ContractTaxUsage) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ContractTaxUsage) GetTaxes() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Taxes
+	}
+	return nil
+}
+
 // GenesisState defines the tax module's genesis state.
 type GenesisState struct {
 	// params contains tax handling parameters.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// contract_tax_policies contains the tax policies of contracts and codes.
+	ContractTaxPolicies []ContractTaxPolicy `protobuf:"bytes,2,rep,name=contract_tax_policies,json=contractTaxPolicies,proto3" json:"contract_tax_policies"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2613d9f939b57990, []int{1}
+	return fileDescriptor_2613d9f939b57990, []int{6}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -117,42 +497,212 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetContractTaxPolicies() []ContractTaxPolicy {
+	if m != nil {
+		return m.ContractTaxPolicies
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("terra.tax.v1beta1.TaxPolicyMode", TaxPolicyMode_name, TaxPolicyMode_value)
 	proto.RegisterType((*Params)(nil), "terra.tax.v1beta1.Params")
+	proto.RegisterType((*FeeDenom)(nil), "terra.tax.v1beta1.FeeDenom")
+	proto.RegisterType((*GasDiscount)(nil), "terra.tax.v1beta1.GasDiscount")
+	proto.RegisterType((*GasDiscountUsage)(nil), "terra.tax.v1beta1.GasDiscountUsage")
+	proto.RegisterType((*ContractTaxPolicy)(nil), "terra.tax.v1beta1.ContractTaxPolicy")
+	proto.RegisterType((*ContractTaxUsage)(nil), "terra.tax.v1beta1.ContractTaxUsage")
 	proto.RegisterType((*GenesisState)(nil), "terra.tax.v1beta1.GenesisState")
 }
 
 func init() { proto.RegisterFile("terra/tax/v1beta1/genesis.proto", fileDescriptor_2613d9f939b57990) }
 
 var fileDescriptor_2613d9f939b57990 = []byte{
-	// 390 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0x31, 0x6f, 0xda, 0x40,
-	0x14, 0xc7, 0x7d, 0xb4, 0x42, 0xc2, 0xb4, 0x03, 0x56, 0x07, 0x4a, 0x2b, 0x1b, 0x79, 0xa8, 0x10,
-	0x2d, 0x77, 0x02, 0x86, 0x4a, 0x8c, 0x6e, 0x55, 0xa6, 0x4a, 0xc8, 0xed, 0xd4, 0x05, 0x3d, 0xbb,
-	0x27, 0xd7, 0x2a, 0xf6, 0x59, 0x77, 0x07, 0x32, 0x5f, 0xa0, 0x03, 0x53, 0xc7, 0x8e, 0x1d, 0xa3,
-	0x4c, 0x7c, 0x0c, 0x46, 0xc6, 0x28, 0x03, 0x89, 0x60, 0x60, 0xcf, 0x27, 0x88, 0x7c, 0x67, 0x92,
-	0x48, 0x59, 0xb2, 0xd8, 0xa7, 0x7b, 0xff, 0xf7, 0x7f, 0xbf, 0xfb, 0x3f, 0xd3, 0x91, 0x94, 0x73,
-	0x20, 0x12, 0x72, 0xb2, 0xe8, 0x07, 0x54, 0x42, 0x9f, 0x44, 0x34, 0xa5, 0x22, 0x16, 0x38, 0xe3,
-	0x4c, 0x32, 0xab, 0xa1, 0x04, 0x58, 0x42, 0x8e, 0x4b, 0x41, 0xeb, 0x55, 0xc4, 0x22, 0xa6, 0xaa,
-	0xa4, 0x38, 0x69, 0x61, 0xcb, 0x0e, 0x99, 0x48, 0x98, 0x20, 0x01, 0x08, 0x7a, 0xe7, 0x15, 0xb2,
-	0x38, 0x2d, 0xeb, 0x0d, 0x48, 0xe2, 0x94, 0x11, 0xf5, 0xd5, 0x57, 0xee, 0x9f, 0x8a, 0x59, 0x9d,
-	0x00, 0x87, 0x44, 0x58, 0x2b, 0x64, 0x9a, 0x11, 0x88, 0x69, 0xc6, 0xe3, 0x90, 0x8a, 0x26, 0x6a,
-	0x3f, 0xeb, 0xd4, 0x07, 0x6f, 0xb1, 0xf6, 0xc4, 0x85, 0xe7, 0x69, 0x3c, 0xfe, 0x4c, 0xc3, 0x4f,
-	0x2c, 0x4e, 0xbd, 0xaf, 0x9b, 0x9d, 0x63, 0xdc, 0xec, 0x9c, 0xc6, 0x12, 0x92, 0xd9, 0xc8, 0xbd,
-	0xef, 0x76, 0xcf, 0xaf, 0x9c, 0xf7, 0x51, 0x2c, 0x7f, 0xcd, 0x03, 0x1c, 0xb2, 0x84, 0x94, 0x60,
-	0xfa, 0xd7, 0x13, 0x3f, 0x7f, 0x13, 0xb9, 0xcc, 0xa8, 0x38, 0x19, 0x89, 0xb3, 0xe3, 0xba, 0x8b,
-	0xfc, 0x5a, 0x04, 0x62, 0xa2, 0xfa, 0x2d, 0xdf, 0x7c, 0x19, 0xcc, 0x79, 0x3a, 0x95, 0x90, 0x4f,
-	0x39, 0x48, 0xda, 0xac, 0xb4, 0x51, 0xa7, 0xe6, 0xe1, 0x62, 0xe0, 0xe5, 0xce, 0x79, 0xf7, 0x34,
-	0x6f, 0xbf, 0x5e, 0x98, 0x7c, 0x87, 0xdc, 0x07, 0x49, 0x47, 0x6f, 0xfe, 0xfd, 0x77, 0xd0, 0xea,
-	0xb8, 0xee, 0x5a, 0x3a, 0xf1, 0x5c, 0x65, 0xae, 0x5f, 0xef, 0x8e, 0xcd, 0x17, 0x63, 0x9d, 0xfa,
-	0x37, 0x09, 0x92, 0x5a, 0x1f, 0xcd, 0x6a, 0xa6, 0x2a, 0x4d, 0xd4, 0x46, 0x9d, 0xfa, 0xe0, 0x35,
-	0x7e, 0xb4, 0x05, 0xac, 0x5b, 0xbd, 0xe7, 0x05, 0x94, 0x5f, 0xca, 0xbd, 0x2f, 0x9b, 0xbd, 0x8d,
-	0xb6, 0x7b, 0x1b, 0x5d, 0xef, 0x6d, 0xf4, 0xf7, 0x60, 0x1b, 0xdb, 0x83, 0x6d, 0x5c, 0x1c, 0x6c,
-	0xe3, 0xc7, 0x87, 0x87, 0xd0, 0x33, 0x10, 0x22, 0x0e, 0x7b, 0x9a, 0x24, 0x64, 0x9c, 0x92, 0xc5,
-	0xb0, 0x24, 0x52, 0xf8, 0x41, 0x55, 0x2d, 0x68, 0x78, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x0d, 0x84,
-	0xfa, 0x5e, 0x1f, 0x02, 0x00, 0x00,
+	// 1162 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xbf, 0x6f, 0xdb, 0xc6,
+	0x17, 0x17, 0x2d, 0xd9, 0xdf, 0xe4, 0x24, 0xdb, 0xf2, 0xc5, 0xdf, 0x44, 0x91, 0x5b, 0x52, 0x61,
+	0x8b, 0xc0, 0x48, 0x1a, 0xa9, 0x49, 0x86, 0xa2, 0x06, 0x3a, 0x98, 0x12, 0x9d, 0xa4, 0x89, 0x62,
+	0xe2, 0x24, 0xb7, 0x49, 0x86, 0x12, 0x27, 0xf2, 0x4c, 0x13, 0x11, 0x79, 0x2c, 0x8f, 0x76, 0xe8,
+	0xa9, 0x63, 0x0b, 0x01, 0x05, 0x3a, 0x76, 0x31, 0x10, 0x34, 0x43, 0x8b, 0x4e, 0xf9, 0x33, 0x82,
+	0x4e, 0x19, 0x8b, 0x0e, 0x6a, 0x11, 0x0f, 0x29, 0xd0, 0x4d, 0x7f, 0x41, 0xc1, 0x3b, 0xea, 0xa7,
+	0x0d, 0x34, 0x5e, 0x6c, 0xdd, 0xfb, 0xf1, 0x79, 0xef, 0xde, 0xe7, 0xa3, 0x77, 0x02, 0x4a, 0x44,
+	0xc2, 0x10, 0xd7, 0x22, 0x1c, 0xd7, 0x0e, 0x6e, 0x76, 0x48, 0x84, 0x6f, 0xd6, 0x1c, 0xe2, 0x13,
+	0xe6, 0xb2, 0x6a, 0x10, 0xd2, 0x88, 0xc2, 0x15, 0x1e, 0x50, 0x8d, 0x70, 0x5c, 0x4d, 0x03, 0xca,
+	0xab, 0x0e, 0x75, 0x28, 0xf7, 0xd6, 0x92, 0x4f, 0x22, 0xb0, 0x2c, 0x5b, 0x94, 0x79, 0x94, 0xd5,
+	0x3a, 0x98, 0x91, 0x11, 0x96, 0x45, 0x5d, 0x3f, 0xf5, 0xaf, 0x60, 0xcf, 0xf5, 0x69, 0x8d, 0xff,
+	0x15, 0x26, 0xf5, 0xb7, 0x2c, 0x58, 0x30, 0x70, 0x88, 0x3d, 0x06, 0x7b, 0x12, 0x00, 0x0e, 0x66,
+	0x66, 0x10, 0xba, 0x16, 0x61, 0x25, 0xa9, 0x92, 0x5d, 0xcf, 0xdf, 0x7a, 0xaf, 0x2a, 0x30, 0xab,
+	0x09, 0xe6, 0xb0, 0x7c, 0xb5, 0x41, 0xac, 0x3a, 0x75, 0x7d, 0xad, 0xf9, 0xaa, 0xaf, 0x64, 0x06,
+	0x7d, 0x65, 0xe5, 0x10, 0x7b, 0xdd, 0x0d, 0x75, 0x9c, 0xad, 0xfe, 0xfa, 0xa7, 0x72, 0xdd, 0x71,
+	0xa3, 0xbd, 0xfd, 0x4e, 0xd5, 0xa2, 0x5e, 0x2d, 0x6d, 0x4c, 0xfc, 0xbb, 0xc1, 0xec, 0xa7, 0xb5,
+	0xe8, 0x30, 0x20, 0x6c, 0x08, 0xc4, 0x7e, 0x79, 0xfb, 0xf2, 0x9a, 0x84, 0xce, 0x3b, 0x98, 0x19,
+	0x3c, 0x1f, 0x22, 0xb0, 0xd8, 0xd9, 0x0f, 0x7d, 0x33, 0xc2, 0xb1, 0x19, 0xe2, 0x88, 0x94, 0xe6,
+	0x2a, 0xd2, 0xfa, 0x79, 0xad, 0x9a, 0x14, 0xfc, 0xa3, 0xaf, 0x5c, 0x7d, 0x37, 0x6c, 0x94, 0x4f,
+	0x40, 0xda, 0x38, 0x46, 0x38, 0x22, 0xf0, 0x09, 0x00, 0xbb, 0x84, 0x98, 0x36, 0xf1, 0xa9, 0xc7,
+	0x4a, 0x59, 0x7e, 0xbf, 0xb5, 0xea, 0x89, 0xe1, 0x56, 0xb7, 0x08, 0x69, 0x24, 0x31, 0x9a, 0x3c,
+	0x7d, 0xbd, 0x71, 0xb2, 0x9a, 0xf6, 0xbb, 0x9b, 0x46, 0x32, 0xb8, 0x0b, 0x16, 0x93, 0xdb, 0xdb,
+	0x2e, 0xb3, 0xe8, 0xbe, 0x1f, 0xb1, 0x52, 0x8e, 0xc3, 0xcb, 0xa7, 0xc0, 0xdf, 0xc1, 0xac, 0x91,
+	0x86, 0x69, 0x57, 0xd2, 0x0a, 0xab, 0xe3, 0x01, 0x8e, 0x20, 0xd2, 0x22, 0x05, 0x67, 0x1c, 0xcf,
+	0x36, 0xd6, 0x7e, 0x7c, 0xae, 0x48, 0xbd, 0xb7, 0x2f, 0xaf, 0x41, 0xa1, 0x9a, 0x98, 0xeb, 0x46,
+	0x30, 0xa8, 0xfe, 0x23, 0x81, 0x73, 0xc3, 0xe6, 0xe1, 0x55, 0x30, 0xcf, 0x9b, 0x2d, 0x49, 0x7c,
+	0x72, 0xc5, 0x41, 0x5f, 0x29, 0x88, 0x2a, 0xdc, 0xac, 0x22, 0xe1, 0x86, 0x1b, 0xa0, 0xe0, 0xe3,
+	0xc8, 0x3d, 0x48, 0xef, 0x96, 0x0e, 0xfa, 0xd2, 0xa0, 0xaf, 0x5c, 0x10, 0xe1, 0x93, 0x5e, 0x15,
+	0xe5, 0xc5, 0x51, 0xd4, 0xf8, 0x1a, 0x2c, 0x5b, 0xd4, 0x3f, 0x20, 0x21, 0x73, 0xa9, 0x2f, 0x78,
+	0xca, 0xf2, 0xf4, 0xbb, 0x67, 0xe3, 0x69, 0xd0, 0x57, 0x2e, 0x8a, 0x62, 0x33, 0x70, 0x2a, 0x5a,
+	0x1a, 0x5b, 0x12, 0x12, 0x37, 0xce, 0x25, 0x03, 0xf8, 0xfb, 0xb9, 0x22, 0xa9, 0x2f, 0xb2, 0x20,
+	0x3f, 0x31, 0x4b, 0xf8, 0x29, 0x28, 0x78, 0xcc, 0x31, 0x13, 0x50, 0x73, 0x3f, 0xec, 0x96, 0xa4,
+	0xd9, 0x8b, 0x4c, 0x7a, 0x55, 0x04, 0x3c, 0xe6, 0xb4, 0x0f, 0x03, 0xb2, 0x13, 0x76, 0xe1, 0x37,
+	0x60, 0x75, 0xa4, 0x5d, 0xd3, 0xdb, 0xef, 0x46, 0x6e, 0xd0, 0x75, 0x49, 0x98, 0xce, 0xa2, 0x79,
+	0xe6, 0xcb, 0xac, 0xcd, 0x7c, 0x1f, 0x26, 0x30, 0x55, 0x04, 0x87, 0x2a, 0x6f, 0x8e, 0x8c, 0xf0,
+	0x73, 0x00, 0x3d, 0x1c, 0x9b, 0x51, 0xcc, 0xcc, 0x80, 0x84, 0xe6, 0x33, 0xd7, 0xb7, 0xe9, 0x33,
+	0x3e, 0xcb, 0x9c, 0xf6, 0xfe, 0xa0, 0xaf, 0x5c, 0x4e, 0x6f, 0x70, 0x22, 0x46, 0x45, 0xcb, 0x1e,
+	0x8e, 0xdb, 0x31, 0x33, 0x48, 0xf8, 0x25, 0xb7, 0xc0, 0xcf, 0xc0, 0xa2, 0xf0, 0x99, 0x9d, 0x2e,
+	0xb5, 0x9e, 0x26, 0x52, 0x4c, 0x60, 0x4a, 0x63, 0x99, 0x4d, 0xb9, 0x55, 0x54, 0x10, 0x67, 0x8d,
+	0x1f, 0x61, 0x1d, 0x2c, 0xe3, 0x6e, 0x97, 0x3e, 0x23, 0xb6, 0xc9, 0x5c, 0xc7, 0x27, 0x21, 0x2b,
+	0xcd, 0x57, 0xb2, 0xeb, 0xe7, 0xb5, 0xf2, 0x98, 0xa5, 0x99, 0x00, 0x15, 0x2d, 0xa5, 0x96, 0x96,
+	0x30, 0x4c, 0xb0, 0x74, 0x1f, 0x14, 0x27, 0x48, 0xda, 0x61, 0xd8, 0x21, 0xf0, 0x0a, 0x48, 0x4b,
+	0x9a, 0x2c, 0xc2, 0x61, 0xc4, 0x99, 0xca, 0xa2, 0xbc, 0xb0, 0xb5, 0x12, 0x13, 0x5c, 0x05, 0xf3,
+	0x3c, 0x81, 0x53, 0x90, 0x43, 0xe2, 0xa0, 0x1e, 0xcf, 0x81, 0x95, 0x3a, 0xf5, 0xa3, 0x10, 0x5b,
+	0x51, 0x1b, 0xc7, 0x06, 0xed, 0xba, 0xd6, 0x21, 0xdc, 0x02, 0x45, 0x2b, 0x35, 0x9a, 0xd8, 0xb6,
+	0x43, 0xc2, 0x58, 0x4a, 0xfe, 0xda, 0xa0, 0xaf, 0x5c, 0x1a, 0x09, 0x6b, 0x2a, 0x42, 0x45, 0xcb,
+	0x43, 0xd3, 0xa6, 0xb0, 0xc0, 0xeb, 0xe0, 0x7f, 0x16, 0xb5, 0x89, 0xe9, 0xda, 0xa2, 0xaa, 0x06,
+	0x07, 0x7d, 0x65, 0x69, 0x98, 0xce, 0x1d, 0x2a, 0x5a, 0x48, 0x3e, 0xdd, 0xb3, 0xa1, 0x0e, 0x72,
+	0x1e, 0xb5, 0x85, 0xde, 0x97, 0x6e, 0x55, 0x4e, 0xf9, 0x9e, 0x8f, 0x1a, 0x6c, 0x52, 0x9b, 0x68,
+	0xcb, 0x83, 0xbe, 0x92, 0x4f, 0x59, 0xa4, 0x36, 0x51, 0x11, 0x4f, 0x87, 0xdf, 0x4a, 0x60, 0xd1,
+	0xc2, 0x01, 0x67, 0x94, 0xf3, 0x91, 0x2e, 0x8e, 0xcb, 0xa7, 0xee, 0x5d, 0xbe, 0x74, 0xef, 0x4e,
+	0xef, 0x8c, 0xa9, 0xec, 0x64, 0xef, 0xae, 0xbf, 0x83, 0x4c, 0x13, 0x20, 0x86, 0xf2, 0x16, 0x0e,
+	0x0c, 0x12, 0x72, 0xe2, 0x27, 0x28, 0xfb, 0x5e, 0x02, 0xc5, 0x89, 0x29, 0x0b, 0xce, 0x2e, 0x82,
+	0x85, 0x3d, 0xe2, 0x3a, 0x7b, 0x43, 0xb6, 0xd2, 0x13, 0xc4, 0x60, 0x3e, 0xc2, 0x31, 0x61, 0xa5,
+	0xb9, 0xff, 0xea, 0xfb, 0xe3, 0xa4, 0xef, 0x33, 0xf5, 0x27, 0x90, 0xd5, 0x9f, 0x25, 0x50, 0xb8,
+	0x23, 0x5e, 0xc4, 0x56, 0x94, 0x2c, 0xf2, 0x4f, 0xc0, 0x42, 0xc0, 0x37, 0x1e, 0xef, 0x25, 0x29,
+	0x7a, 0x72, 0xfa, 0x62, 0x25, 0x6a, 0xb9, 0xa4, 0x28, 0x4a, 0xc3, 0xe1, 0x57, 0xe0, 0xff, 0x23,
+	0x1d, 0x24, 0x2f, 0x4b, 0x90, 0xf0, 0xe3, 0x8e, 0x9a, 0xff, 0xf0, 0x14, 0x9c, 0x13, 0x72, 0x4b,
+	0x21, 0x2f, 0x58, 0x33, 0x0e, 0x97, 0xb0, 0x6b, 0x3f, 0xcd, 0x81, 0xc5, 0x29, 0xda, 0xa1, 0x06,
+	0xe4, 0xf6, 0xe6, 0x23, 0xd3, 0xd8, 0x7e, 0x70, 0xaf, 0xfe, 0xd8, 0x6c, 0x6e, 0x37, 0x74, 0x13,
+	0xe9, 0x5f, 0xe8, 0xa8, 0xa5, 0x9b, 0xf5, 0xbb, 0x9b, 0xe8, 0x8e, 0x5e, 0xcc, 0x94, 0xe5, 0xde,
+	0x51, 0xa5, 0x3c, 0x95, 0x86, 0x48, 0xb2, 0xf2, 0x48, 0x7d, 0x0f, 0x87, 0x0e, 0x81, 0xb7, 0xc1,
+	0xc5, 0x59, 0x0c, 0xfd, 0x91, 0xde, 0x34, 0xda, 0x45, 0xa9, 0x7c, 0xa9, 0x77, 0x54, 0xb9, 0x30,
+	0x95, 0xab, 0xc7, 0xc4, 0x0b, 0x22, 0x78, 0x1f, 0x7c, 0x30, 0x9b, 0xd4, 0xd2, 0x1f, 0x36, 0x74,
+	0x64, 0x1a, 0x9b, 0x8f, 0x5b, 0xe6, 0x8e, 0xb1, 0x85, 0xb6, 0x1f, 0xb6, 0x8b, 0x73, 0x65, 0xb5,
+	0x77, 0x54, 0x91, 0xa7, 0x10, 0x5a, 0xc4, 0xb7, 0x49, 0x68, 0xe0, 0x43, 0xb6, 0x13, 0xec, 0x86,
+	0xd4, 0x8f, 0xa0, 0x0e, 0x2a, 0xb3, 0x60, 0xf5, 0x4d, 0xc3, 0xd0, 0x1b, 0xa6, 0xa1, 0x23, 0x53,
+	0x7b, 0xb0, 0x5d, 0xbf, 0x5f, 0xcc, 0x96, 0x95, 0xde, 0x51, 0x65, 0x6d, 0x0a, 0xa9, 0x8e, 0x83,
+	0x80, 0xd8, 0x43, 0x89, 0x95, 0x73, 0xdf, 0xbd, 0x90, 0x33, 0xda, 0xd6, 0xab, 0x37, 0xb2, 0xf4,
+	0xfa, 0x8d, 0x2c, 0xfd, 0xf5, 0x46, 0x96, 0x7e, 0x38, 0x96, 0x33, 0xaf, 0x8f, 0xe5, 0xcc, 0xef,
+	0xc7, 0x72, 0xe6, 0xc9, 0x47, 0x93, 0xca, 0xe8, 0x62, 0xc6, 0x5c, 0xeb, 0x86, 0x78, 0xe6, 0x2c,
+	0x1a, 0x92, 0xda, 0xc1, 0xed, 0xf4, 0xb9, 0xe3, 0x1a, 0xe9, 0x2c, 0xf0, 0x5f, 0x30, 0xb7, 0xff,
+	0x1d, 0x00, 0x36, 0xea, 0xf5, 0x95, 0x40, 0x09, 0x00, 0x00,
+}
+
+func (this *FeeDenom) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeeDenom)
+	if !ok {
+		that2, ok := that.(FeeDenom)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.NativeDenom != that1.NativeDenom {
+		return false
+	}
+	if !this.ConversionRate.Equal(that1.ConversionRate) {
+		return false
+	}
+	return true
+}
+func (this *GasDiscount) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GasDiscount)
+	if !ok {
+		that2, ok := that.(GasDiscount)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MsgTypeUrl != that1.MsgTypeUrl {
+		return false
+	}
+	if !this.GasPriceMultiplier.Equal(that1.GasPriceMultiplier) {
+		return false
+	}
+	if this.MaxTxsPerWindow != that1.MaxTxsPerWindow {
+		return false
+	}
+	if this.WindowBlocks != that1.WindowBlocks {
+		return false
+	}
+	if len(this.AllowedSigners) != len(that1.AllowedSigners) {
+		return false
+	}
+	for i := range this.AllowedSigners {
+		if this.AllowedSigners[i] != that1.AllowedSigners[i] {
+			return false
+		}
+	}
+	return true
 }
+func (this *ContractTaxPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
+	that1, ok := that.(*ContractTaxPolicy)
+	if !ok {
+		that2, ok := that.(ContractTaxPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ContractAddress != that1.ContractAddress {
+		return false
+	}
+	if this.CodeId != that1.CodeId {
+		return false
+	}
+	if this.Mode != that1.Mode {
+		return false
+	}
+	if len(this.CapPerBlock) != len(that1.CapPerBlock) {
+		return false
+	}
+	for i := range this.CapPerBlock {
+		if !this.CapPerBlock[i].Equal(&that1.CapPerBlock[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -173,6 +723,34 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.GasDiscounts) > 0 {
+		for iNdEx := len(m.GasDiscounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasDiscounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.FeeDenoms) > 0 {
+		for iNdEx := len(m.FeeDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size := m.BurnTaxRate.Size()
 		i -= size
@@ -193,92 +771,1071 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i -= size
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.GasPrices) > 0 {
-		for _, e := range m.GasPrices {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ConversionRate.Size()
+		i -= size
+		if _, err := m.ConversionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.NativeDenom) > 0 {
+		i -= len(m.NativeDenom)
+		copy(dAtA[i:], m.NativeDenom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.NativeDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasDiscount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasDiscount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasDiscount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedSigners) > 0 {
+		for iNdEx := len(m.AllowedSigners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedSigners[iNdEx])
+			copy(dAtA[i:], m.AllowedSigners[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AllowedSigners[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.WindowBlocks != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.WindowBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxTxsPerWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxTxsPerWindow))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.GasPriceMultiplier.Size()
+		i -= size
+		if _, err := m.GasPriceMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasDiscountUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasDiscountUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasDiscountUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.WindowStart != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.WindowStart))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractTaxPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractTaxPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractTaxPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CapPerBlock) > 0 {
+		for iNdEx := len(m.CapPerBlock) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CapPerBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Mode != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CodeId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CodeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractTaxUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractTaxUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractTaxUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Taxes) > 0 {
+		for iNdEx := len(m.Taxes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Taxes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractTaxPolicies) > 0 {
+		for iNdEx := len(m.ContractTaxPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractTaxPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GasPrices) > 0 {
+		for _, e := range m.GasPrices {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.BurnTaxRate.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.FeeDenoms) > 0 {
+		for _, e := range m.FeeDenoms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.GasDiscounts) > 0 {
+		for _, e := range m.GasDiscounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.NativeDenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.ConversionRate.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *GasDiscount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.GasPriceMultiplier.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.MaxTxsPerWindow != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxTxsPerWindow))
+	}
+	if m.WindowBlocks != 0 {
+		n += 1 + sovGenesis(uint64(m.WindowBlocks))
+	}
+	if len(m.AllowedSigners) > 0 {
+		for _, s := range m.AllowedSigners {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GasDiscountUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WindowStart != 0 {
+		n += 1 + sovGenesis(uint64(m.WindowStart))
+	}
+	if m.Count != 0 {
+		n += 1 + sovGenesis(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *ContractTaxPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.CodeId != 0 {
+		n += 1 + sovGenesis(uint64(m.CodeId))
+	}
+	if m.Mode != 0 {
+		n += 1 + sovGenesis(uint64(m.Mode))
+	}
+	if len(m.CapPerBlock) > 0 {
+		for _, e := range m.CapPerBlock {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContractTaxUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	if len(m.Taxes) > 0 {
+		for _, e := range m.Taxes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ContractTaxPolicies) > 0 {
+		for _, e := range m.ContractTaxPolicies {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasPrices = append(m.GasPrices, types.DecCoin{})
+			if err := m.GasPrices[len(m.GasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnTaxRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnTaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenoms = append(m.FeeDenoms, FeeDenom{})
+			if err := m.FeeDenoms[len(m.FeeDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasDiscounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasDiscounts = append(m.GasDiscounts, GasDiscount{})
+			if err := m.GasDiscounts[len(m.GasDiscounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConversionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasDiscount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasDiscount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasDiscount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPriceMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasPriceMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxsPerWindow", wireType)
+			}
+			m.MaxTxsPerWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTxsPerWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowBlocks", wireType)
+			}
+			m.WindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedSigners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedSigners = append(m.AllowedSigners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasDiscountUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasDiscountUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasDiscountUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			m.WindowStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowStart |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	l = m.BurnTaxRate.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
-}
 
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *ContractTaxPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -301,15 +1858,85 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractTaxPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractTaxPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasPrices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeId", wireType)
+			}
+			m.CodeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= TaxPolicyMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CapPerBlock", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -336,16 +1963,85 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GasPrices = append(m.GasPrices, types.DecCoin{})
-			if err := m.GasPrices[len(m.GasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CapPerBlock = append(m.CapPerBlock, types.Coin{})
+			if err := m.CapPerBlock[len(m.CapPerBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractTaxUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractTaxUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractTaxUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnTaxRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Taxes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -355,23 +2051,23 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BurnTaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Taxes = append(m.Taxes, types.Coin{})
+			if err := m.Taxes[len(m.Taxes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -458,6 +2154,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractTaxPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractTaxPolicies = append(m.ContractTaxPolicies, ContractTaxPolicy{})
+			if err := m.ContractTaxPolicies[len(m.ContractTaxPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
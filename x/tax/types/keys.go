@@ -1,5 +1,10 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	ModuleName = "tax"
 	StoreKey   = ModuleName
@@ -9,6 +14,14 @@ const (
 	ContextKeyTaxReverseCharge = "tax.reverse_charge"
 	ContextKeyTaxDue           = "tax.due"
 	ContextKeyTaxPayer         = "tax.payer"
+	// ContextKeyTaxCappedContract holds the contract whose dispatched messages
+	// are taxed up to the cap per block of its tax policy.
+	ContextKeyTaxCappedContract = "tax.capped_contract"
+	// ContextKeyTaxPrepaid holds the *PrepaidTax of the tx.
+	ContextKeyTaxPrepaid = "tax.prepaid"
+	// ContextKeyTaxUpfrontContract holds the contract whose dispatched messages
+	// are taxed unless the tax is covered by the tax prepaid for the contract.
+	ContextKeyTaxUpfrontContract = "tax.upfront_contract"
 
 	EventTypeTax                  = "tax_payment"
 	EventTypeTaxRefund            = "tax_refund"
@@ -16,9 +29,70 @@ const (
 	AttributeValueReverseCharge   = "true"
 	AttributeValueNoReverseCharge = "false"
 	AttributeKeyTaxAmount         = "tax_amount"
+
+	EventTypeFeeConversion      = "fee_conversion"
+	AttributeKeyFeeAmount       = "fee_amount"
+	AttributeKeyNativeFeeAmount = "native_fee_amount"
+
+	EventTypeSetContractTaxPolicy    = "set_contract_tax_policy"
+	EventTypeRemoveContractTaxPolicy = "remove_contract_tax_policy"
+	EventTypeContractTaxPolicy       = "contract_tax_policy"
+	AttributeKeyContractAddress      = "contract_address"
+	AttributeKeyCodeID               = "code_id"
+	AttributeKeyTaxPolicyMode        = "mode"
+	AttributeKeyCapPerBlock          = "cap_per_block"
 )
 
 // Key defines the store key for tax.
 var (
-	ParamsKey = []byte{0x1}
+	ParamsKey                  = []byte{0x1}
+	GasDiscountUsageKeyPrefix  = []byte{0x2}
+	ContractTaxPolicyKeyPrefix = []byte{0x3}
+	CodeTaxPolicyKeyPrefix     = []byte{0x4}
+	ContractTaxUsageKeyPrefix  = []byte{0x5}
+	// GasDiscountUsageExpiryKeyPrefix indexes the gas discount usages by the
+	// end height of their window.
+	GasDiscountUsageExpiryKeyPrefix = []byte{0x6}
 )
+
+// GetGasDiscountUsageKey returns the store key of the gas discount usage of a
+// signer for a message type.
+func GetGasDiscountUsageKey(signer sdk.AccAddress, msgTypeURL string) []byte {
+	return append(append(GasDiscountUsageKeyPrefix, address.MustLengthPrefix(signer)...), []byte(msgTypeURL)...)
+}
+
+// GetGasDiscountUsageExpiryPrefix returns the prefix of the usages whose
+// window ends at the given height.
+func GetGasDiscountUsageExpiryPrefix(height int64) []byte {
+	return append(GasDiscountUsageExpiryKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetGasDiscountUsageExpiryKey returns the index key of the gas discount usage
+// of a signer for a message type whose window ends at the given height.
+func GetGasDiscountUsageExpiryKey(height int64, signer sdk.AccAddress, msgTypeURL string) []byte {
+	return append(append(GetGasDiscountUsageExpiryPrefix(height), address.MustLengthPrefix(signer)...), []byte(msgTypeURL)...)
+}
+
+// ParseGasDiscountUsageExpiryKey returns the signer and the message type of a
+// gas discount usage expiry key.
+func ParseGasDiscountUsageExpiryKey(key []byte) (sdk.AccAddress, string) {
+	key = key[len(GasDiscountUsageExpiryKeyPrefix)+8:]
+	addrLen := int(key[0])
+	return sdk.AccAddress(key[1 : 1+addrLen]), string(key[1+addrLen:])
+}
+
+// GetContractTaxPolicyKey returns the store key of the tax policy of a contract.
+func GetContractTaxPolicyKey(contract sdk.AccAddress) []byte {
+	return append(ContractTaxPolicyKeyPrefix, address.MustLengthPrefix(contract)...)
+}
+
+// GetCodeTaxPolicyKey returns the store key of the tax policy of a code id.
+func GetCodeTaxPolicyKey(codeID uint64) []byte {
+	return append(CodeTaxPolicyKeyPrefix, sdk.Uint64ToBigEndian(codeID)...)
+}
+
+// GetContractTaxUsageKey returns the store key of the taxes charged to a
+// capped contract in the current block.
+func GetContractTaxUsageKey(contract sdk.AccAddress) []byte {
+	return append(ContractTaxUsageKeyPrefix, address.MustLengthPrefix(contract)...)
+}
@@ -5,10 +5,16 @@ import (
 )
 
 const (
-	TypeMsgUpdateParams = "update_params"
+	TypeMsgUpdateParams            = "update_params"
+	TypeMsgSetContractTaxPolicy    = "set_contract_tax_policy"
+	TypeMsgRemoveContractTaxPolicy = "remove_contract_tax_policy"
 )
 
-var _ sdk.Msg = &MsgUpdateParams{}
+var (
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgSetContractTaxPolicy{}
+	_ sdk.Msg = &MsgRemoveContractTaxPolicy{}
+)
 
 func (msg MsgUpdateParams) Route() string { return ModuleName }
 func (msg MsgUpdateParams) Type() string  { return TypeMsgUpdateParams }
@@ -24,3 +30,41 @@ func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
 	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
 	return []sdk.AccAddress{authority}
 }
+
+func (msg MsgSetContractTaxPolicy) Route() string { return ModuleName }
+func (msg MsgSetContractTaxPolicy) Type() string  { return TypeMsgSetContractTaxPolicy }
+func (msg MsgSetContractTaxPolicy) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return err
+	}
+
+	return msg.Policy.Validate()
+}
+
+func (msg MsgSetContractTaxPolicy) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSetContractTaxPolicy) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+func (msg MsgRemoveContractTaxPolicy) Route() string { return ModuleName }
+func (msg MsgRemoveContractTaxPolicy) Type() string  { return TypeMsgRemoveContractTaxPolicy }
+func (msg MsgRemoveContractTaxPolicy) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return err
+	}
+
+	return ValidateContractTaxPolicyTarget(msg.ContractAddress, msg.CodeId)
+}
+
+func (msg MsgRemoveContractTaxPolicy) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgRemoveContractTaxPolicy) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -51,5 +53,83 @@ func (p Params) Validate() error {
 	}*/
 	// gas prices can be empty in case of 0 gas price
 
+	seen := make(map[string]bool, len(p.FeeDenoms))
+	for _, feeDenom := range p.FeeDenoms {
+		if err := feeDenom.Validate(); err != nil {
+			return err
+		}
+
+		if seen[feeDenom.Denom] {
+			return fmt.Errorf("duplicate fee denom: %s", feeDenom.Denom)
+		}
+		seen[feeDenom.Denom] = true
+	}
+
+	seen = make(map[string]bool, len(p.GasDiscounts))
+	for _, discount := range p.GasDiscounts {
+		if err := discount.Validate(); err != nil {
+			return err
+		}
+
+		if seen[discount.MsgTypeUrl] {
+			return fmt.Errorf("duplicate gas discount: %s", discount.MsgTypeUrl)
+		}
+		seen[discount.MsgTypeUrl] = true
+	}
+
+	return nil
+}
+
+// NewFeeDenom returns a new FeeDenom. A zero conversion rate makes the
+// denom priced through the oracle.
+func NewFeeDenom(denom, nativeDenom string, conversionRate sdk.Dec) FeeDenom {
+	return FeeDenom{
+		Denom:          denom,
+		NativeDenom:    nativeDenom,
+		ConversionRate: conversionRate,
+	}
+}
+
+// Validate validates a fee denom entry.
+func (fd FeeDenom) Validate() error {
+	if err := sdk.ValidateDenom(fd.Denom); err != nil {
+		return fmt.Errorf("invalid fee denom: %w", err)
+	}
+
+	if err := sdk.ValidateDenom(fd.NativeDenom); err != nil {
+		return fmt.Errorf("invalid native denom for fee denom %s: %w", fd.Denom, err)
+	}
+
+	if fd.Denom == fd.NativeDenom {
+		return fmt.Errorf("fee denom %s cannot be converted into itself", fd.Denom)
+	}
+
+	if fd.ConversionRate.IsNil() || fd.ConversionRate.IsNegative() {
+		return fmt.Errorf("conversion rate of fee denom %s must be zero or positive: %s", fd.Denom, fd.ConversionRate)
+	}
+
+	return nil
+}
+
+// Validate validates a gas discount entry.
+func (gd GasDiscount) Validate() error {
+	if len(gd.MsgTypeUrl) == 0 || gd.MsgTypeUrl[0] != '/' {
+		return fmt.Errorf("invalid gas discount msg type url: %q", gd.MsgTypeUrl)
+	}
+
+	if gd.GasPriceMultiplier.IsNil() || gd.GasPriceMultiplier.IsNegative() || gd.GasPriceMultiplier.GT(sdk.OneDec()) {
+		return fmt.Errorf("gas price multiplier of %s must be between 0 and 1: %s", gd.MsgTypeUrl, gd.GasPriceMultiplier)
+	}
+
+	if gd.MaxTxsPerWindow > 0 && gd.WindowBlocks == 0 {
+		return fmt.Errorf("gas discount of %s must define window blocks when rate limited", gd.MsgTypeUrl)
+	}
+
+	for _, signer := range gd.AllowedSigners {
+		if _, err := sdk.AccAddressFromBech32(signer); err != nil {
+			return fmt.Errorf("invalid allowed signer of gas discount %s: %w", gd.MsgTypeUrl, err)
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,44 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PrepaidTax tracks the taxes a tx sender paid with the fee for the funds sent
+// to sender pays upfront contracts. They cover the taxes of the messages the
+// contracts dispatch, used coverage is not restored if a dispatch fails.
+type PrepaidTax struct {
+	contracts map[string]sdk.Coins
+}
+
+// NewPrepaidTax returns a PrepaidTax without coverage.
+func NewPrepaidTax() *PrepaidTax {
+	return &PrepaidTax{contracts: map[string]sdk.Coins{}}
+}
+
+// Add adds taxes to the coverage of contract.
+func (p *PrepaidTax) Add(contract string, taxes sdk.Coins) {
+	if taxes.IsZero() {
+		return
+	}
+
+	p.contracts[contract] = p.contracts[contract].Add(taxes...)
+}
+
+// Get returns the remaining coverage of contract.
+func (p *PrepaidTax) Get(contract string) sdk.Coins {
+	return p.contracts[contract]
+}
+
+// Cover uses the coverage of contract for taxes and returns the taxes which
+// are not covered.
+func (p *PrepaidTax) Cover(contract string, taxes sdk.Coins) sdk.Coins {
+	remaining := p.contracts[contract]
+	if remaining.IsZero() {
+		return taxes
+	}
+
+	covered := taxes.Min(remaining)
+	p.contracts[contract] = remaining.Sub(covered...)
+	return taxes.Sub(covered...)
+}
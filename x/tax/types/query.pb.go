@@ -184,42 +184,323 @@ func (m *QueryBurnTaxRateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryBurnTaxRateResponse proto.InternalMessageInfo
 
+type QueryGasDiscountsRequest struct {
+}
+
+func (m *QueryGasDiscountsRequest) Reset()         { *m = QueryGasDiscountsRequest{} }
+func (m *QueryGasDiscountsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGasDiscountsRequest) ProtoMessage()    {}
+func (*QueryGasDiscountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_320070565a800820, []int{4}
+}
+func (m *QueryGasDiscountsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGasDiscountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGasDiscountsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGasDiscountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGasDiscountsRequest.Merge(m, src)
+}
+func (m *QueryGasDiscountsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGasDiscountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGasDiscountsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGasDiscountsRequest proto.InternalMessageInfo
+
+type QueryGasDiscountsResponse struct {
+	GasDiscounts []GasDiscount `protobuf:"bytes,1,rep,name=gas_discounts,json=gasDiscounts,proto3" json:"gas_discounts"`
+}
+
+func (m *QueryGasDiscountsResponse) Reset()         { *m = QueryGasDiscountsResponse{} }
+func (m *QueryGasDiscountsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGasDiscountsResponse) ProtoMessage()    {}
+func (*QueryGasDiscountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_320070565a800820, []int{5}
+}
+func (m *QueryGasDiscountsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGasDiscountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGasDiscountsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGasDiscountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGasDiscountsResponse.Merge(m, src)
+}
+func (m *QueryGasDiscountsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGasDiscountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGasDiscountsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGasDiscountsResponse proto.InternalMessageInfo
+
+func (m *QueryGasDiscountsResponse) GetGasDiscounts() []GasDiscount {
+	if m != nil {
+		return m.GasDiscounts
+	}
+	return nil
+}
+
+type QueryContractTaxPoliciesRequest struct {
+}
+
+func (m *QueryContractTaxPoliciesRequest) Reset()         { *m = QueryContractTaxPoliciesRequest{} }
+func (m *QueryContractTaxPoliciesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContractTaxPoliciesRequest) ProtoMessage()    {}
+func (*QueryContractTaxPoliciesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_320070565a800820, []int{6}
+}
+func (m *QueryContractTaxPoliciesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractTaxPoliciesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractTaxPoliciesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContractTaxPoliciesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractTaxPoliciesRequest.Merge(m, src)
+}
+func (m *QueryContractTaxPoliciesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractTaxPoliciesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractTaxPoliciesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractTaxPoliciesRequest proto.InternalMessageInfo
+
+type QueryContractTaxPoliciesResponse struct {
+	Policies []ContractTaxPolicy `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies"`
+}
+
+func (m *QueryContractTaxPoliciesResponse) Reset()         { *m = QueryContractTaxPoliciesResponse{} }
+func (m *QueryContractTaxPoliciesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContractTaxPoliciesResponse) ProtoMessage()    {}
+func (*QueryContractTaxPoliciesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_320070565a800820, []int{7}
+}
+func (m *QueryContractTaxPoliciesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractTaxPoliciesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractTaxPoliciesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContractTaxPoliciesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractTaxPoliciesResponse.Merge(m, src)
+}
+func (m *QueryContractTaxPoliciesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractTaxPoliciesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractTaxPoliciesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractTaxPoliciesResponse proto.InternalMessageInfo
+
+func (m *QueryContractTaxPoliciesResponse) GetPolicies() []ContractTaxPolicy {
+	if m != nil {
+		return m.Policies
+	}
+	return nil
+}
+
+type QueryContractTaxPolicyRequest struct {
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+}
+
+func (m *QueryContractTaxPolicyRequest) Reset()         { *m = QueryContractTaxPolicyRequest{} }
+func (m *QueryContractTaxPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContractTaxPolicyRequest) ProtoMessage()    {}
+func (*QueryContractTaxPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_320070565a800820, []int{8}
+}
+func (m *QueryContractTaxPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractTaxPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractTaxPolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContractTaxPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractTaxPolicyRequest.Merge(m, src)
+}
+func (m *QueryContractTaxPolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractTaxPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractTaxPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractTaxPolicyRequest proto.InternalMessageInfo
+
+func (m *QueryContractTaxPolicyRequest) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+type QueryContractTaxPolicyResponse struct {
+	// policy is the policy applied to the contract, the reverse charge default
+	// if no policy is registered.
+	Policy ContractTaxPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy"`
+	// source is where the policy is registered: contract, code or default.
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+}
+
+func (m *QueryContractTaxPolicyResponse) Reset()         { *m = QueryContractTaxPolicyResponse{} }
+func (m *QueryContractTaxPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContractTaxPolicyResponse) ProtoMessage()    {}
+func (*QueryContractTaxPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_320070565a800820, []int{9}
+}
+func (m *QueryContractTaxPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractTaxPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractTaxPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContractTaxPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractTaxPolicyResponse.Merge(m, src)
+}
+func (m *QueryContractTaxPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractTaxPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractTaxPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractTaxPolicyResponse proto.InternalMessageInfo
+
+func (m *QueryContractTaxPolicyResponse) GetPolicy() ContractTaxPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return ContractTaxPolicy{}
+}
+
+func (m *QueryContractTaxPolicyResponse) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "terra.tax.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "terra.tax.v1beta1.QueryParamsResponse")
 	proto.RegisterType((*QueryBurnTaxRateRequest)(nil), "terra.tax.v1beta1.QueryBurnTaxRateRequest")
 	proto.RegisterType((*QueryBurnTaxRateResponse)(nil), "terra.tax.v1beta1.QueryBurnTaxRateResponse")
+	proto.RegisterType((*QueryGasDiscountsRequest)(nil), "terra.tax.v1beta1.QueryGasDiscountsRequest")
+	proto.RegisterType((*QueryGasDiscountsResponse)(nil), "terra.tax.v1beta1.QueryGasDiscountsResponse")
+	proto.RegisterType((*QueryContractTaxPoliciesRequest)(nil), "terra.tax.v1beta1.QueryContractTaxPoliciesRequest")
+	proto.RegisterType((*QueryContractTaxPoliciesResponse)(nil), "terra.tax.v1beta1.QueryContractTaxPoliciesResponse")
+	proto.RegisterType((*QueryContractTaxPolicyRequest)(nil), "terra.tax.v1beta1.QueryContractTaxPolicyRequest")
+	proto.RegisterType((*QueryContractTaxPolicyResponse)(nil), "terra.tax.v1beta1.QueryContractTaxPolicyResponse")
 }
 
 func init() { proto.RegisterFile("terra/tax/v1beta1/query.proto", fileDescriptor_320070565a800820) }
 
 var fileDescriptor_320070565a800820 = []byte{
-	// 395 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0x4b, 0xe3, 0x40,
-	0x00, 0x85, 0x93, 0xb2, 0xdb, 0xdd, 0x9d, 0x9e, 0x76, 0xb6, 0xb0, 0x6d, 0x76, 0x37, 0xed, 0x06,
-	0x2c, 0x45, 0xed, 0x0c, 0x6d, 0x0f, 0xde, 0x83, 0x08, 0x5e, 0x44, 0x83, 0x27, 0x2f, 0x65, 0x12,
-	0x87, 0x18, 0x6c, 0x33, 0xe9, 0xcc, 0xa4, 0xa4, 0x1e, 0xfd, 0x05, 0x05, 0xc1, 0xdf, 0xd4, 0x63,
-	0xc1, 0x8b, 0x78, 0x28, 0xd2, 0xfa, 0x43, 0xa4, 0x93, 0x14, 0x2a, 0xa9, 0xe8, 0x29, 0x61, 0xde,
-	0xcb, 0x7b, 0x2f, 0x1f, 0x03, 0xfe, 0x49, 0xca, 0x39, 0xc1, 0x92, 0x24, 0x78, 0xd4, 0x76, 0xa9,
-	0x24, 0x6d, 0x3c, 0x8c, 0x29, 0x1f, 0xa3, 0x88, 0x33, 0xc9, 0xe0, 0x4f, 0x25, 0x23, 0x49, 0x12,
-	0x94, 0xc9, 0x46, 0xd9, 0x67, 0x3e, 0x53, 0x2a, 0x5e, 0xbd, 0xa5, 0x46, 0xe3, 0xaf, 0xcf, 0x98,
-	0xdf, 0xa7, 0x98, 0x44, 0x01, 0x26, 0x61, 0xc8, 0x24, 0x91, 0x01, 0x0b, 0x45, 0xa6, 0xd6, 0xf2,
-	0x2d, 0x3e, 0x0d, 0xa9, 0x08, 0x32, 0x83, 0x55, 0x06, 0xf0, 0x6c, 0x55, 0x7b, 0x4a, 0x38, 0x19,
-	0x08, 0x87, 0x0e, 0x63, 0x2a, 0xa4, 0x75, 0x02, 0x7e, 0xbd, 0x39, 0x15, 0x11, 0x0b, 0x05, 0x85,
-	0x07, 0xa0, 0x18, 0xa9, 0x93, 0x8a, 0x5e, 0xd7, 0x9b, 0xa5, 0x4e, 0x15, 0xe5, 0x56, 0xa2, 0xf4,
-	0x13, 0xfb, 0xcb, 0x74, 0x5e, 0xd3, 0x9c, 0xcc, 0x6e, 0x55, 0xc1, 0x6f, 0x95, 0x67, 0xc7, 0x3c,
-	0x3c, 0x27, 0x89, 0x43, 0x24, 0x5d, 0x57, 0x51, 0x50, 0xc9, 0x4b, 0x59, 0xdf, 0x31, 0xf8, 0x2e,
-	0x49, 0xd2, 0xe3, 0x44, 0x52, 0xd5, 0xf8, 0xc3, 0x46, 0xab, 0xd8, 0xa7, 0x79, 0xad, 0xe1, 0x07,
-	0xf2, 0x2a, 0x76, 0x91, 0xc7, 0x06, 0xd8, 0x63, 0x62, 0xc0, 0x44, 0xf6, 0x68, 0x89, 0xcb, 0x6b,
-	0x2c, 0xc7, 0x11, 0x15, 0xe8, 0x90, 0x7a, 0xce, 0x37, 0x99, 0x46, 0x76, 0xee, 0x0b, 0xe0, 0xab,
-	0xea, 0x81, 0x37, 0xa0, 0x98, 0x6e, 0x84, 0x3b, 0x5b, 0xe6, 0xe7, 0x61, 0x18, 0x8d, 0x8f, 0x6c,
-	0xe9, 0x5a, 0xeb, 0xff, 0xed, 0xc3, 0xcb, 0x5d, 0xe1, 0x0f, 0xac, 0xe2, 0x3c, 0xf4, 0x94, 0x03,
-	0x9c, 0xe8, 0xa0, 0xb4, 0xf1, 0xa3, 0x70, 0xf7, 0xbd, 0xe8, 0x3c, 0x28, 0x63, 0xef, 0x53, 0xde,
-	0x6c, 0x4b, 0x53, 0x6d, 0xb1, 0x60, 0x7d, 0xcb, 0x16, 0x37, 0xe6, 0x61, 0x6f, 0xcd, 0xd5, 0x3e,
-	0x9a, 0x2e, 0x4c, 0x7d, 0xb6, 0x30, 0xf5, 0xe7, 0x85, 0xa9, 0x4f, 0x96, 0xa6, 0x36, 0x5b, 0x9a,
-	0xda, 0xe3, 0xd2, 0xd4, 0x2e, 0xf6, 0x37, 0x19, 0xf7, 0x89, 0x10, 0x81, 0xd7, 0x4a, 0xd3, 0x3c,
-	0xc6, 0x29, 0x1e, 0x75, 0x71, 0xa2, 0x72, 0x15, 0x6d, 0xb7, 0xa8, 0xee, 0x53, 0xf7, 0x35, 0x00,
-	0x00, 0xff, 0xff, 0xad, 0x12, 0x5b, 0x0a, 0xd8, 0x02, 0x00, 0x00,
+	// 642 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0xcf, 0x4e, 0x13, 0x41,
+	0x1c, 0xc7, 0xbb, 0xa8, 0x2b, 0x0c, 0x18, 0x65, 0x20, 0xda, 0xae, 0xb2, 0x2d, 0x1b, 0x25, 0x15,
+	0x61, 0x07, 0xca, 0xc1, 0x83, 0xf1, 0x60, 0x21, 0x18, 0x3d, 0x18, 0x6c, 0x38, 0x79, 0x69, 0xa6,
+	0xdb, 0x71, 0x5d, 0xa5, 0x3b, 0xcb, 0xcc, 0x2c, 0xd9, 0xfa, 0xe7, 0xe2, 0x13, 0x90, 0xf0, 0x00,
+	0xbe, 0x82, 0x2f, 0xe0, 0x9d, 0x23, 0x89, 0x17, 0xe3, 0x81, 0x98, 0xd6, 0x07, 0x31, 0x9d, 0x9d,
+	0x6d, 0x0a, 0xbb, 0x1b, 0xdb, 0x53, 0xdb, 0xf9, 0xfd, 0xf9, 0x7e, 0xa6, 0xfd, 0x7e, 0xb7, 0x60,
+	0x49, 0x10, 0xc6, 0x30, 0x12, 0x38, 0x42, 0x47, 0x9b, 0x2d, 0x22, 0xf0, 0x26, 0x3a, 0x0c, 0x09,
+	0xeb, 0xda, 0x01, 0xa3, 0x82, 0xc2, 0x79, 0x59, 0xb6, 0x05, 0x8e, 0x6c, 0x55, 0x36, 0x16, 0x5d,
+	0xea, 0x52, 0x59, 0x45, 0x83, 0x77, 0x71, 0xa3, 0x71, 0xcf, 0xa5, 0xd4, 0x3d, 0x20, 0x08, 0x07,
+	0x1e, 0xc2, 0xbe, 0x4f, 0x05, 0x16, 0x1e, 0xf5, 0xb9, 0xaa, 0x96, 0xd3, 0x2a, 0x2e, 0xf1, 0x09,
+	0xf7, 0x54, 0x83, 0xb5, 0x08, 0xe0, 0xeb, 0x81, 0xec, 0x1e, 0x66, 0xb8, 0xc3, 0x1b, 0xe4, 0x30,
+	0x24, 0x5c, 0x58, 0xaf, 0xc0, 0xc2, 0x85, 0x53, 0x1e, 0x50, 0x9f, 0x13, 0xf8, 0x18, 0xe8, 0x81,
+	0x3c, 0x29, 0x6a, 0x15, 0xad, 0x3a, 0x5b, 0x2b, 0xd9, 0x29, 0x4a, 0x3b, 0x1e, 0xa9, 0x5f, 0x3d,
+	0x3d, 0x2f, 0x17, 0x1a, 0xaa, 0xdd, 0x2a, 0x81, 0x3b, 0x72, 0x5f, 0x3d, 0x64, 0xfe, 0x3e, 0x8e,
+	0x1a, 0x58, 0x90, 0x44, 0x8a, 0x80, 0x62, 0xba, 0xa4, 0xf4, 0x5e, 0x80, 0x69, 0x81, 0xa3, 0x26,
+	0xc3, 0x82, 0x48, 0xc5, 0x99, 0xba, 0x3d, 0x58, 0xfb, 0xfb, 0xbc, 0xbc, 0xe2, 0x7a, 0xe2, 0x5d,
+	0xd8, 0xb2, 0x1d, 0xda, 0x41, 0x0e, 0xe5, 0x1d, 0xca, 0xd5, 0xcb, 0x3a, 0x6f, 0x7f, 0x40, 0xa2,
+	0x1b, 0x10, 0x6e, 0xef, 0x10, 0xa7, 0x71, 0x5d, 0xc4, 0x2b, 0x2d, 0x43, 0xc9, 0x3c, 0xc7, 0x7c,
+	0xc7, 0xe3, 0x0e, 0x0d, 0x7d, 0x31, 0xbc, 0xed, 0x5b, 0x50, 0xca, 0xa8, 0x0d, 0x19, 0x6e, 0xb8,
+	0x98, 0x37, 0xdb, 0x49, 0xa1, 0xa8, 0x55, 0xae, 0x54, 0x67, 0x6b, 0x66, 0xc6, 0xd5, 0x47, 0xe6,
+	0xd5, 0xfd, 0xe7, 0xdc, 0x91, 0x95, 0xd6, 0x32, 0x28, 0x4b, 0x9d, 0x6d, 0xea, 0x0b, 0x86, 0x1d,
+	0xb1, 0x8f, 0xa3, 0x3d, 0x7a, 0xe0, 0x39, 0x1e, 0x19, 0xa2, 0xbc, 0x07, 0x95, 0xfc, 0x16, 0x45,
+	0xb4, 0x0b, 0xa6, 0x03, 0x75, 0xa6, 0x60, 0xee, 0x67, 0xc0, 0x5c, 0xde, 0xd0, 0x55, 0x48, 0xc3,
+	0x59, 0xeb, 0x25, 0x58, 0xca, 0xd4, 0xea, 0x2a, 0x18, 0xf8, 0x10, 0xdc, 0x72, 0x54, 0xad, 0x89,
+	0xdb, 0x6d, 0x46, 0x78, 0xfc, 0xc3, 0xcf, 0x34, 0x6e, 0x26, 0xe7, 0xcf, 0xe2, 0x63, 0xeb, 0x33,
+	0x30, 0xf3, 0x76, 0x29, 0xea, 0x3a, 0xd0, 0xa5, 0x72, 0x57, 0x79, 0x67, 0x12, 0x66, 0x35, 0x09,
+	0x6f, 0x03, 0x9d, 0xd3, 0x90, 0x39, 0xa4, 0x38, 0x25, 0x31, 0xd4, 0xa7, 0xda, 0x37, 0x1d, 0x5c,
+	0x93, 0xf2, 0xf0, 0x23, 0xd0, 0x63, 0x03, 0xc2, 0x07, 0x19, 0xfb, 0xd3, 0x4e, 0x37, 0x56, 0xfe,
+	0xd7, 0x16, 0xe3, 0x5b, 0xcb, 0x5f, 0x7f, 0xfe, 0x3d, 0x99, 0xba, 0x0b, 0x4b, 0x28, 0x9d, 0xa8,
+	0xd8, 0xe4, 0xf0, 0x58, 0x03, 0xb3, 0x23, 0x2e, 0x86, 0xab, 0x79, 0xab, 0xd3, 0x29, 0x30, 0x1e,
+	0x8d, 0xd5, 0xab, 0x58, 0xaa, 0x92, 0xc5, 0x82, 0x95, 0x0c, 0x96, 0x56, 0xc8, 0xfc, 0x66, 0x12,
+	0x1a, 0x78, 0xa2, 0x81, 0xb9, 0x51, 0x57, 0xc3, 0x5c, 0x9d, 0x8c, 0x5c, 0x18, 0x6b, 0xe3, 0x35,
+	0x8f, 0x41, 0x75, 0x21, 0x41, 0xf0, 0xbb, 0x06, 0x16, 0x32, 0x0c, 0x0e, 0x6b, 0x79, 0x7a, 0xf9,
+	0x81, 0x31, 0xb6, 0x26, 0x9a, 0x51, 0xa8, 0x1b, 0x12, 0x75, 0x15, 0x56, 0x33, 0x50, 0x87, 0x8e,
+	0x1f, 0x7c, 0x89, 0x49, 0x56, 0xe0, 0x0f, 0x0d, 0xcc, 0xa7, 0xdc, 0x09, 0x37, 0xc6, 0x15, 0x4f,
+	0x22, 0x65, 0x6c, 0x4e, 0x30, 0xa1, 0x60, 0xb7, 0x25, 0xec, 0x53, 0xf8, 0x64, 0x5c, 0x58, 0xf4,
+	0xe9, 0x72, 0x6a, 0xbf, 0xd4, 0x77, 0x4f, 0x7b, 0xa6, 0x76, 0xd6, 0x33, 0xb5, 0x3f, 0x3d, 0x53,
+	0x3b, 0xee, 0x9b, 0x85, 0xb3, 0xbe, 0x59, 0xf8, 0xd5, 0x37, 0x0b, 0x6f, 0xd6, 0x46, 0x9f, 0xa4,
+	0x07, 0x98, 0x73, 0xcf, 0x59, 0x8f, 0x85, 0x1c, 0xca, 0x08, 0x3a, 0xda, 0x42, 0x91, 0x94, 0x94,
+	0xcf, 0xd4, 0x96, 0x2e, 0xff, 0x35, 0xb6, 0xfe, 0x0d, 0x00, 0x6a, 0x96, 0x84, 0xd5, 0xbe, 0x06,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -236,6 +517,11 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	BurnTaxRate(ctx context.Context, in *QueryBurnTaxRateRequest, opts ...grpc.CallOption) (*QueryBurnTaxRateResponse, error)
+	GasDiscounts(ctx context.Context, in *QueryGasDiscountsRequest, opts ...grpc.CallOption) (*QueryGasDiscountsResponse, error)
+	// ContractTaxPolicies returns all registered contract and code tax policies.
+	ContractTaxPolicies(ctx context.Context, in *QueryContractTaxPoliciesRequest, opts ...grpc.CallOption) (*QueryContractTaxPoliciesResponse, error)
+	// ContractTaxPolicy returns the tax policy applied to a contract.
+	ContractTaxPolicy(ctx context.Context, in *QueryContractTaxPolicyRequest, opts ...grpc.CallOption) (*QueryContractTaxPolicyResponse, error)
 }
 
 type queryClient struct {
@@ -264,10 +550,42 @@ func (c *queryClient) BurnTaxRate(ctx context.Context, in *QueryBurnTaxRateReque
 	return out, nil
 }
 
+func (c *queryClient) GasDiscounts(ctx context.Context, in *QueryGasDiscountsRequest, opts ...grpc.CallOption) (*QueryGasDiscountsResponse, error) {
+	out := new(QueryGasDiscountsResponse)
+	err := c.cc.Invoke(ctx, "/terra.tax.v1beta1.Query/GasDiscounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ContractTaxPolicies(ctx context.Context, in *QueryContractTaxPoliciesRequest, opts ...grpc.CallOption) (*QueryContractTaxPoliciesResponse, error) {
+	out := new(QueryContractTaxPoliciesResponse)
+	err := c.cc.Invoke(ctx, "/terra.tax.v1beta1.Query/ContractTaxPolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ContractTaxPolicy(ctx context.Context, in *QueryContractTaxPolicyRequest, opts ...grpc.CallOption) (*QueryContractTaxPolicyResponse, error) {
+	out := new(QueryContractTaxPolicyResponse)
+	err := c.cc.Invoke(ctx, "/terra.tax.v1beta1.Query/ContractTaxPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	BurnTaxRate(context.Context, *QueryBurnTaxRateRequest) (*QueryBurnTaxRateResponse, error)
+	GasDiscounts(context.Context, *QueryGasDiscountsRequest) (*QueryGasDiscountsResponse, error)
+	// ContractTaxPolicies returns all registered contract and code tax policies.
+	ContractTaxPolicies(context.Context, *QueryContractTaxPoliciesRequest) (*QueryContractTaxPoliciesResponse, error)
+	// ContractTaxPolicy returns the tax policy applied to a contract.
+	ContractTaxPolicy(context.Context, *QueryContractTaxPolicyRequest) (*QueryContractTaxPolicyResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -280,6 +598,15 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) BurnTaxRate(ctx context.Context, req *QueryBurnTaxRateRequest) (*QueryBurnTaxRateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BurnTaxRate not implemented")
 }
+func (*UnimplementedQueryServer) GasDiscounts(ctx context.Context, req *QueryGasDiscountsRequest) (*QueryGasDiscountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GasDiscounts not implemented")
+}
+func (*UnimplementedQueryServer) ContractTaxPolicies(ctx context.Context, req *QueryContractTaxPoliciesRequest) (*QueryContractTaxPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractTaxPolicies not implemented")
+}
+func (*UnimplementedQueryServer) ContractTaxPolicy(ctx context.Context, req *QueryContractTaxPolicyRequest) (*QueryContractTaxPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractTaxPolicy not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -321,6 +648,61 @@ func _Query_BurnTaxRate_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GasDiscounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGasDiscountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GasDiscounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.tax.v1beta1.Query/GasDiscounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GasDiscounts(ctx, req.(*QueryGasDiscountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractTaxPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractTaxPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractTaxPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.tax.v1beta1.Query/ContractTaxPolicies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractTaxPolicies(ctx, req.(*QueryContractTaxPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractTaxPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractTaxPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractTaxPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.tax.v1beta1.Query/ContractTaxPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractTaxPolicy(ctx, req.(*QueryContractTaxPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "terra.tax.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -333,6 +715,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BurnTaxRate",
 			Handler:    _Query_BurnTaxRate_Handler,
 		},
+		{
+			MethodName: "GasDiscounts",
+			Handler:    _Query_GasDiscounts_Handler,
+		},
+		{
+			MethodName: "ContractTaxPolicies",
+			Handler:    _Query_ContractTaxPolicies_Handler,
+		},
+		{
+			MethodName: "ContractTaxPolicy",
+			Handler:    _Query_ContractTaxPolicy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "terra/tax/v1beta1/query.proto",
@@ -450,44 +844,234 @@ func (m *QueryBurnTaxRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGasDiscountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryGasDiscountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBurnTaxRateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGasDiscountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGasDiscountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGasDiscountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGasDiscountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GasDiscounts) > 0 {
+		for iNdEx := len(m.GasDiscounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasDiscounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractTaxPoliciesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractTaxPoliciesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractTaxPoliciesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractTaxPoliciesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractTaxPoliciesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractTaxPoliciesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Policies) > 0 {
+		for iNdEx := len(m.Policies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Policies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractTaxPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractTaxPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractTaxPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractTaxPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractTaxPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractTaxPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBurnTaxRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
 
 func (m *QueryBurnTaxRateResponse) Size() (n int) {
@@ -501,6 +1085,82 @@ func (m *QueryBurnTaxRateResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryGasDiscountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGasDiscountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GasDiscounts) > 0 {
+		for _, e := range m.GasDiscounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryContractTaxPoliciesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryContractTaxPoliciesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Policies) > 0 {
+		for _, e := range m.Policies {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryContractTaxPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractTaxPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Policy.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -774,6 +1434,471 @@ func (m *QueryBurnTaxRateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryGasDiscountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGasDiscountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGasDiscountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGasDiscountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGasDiscountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGasDiscountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasDiscounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasDiscounts = append(m.GasDiscounts, GasDiscount{})
+			if err := m.GasDiscounts[len(m.GasDiscounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractTaxPoliciesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractTaxPoliciesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractTaxPoliciesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractTaxPoliciesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractTaxPoliciesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractTaxPoliciesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Policies = append(m.Policies, ContractTaxPolicy{})
+			if err := m.Policies[len(m.Policies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractTaxPolicyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractTaxPolicyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractTaxPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractTaxPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractTaxPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractTaxPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -130,38 +130,246 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgSetContractTaxPolicy is the Msg/SetContractTaxPolicy request type.
+type MsgSetContractTaxPolicy struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string            `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Policy    ContractTaxPolicy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy"`
+}
+
+func (m *MsgSetContractTaxPolicy) Reset()         { *m = MsgSetContractTaxPolicy{} }
+func (m *MsgSetContractTaxPolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgSetContractTaxPolicy) ProtoMessage()    {}
+func (*MsgSetContractTaxPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5df2a35c8794fdf, []int{2}
+}
+func (m *MsgSetContractTaxPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetContractTaxPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetContractTaxPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetContractTaxPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetContractTaxPolicy.Merge(m, src)
+}
+func (m *MsgSetContractTaxPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetContractTaxPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetContractTaxPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetContractTaxPolicy proto.InternalMessageInfo
+
+func (m *MsgSetContractTaxPolicy) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetContractTaxPolicy) GetPolicy() ContractTaxPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return ContractTaxPolicy{}
+}
+
+// MsgSetContractTaxPolicyResponse defines the response structure for executing a
+// MsgSetContractTaxPolicy message.
+type MsgSetContractTaxPolicyResponse struct {
+}
+
+func (m *MsgSetContractTaxPolicyResponse) Reset()         { *m = MsgSetContractTaxPolicyResponse{} }
+func (m *MsgSetContractTaxPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetContractTaxPolicyResponse) ProtoMessage()    {}
+func (*MsgSetContractTaxPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5df2a35c8794fdf, []int{3}
+}
+func (m *MsgSetContractTaxPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetContractTaxPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetContractTaxPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetContractTaxPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetContractTaxPolicyResponse.Merge(m, src)
+}
+func (m *MsgSetContractTaxPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetContractTaxPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetContractTaxPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetContractTaxPolicyResponse proto.InternalMessageInfo
+
+// MsgRemoveContractTaxPolicy is the Msg/RemoveContractTaxPolicy request type,
+// exactly one of contract_address and code_id must be set.
+type MsgRemoveContractTaxPolicy struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority       string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	CodeId          uint64 `protobuf:"varint,3,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+}
+
+func (m *MsgRemoveContractTaxPolicy) Reset()         { *m = MsgRemoveContractTaxPolicy{} }
+func (m *MsgRemoveContractTaxPolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveContractTaxPolicy) ProtoMessage()    {}
+func (*MsgRemoveContractTaxPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5df2a35c8794fdf, []int{4}
+}
+func (m *MsgRemoveContractTaxPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveContractTaxPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveContractTaxPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveContractTaxPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveContractTaxPolicy.Merge(m, src)
+}
+func (m *MsgRemoveContractTaxPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveContractTaxPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveContractTaxPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveContractTaxPolicy proto.InternalMessageInfo
+
+func (m *MsgRemoveContractTaxPolicy) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRemoveContractTaxPolicy) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *MsgRemoveContractTaxPolicy) GetCodeId() uint64 {
+	if m != nil {
+		return m.CodeId
+	}
+	return 0
+}
+
+// MsgRemoveContractTaxPolicyResponse defines the response structure for executing a
+// MsgRemoveContractTaxPolicy message.
+type MsgRemoveContractTaxPolicyResponse struct {
+}
+
+func (m *MsgRemoveContractTaxPolicyResponse) Reset()         { *m = MsgRemoveContractTaxPolicyResponse{} }
+func (m *MsgRemoveContractTaxPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveContractTaxPolicyResponse) ProtoMessage()    {}
+func (*MsgRemoveContractTaxPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5df2a35c8794fdf, []int{5}
+}
+func (m *MsgRemoveContractTaxPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveContractTaxPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveContractTaxPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveContractTaxPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveContractTaxPolicyResponse.Merge(m, src)
+}
+func (m *MsgRemoveContractTaxPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveContractTaxPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveContractTaxPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveContractTaxPolicyResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "terra.tax.v1beta1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "terra.tax.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSetContractTaxPolicy)(nil), "terra.tax.v1beta1.MsgSetContractTaxPolicy")
+	proto.RegisterType((*MsgSetContractTaxPolicyResponse)(nil), "terra.tax.v1beta1.MsgSetContractTaxPolicyResponse")
+	proto.RegisterType((*MsgRemoveContractTaxPolicy)(nil), "terra.tax.v1beta1.MsgRemoveContractTaxPolicy")
+	proto.RegisterType((*MsgRemoveContractTaxPolicyResponse)(nil), "terra.tax.v1beta1.MsgRemoveContractTaxPolicyResponse")
 }
 
 func init() { proto.RegisterFile("terra/tax/v1beta1/tx.proto", fileDescriptor_e5df2a35c8794fdf) }
 
 var fileDescriptor_e5df2a35c8794fdf = []byte{
-	// 362 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x51, 0x4d, 0x4b, 0x3a, 0x41,
-	0x1c, 0xde, 0xf9, 0xff, 0x49, 0x70, 0x0b, 0xc2, 0x45, 0x50, 0x37, 0x58, 0xc5, 0x93, 0x2c, 0xb9,
-	0x83, 0x0a, 0x1d, 0xa2, 0x4b, 0x1e, 0xba, 0x09, 0x61, 0x74, 0xe9, 0x50, 0x8c, 0xeb, 0x30, 0x2e,
-	0xb8, 0x3b, 0xcb, 0xfc, 0x46, 0x59, 0x6f, 0xd1, 0xb1, 0x53, 0x1f, 0xa3, 0xa3, 0x87, 0xa0, 0xaf,
-	0xe0, 0x51, 0x3a, 0x75, 0x8a, 0xd0, 0x83, 0x5f, 0x23, 0xdc, 0x99, 0x90, 0x34, 0xe8, 0x32, 0x2f,
-	0xbf, 0xe7, 0x99, 0xe7, 0x85, 0x31, 0x6d, 0x49, 0x85, 0x20, 0x58, 0x92, 0x04, 0x8f, 0x1b, 0x3d,
-	0x2a, 0x49, 0x03, 0xcb, 0xc4, 0x8b, 0x05, 0x97, 0xdc, 0xca, 0xa5, 0x98, 0x27, 0x49, 0xe2, 0x69,
-	0xcc, 0xce, 0x33, 0xce, 0x78, 0x8a, 0xe2, 0xf5, 0x49, 0x11, 0xed, 0x82, 0xcf, 0x21, 0xe4, 0x80,
-	0x43, 0x60, 0x78, 0xdc, 0x58, 0x6f, 0x1a, 0xc8, 0x91, 0x30, 0x88, 0x38, 0x4e, 0x57, 0x3d, 0x2a,
-	0x29, 0xee, 0x9d, 0x12, 0x51, 0x17, 0x0d, 0x95, 0x77, 0xb3, 0x30, 0x1a, 0x51, 0x08, 0x34, 0xa1,
-	0xfa, 0x8a, 0xcc, 0xc3, 0x0e, 0xb0, 0xeb, 0xb8, 0x4f, 0x24, 0xbd, 0x24, 0x82, 0x84, 0x60, 0x9d,
-	0x98, 0x59, 0x32, 0x92, 0x03, 0x2e, 0x02, 0x39, 0x29, 0xa2, 0x0a, 0xaa, 0x65, 0xdb, 0xc5, 0xb7,
-	0x97, 0x7a, 0x5e, 0x2b, 0x9f, 0xf7, 0xfb, 0x82, 0x02, 0x5c, 0x49, 0x11, 0x44, 0xac, 0xbb, 0xa1,
-	0x5a, 0x67, 0x66, 0x26, 0x4e, 0x15, 0x8a, 0xff, 0x2a, 0xa8, 0xb6, 0xdf, 0x2c, 0x79, 0x3b, 0x6d,
-	0x3d, 0x65, 0xd1, 0xce, 0xce, 0x3e, 0xca, 0xc6, 0xf3, 0x6a, 0xea, 0xa2, 0xae, 0x7e, 0x73, 0xea,
-	0x3d, 0xac, 0xa6, 0xee, 0x46, 0xed, 0x71, 0x35, 0x75, 0x8f, 0x54, 0xfa, 0x24, 0xcd, 0xbf, 0x95,
-	0xb2, 0x5a, 0x32, 0x0b, 0x5b, 0xa3, 0x2e, 0x85, 0x98, 0x47, 0x40, 0x9b, 0x43, 0xf3, 0x7f, 0x07,
-	0x98, 0x75, 0x6b, 0x1e, 0xfc, 0xe8, 0x55, 0xfd, 0x25, 0xcf, 0x96, 0x84, 0xed, 0xfe, 0xcd, 0xf9,
-	0xb6, 0xb1, 0xf7, 0xee, 0xd7, 0x05, 0xda, 0x17, 0xb3, 0x85, 0x83, 0xe6, 0x0b, 0x07, 0x7d, 0x2e,
-	0x1c, 0xf4, 0xb4, 0x74, 0x8c, 0xf9, 0xd2, 0x31, 0xde, 0x97, 0x8e, 0x71, 0x73, 0xcc, 0x02, 0x39,
-	0x18, 0xf5, 0x3c, 0x9f, 0x87, 0xd8, 0x1f, 0x12, 0x80, 0xc0, 0xaf, 0xab, 0x4a, 0x3e, 0x17, 0x14,
-	0x8f, 0x5b, 0xba, 0x9a, 0x9c, 0xc4, 0x14, 0x7a, 0x99, 0xf4, 0x47, 0x5a, 0x5f, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0x75, 0x82, 0x2c, 0xec, 0x40, 0x02, 0x00, 0x00,
+	// 529 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcf, 0x8b, 0xd3, 0x40,
+	0x18, 0xed, 0xec, 0x6a, 0xa5, 0xa3, 0xb0, 0x6e, 0x28, 0xb4, 0x8d, 0x90, 0xd6, 0xb8, 0x48, 0x2d,
+	0x36, 0xa1, 0x5d, 0x5c, 0xb0, 0x78, 0xb1, 0x82, 0xe2, 0xa1, 0xb0, 0x64, 0xf5, 0xe2, 0xc1, 0x32,
+	0x4d, 0x86, 0x6c, 0x60, 0x93, 0x09, 0x33, 0xb3, 0x21, 0x3d, 0x29, 0x1e, 0x3d, 0xf9, 0x67, 0x78,
+	0xec, 0x41, 0xf0, 0x5f, 0xd8, 0xe3, 0xb2, 0x5e, 0x3c, 0x89, 0xb4, 0x48, 0xff, 0x0d, 0xe9, 0x64,
+	0xba, 0xcb, 0xe6, 0x07, 0xfe, 0x60, 0x2f, 0x6d, 0xe6, 0x7b, 0x6f, 0xde, 0xf7, 0x5e, 0xbe, 0x8f,
+	0x40, 0x95, 0x63, 0x4a, 0x91, 0xc9, 0x51, 0x6c, 0x46, 0xbd, 0x09, 0xe6, 0xa8, 0x67, 0xf2, 0xd8,
+	0x08, 0x29, 0xe1, 0x44, 0xd9, 0x16, 0x98, 0xc1, 0x51, 0x6c, 0x48, 0x4c, 0xad, 0xba, 0xc4, 0x25,
+	0x02, 0x35, 0x57, 0x4f, 0x09, 0x51, 0xad, 0xd9, 0x84, 0xf9, 0x84, 0x99, 0x3e, 0x73, 0xcd, 0xa8,
+	0xb7, 0xfa, 0x93, 0xc0, 0x36, 0xf2, 0xbd, 0x80, 0x98, 0xe2, 0x57, 0x96, 0x1a, 0x09, 0x77, 0x9c,
+	0x88, 0x24, 0x07, 0x09, 0x35, 0xb3, 0x5e, 0x5c, 0x1c, 0x60, 0xe6, 0x49, 0x82, 0xfe, 0x15, 0xc0,
+	0xad, 0x11, 0x73, 0x5f, 0x87, 0x0e, 0xe2, 0x78, 0x1f, 0x51, 0xe4, 0x33, 0x65, 0x0f, 0x56, 0xd0,
+	0x31, 0x3f, 0x24, 0xd4, 0xe3, 0xd3, 0x3a, 0x68, 0x81, 0x76, 0x65, 0x58, 0x3f, 0xfb, 0xd2, 0xad,
+	0x4a, 0xe5, 0xa7, 0x8e, 0x43, 0x31, 0x63, 0x07, 0x9c, 0x7a, 0x81, 0x6b, 0x5d, 0x50, 0x95, 0x27,
+	0xb0, 0x1c, 0x0a, 0x85, 0xfa, 0x46, 0x0b, 0xb4, 0x6f, 0xf6, 0x1b, 0x46, 0x26, 0xad, 0x91, 0xb4,
+	0x18, 0x56, 0x4e, 0x7e, 0x34, 0x4b, 0x9f, 0x97, 0xb3, 0x0e, 0xb0, 0xe4, 0x9d, 0x81, 0xf1, 0x61,
+	0x39, 0xeb, 0x5c, 0xa8, 0x7d, 0x5c, 0xce, 0x3a, 0x77, 0x12, 0xf7, 0xb1, 0xf0, 0x9f, 0x72, 0xa9,
+	0x37, 0x60, 0x2d, 0x55, 0xb2, 0x30, 0x0b, 0x49, 0xc0, 0xb0, 0x7e, 0x06, 0x04, 0x76, 0x80, 0xf9,
+	0x33, 0x12, 0x70, 0x8a, 0x6c, 0xfe, 0x0a, 0xc5, 0xfb, 0xe4, 0xc8, 0xb3, 0xa7, 0xff, 0x1d, 0xee,
+	0x05, 0x2c, 0x87, 0x42, 0x41, 0x86, 0xdb, 0xc9, 0x09, 0x97, 0xe9, 0x76, 0x39, 0xa7, 0x28, 0x0d,
+	0xf6, 0xb2, 0x39, 0xef, 0xa5, 0x72, 0xe6, 0x19, 0xd7, 0xef, 0xc2, 0x66, 0x01, 0x74, 0x9e, 0xfb,
+	0x1b, 0x80, 0xea, 0x88, 0xb9, 0x16, 0xf6, 0x49, 0x84, 0xaf, 0x2e, 0xfa, 0x03, 0x78, 0xdb, 0x96,
+	0x62, 0x63, 0x94, 0x90, 0xc4, 0x4b, 0xa8, 0x58, 0x5b, 0xeb, 0xba, 0xbc, 0xab, 0xd4, 0xe0, 0x0d,
+	0x9b, 0x38, 0x78, 0xec, 0x39, 0xf5, 0xcd, 0x16, 0x68, 0x5f, 0xb3, 0xca, 0xab, 0xe3, 0x4b, 0x67,
+	0xf0, 0x38, 0x9b, 0xfa, 0x7e, 0x2a, 0x75, 0x81, 0x6d, 0x7d, 0x07, 0xea, 0xc5, 0xe8, 0x3a, 0x7b,
+	0xff, 0xd7, 0x06, 0xdc, 0x1c, 0x31, 0x57, 0x79, 0x0b, 0x6f, 0x5d, 0x5a, 0x66, 0x3d, 0x67, 0x4e,
+	0xa9, 0xbd, 0x51, 0x3b, 0x7f, 0xe6, 0xac, 0xfb, 0x28, 0x11, 0xac, 0xe6, 0xee, 0x55, 0x81, 0x46,
+	0x1e, 0x57, 0xed, 0xff, 0x3d, 0xf7, 0xbc, 0xef, 0x3b, 0x58, 0x2b, 0x9a, 0x6b, 0x37, 0x5f, 0xae,
+	0x80, 0xae, 0x3e, 0xfa, 0x27, 0xfa, 0xda, 0x80, 0x7a, 0xfd, 0xfd, 0x6a, 0x8d, 0x87, 0xcf, 0x4f,
+	0xe6, 0x1a, 0x38, 0x9d, 0x6b, 0xe0, 0xe7, 0x5c, 0x03, 0x9f, 0x16, 0x5a, 0xe9, 0x74, 0xa1, 0x95,
+	0xbe, 0x2f, 0xb4, 0xd2, 0x9b, 0x87, 0xae, 0xc7, 0x0f, 0x8f, 0x27, 0x86, 0x4d, 0x7c, 0xd3, 0x3e,
+	0x42, 0x8c, 0x79, 0x76, 0x37, 0x19, 0xb1, 0x4d, 0x28, 0x36, 0xa3, 0x5d, 0x39, 0x6a, 0x3e, 0x0d,
+	0x31, 0x9b, 0x94, 0xc5, 0xf7, 0x67, 0xf7, 0xf7, 0x00, 0xb8, 0x9c, 0xf9, 0x76, 0x2e, 0x05, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -177,6 +385,10 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type MsgClient interface {
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetContractTaxPolicy adds or replaces the tax policy of a contract or code id.
+	SetContractTaxPolicy(ctx context.Context, in *MsgSetContractTaxPolicy, opts ...grpc.CallOption) (*MsgSetContractTaxPolicyResponse, error)
+	// RemoveContractTaxPolicy removes the tax policy of a contract or code id.
+	RemoveContractTaxPolicy(ctx context.Context, in *MsgRemoveContractTaxPolicy, opts ...grpc.CallOption) (*MsgRemoveContractTaxPolicyResponse, error)
 }
 
 type msgClient struct {
@@ -196,9 +408,31 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) SetContractTaxPolicy(ctx context.Context, in *MsgSetContractTaxPolicy, opts ...grpc.CallOption) (*MsgSetContractTaxPolicyResponse, error) {
+	out := new(MsgSetContractTaxPolicyResponse)
+	err := c.cc.Invoke(ctx, "/terra.tax.v1beta1.Msg/SetContractTaxPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveContractTaxPolicy(ctx context.Context, in *MsgRemoveContractTaxPolicy, opts ...grpc.CallOption) (*MsgRemoveContractTaxPolicyResponse, error) {
+	out := new(MsgRemoveContractTaxPolicyResponse)
+	err := c.cc.Invoke(ctx, "/terra.tax.v1beta1.Msg/RemoveContractTaxPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetContractTaxPolicy adds or replaces the tax policy of a contract or code id.
+	SetContractTaxPolicy(context.Context, *MsgSetContractTaxPolicy) (*MsgSetContractTaxPolicyResponse, error)
+	// RemoveContractTaxPolicy removes the tax policy of a contract or code id.
+	RemoveContractTaxPolicy(context.Context, *MsgRemoveContractTaxPolicy) (*MsgRemoveContractTaxPolicyResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -208,6 +442,12 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) SetContractTaxPolicy(ctx context.Context, req *MsgSetContractTaxPolicy) (*MsgSetContractTaxPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetContractTaxPolicy not implemented")
+}
+func (*UnimplementedMsgServer) RemoveContractTaxPolicy(ctx context.Context, req *MsgRemoveContractTaxPolicy) (*MsgRemoveContractTaxPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveContractTaxPolicy not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -231,6 +471,43 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetContractTaxPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetContractTaxPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetContractTaxPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.tax.v1beta1.Msg/SetContractTaxPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetContractTaxPolicy(ctx, req.(*MsgSetContractTaxPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveContractTaxPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveContractTaxPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveContractTaxPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/terra.tax.v1beta1.Msg/RemoveContractTaxPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveContractTaxPolicy(ctx, req.(*MsgRemoveContractTaxPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "terra.tax.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -239,6 +516,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "SetContractTaxPolicy",
+			Handler:    _Msg_SetContractTaxPolicy_Handler,
+		},
+		{
+			MethodName: "RemoveContractTaxPolicy",
+			Handler:    _Msg_RemoveContractTaxPolicy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "terra/tax/v1beta1/tx.proto",
@@ -307,67 +592,248 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSetContractTaxPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgSetContractTaxPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetContractTaxPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetContractTaxPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetContractTaxPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetContractTaxPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgRemoveContractTaxPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgRemoveContractTaxPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
+
+func (m *MsgRemoveContractTaxPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CodeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CodeId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveContractTaxPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveContractTaxPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveContractTaxPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetContractTaxPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Policy.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetContractTaxPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveContractTaxPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CodeId != 0 {
+		n += 1 + sovTx(uint64(m.CodeId))
+	}
+	return n
+}
+
+func (m *MsgRemoveContractTaxPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
@@ -513,6 +979,354 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetContractTaxPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetContractTaxPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetContractTaxPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetContractTaxPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetContractTaxPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetContractTaxPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveContractTaxPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveContractTaxPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveContractTaxPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeId", wireType)
+			}
+			m.CodeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveContractTaxPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveContractTaxPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveContractTaxPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
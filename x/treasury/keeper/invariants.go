@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/classic-terra/core/v3/types"
+	"github.com/classic-terra/core/v3/x/treasury/types"
+)
+
+// RegisterInvariants registers all treasury invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "burn-account", BurnAccountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "tax-caps", TaxCapsInvariant(k))
+}
+
+// AllInvariants runs all invariants of the treasury module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := BurnAccountInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return TaxCapsInvariant(k)(ctx)
+	}
+}
+
+// BurnAccountInvariant checks that the burn module account is emptied by the
+// EndBlocker. The taxes of a block are burned at its end, so the account is
+// only checked outside of transactions.
+func BurnAccountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		if len(ctx.TxBytes()) == 0 {
+			burnAddress := k.accountKeeper.GetModuleAddress(types.BurnModuleName)
+			if coins := k.bankKeeper.GetAllBalances(ctx, burnAddress); !coins.IsZero() {
+				broken = true
+				msg += fmt.Sprintf("\t%s module account holds %s after the end of the block\n", types.BurnModuleName, coins)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "burn-account", msg), broken
+	}
+}
+
+// TaxCapsInvariant checks that the registered tax caps are valid and that a tax
+// cap is registered for every whitelisted denom but the denom of the tax policy
+// cap, which is never registered. The caps are registered at the end of the
+// first epoch after the probation period, before the tax policy cap applies.
+func TaxCapsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		k.IterateTaxCap(ctx, func(denom string, taxCap math.Int) bool {
+			if taxCap.IsNil() || taxCap.IsNegative() {
+				broken = true
+				msg += fmt.Sprintf("\t%s registered tax cap %s is invalid\n", denom, taxCap)
+			}
+			return false
+		})
+
+		if ctx.BlockHeight() >= int64(core.BlocksPerWeek*k.WindowProbation(ctx)) {
+			store := ctx.KVStore(k.storeKey)
+			policyCapDenom := k.TaxPolicy(ctx).Cap.Denom
+			for _, denom := range k.oracleKeeper.Whitelist(ctx) {
+				if denom.Name != policyCapDenom && !store.Has(types.GetTaxCapKey(denom.Name)) {
+					broken = true
+					msg += fmt.Sprintf("\t%s has no registered tax cap\n", denom.Name)
+				}
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "tax-caps", msg), broken
+	}
+}
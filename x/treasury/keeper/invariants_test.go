@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	core "github.com/classic-terra/core/v3/types"
+	oracletypes "github.com/classic-terra/core/v3/x/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestBurnAccountInvariant(t *testing.T) {
+	input := CreateTestInput(t)
+
+	// the taxes of the block are burned at its end
+	_, broken := BurnAccountInvariant(input.TreasuryKeeper)(input.Ctx.WithTxBytes([]byte("tx")))
+	require.False(t, broken)
+	_, broken = BurnAccountInvariant(input.TreasuryKeeper)(input.Ctx)
+	require.True(t, broken)
+
+	input.TreasuryKeeper.BurnCoinsFromBurnAccount(input.Ctx)
+	_, broken = AllInvariants(input.TreasuryKeeper)(input.Ctx)
+	require.False(t, broken)
+}
+
+func TestTaxCapsInvariant(t *testing.T) {
+	input := CreateTestInput(t)
+	input.OracleKeeper.SetWhitelist(input.Ctx, oracletypes.DenomList{{Name: core.MicroSDRDenom}, {Name: core.MicroKRWDenom}})
+
+	// unregistered caps fall back to the tax policy during the probation period
+	_, broken := TaxCapsInvariant(input.TreasuryKeeper)(input.Ctx)
+	require.False(t, broken)
+
+	// the caps of the whitelisted denoms are registered after the probation period
+	pastProbation := input.Ctx.WithBlockHeight(int64(core.BlocksPerWeek * input.TreasuryKeeper.WindowProbation(input.Ctx)))
+	_, broken = TaxCapsInvariant(input.TreasuryKeeper)(pastProbation)
+	require.True(t, broken)
+
+	input.TreasuryKeeper.SetTaxCap(input.Ctx, core.MicroKRWDenom, sdk.NewInt(-1))
+	_, broken = TaxCapsInvariant(input.TreasuryKeeper)(input.Ctx)
+	require.True(t, broken)
+
+	input.TreasuryKeeper.SetTaxCap(input.Ctx, core.MicroKRWDenom, sdk.NewInt(1000))
+	_, broken = TaxCapsInvariant(input.TreasuryKeeper)(input.Ctx)
+	require.False(t, broken)
+
+	// the tax policy cap applies to its denom
+	_, broken = TaxCapsInvariant(input.TreasuryKeeper)(pastProbation)
+	require.False(t, broken)
+}
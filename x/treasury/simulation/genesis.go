@@ -23,6 +23,7 @@ const (
 	windowShortKey             = "window_short"
 	windowLongKey              = "window_long"
 	windowProbationKey         = "window_probation"
+	lunaTaxCapKey              = "luna_tax_cap"
 )
 
 // GenTaxPolicy randomized TaxPolicy
@@ -70,6 +71,11 @@ func GenWindowProbation(r *rand.Rand) uint64 {
 	return uint64(1 + r.Intn(6))
 }
 
+// GenLunaTaxCap randomized tax cap of luna, which keeps luna sends taxed
+func GenLunaTaxCap(r *rand.Rand) sdk.Int {
+	return sdk.NewInt(int64(1+r.Intn(1000000)) * 1000)
+}
+
 // RandomizedGenState generates a random GenesisState for gov
 func RandomizedGenState(simState *module.SimulationState) {
 	var taxPolicy types.PolicyConstraints
@@ -114,6 +120,12 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { windowProbation = GenWindowProbation(r) },
 	)
 
+	var lunaTaxCap sdk.Int
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, lunaTaxCapKey, &lunaTaxCap, simState.Rand,
+		func(r *rand.Rand) { lunaTaxCap = GenLunaTaxCap(r) },
+	)
+
 	treasuryGenesis := types.NewGenesisState(
 		types.Params{
 			TaxPolicy:               taxPolicy,
@@ -127,7 +139,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 		taxPolicy.RateMin,
 		rewardPolicy.RateMin,
 		[]types.TaxCap{
-			{Denom: core.MicroLunaDenom, TaxCap: sdk.NewInt(0)},
+			{Denom: core.MicroLunaDenom, TaxCap: lunaTaxCap},
 		},
 		sdk.Coins{},
 		sdk.Coins{},
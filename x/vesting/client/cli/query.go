@@ -0,0 +1,79 @@
+package cli
+
+import (
+	"context"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/classic-terra/core/v3/x/vesting/types"
+)
+
+const flagTimestamp = "timestamp"
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	vestingQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the vesting module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	vestingQueryCmd.AddCommand(
+		GetCmdQueryVestingSchedule(),
+	)
+
+	return vestingQueryCmd
+}
+
+// GetCmdQueryVestingSchedule implements the query vesting schedule command.
+func GetCmdQueryVestingSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the unlock timeline and the vested coins of a lazy graded vesting account",
+		Long: strings.TrimSpace(`
+Query the unlock timeline of each denom of a lazy graded vesting account, and
+its vested, vesting, delegated vesting and spendable coins at the given unix
+timestamp, or at the latest block time if none is given.
+
+$ terrad query vesting schedule terra1... --timestamp 1700000000
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			timestamp, err := cmd.Flags().GetInt64(flagTimestamp)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.VestingSchedule(context.Background(),
+				&types.QueryVestingScheduleRequest{Address: addr.String(), Timestamp: timestamp},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Int64(flagTimestamp, 0, "Unix timestamp at which the coins are computed, defaults to the latest block time")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,204 @@
+package cli
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/classic-terra/core/v3/x/vesting/types"
+)
+
+const flagDest = "dest"
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	vestingTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Vesting transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	vestingTxCmd.AddCommand(
+		GetCreateLazyGradedVestingAccountCmd(),
+		GetClawbackCmd(),
+		GetExtendVestingScheduleCmd(),
+	)
+
+	return vestingTxCmd
+}
+
+// GetCreateLazyGradedVestingAccountCmd will create and send a MsgCreateLazyGradedVestingAccount
+func GetCreateLazyGradedVestingAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-lazy-graded-vesting-account [to-address] [amount] [schedules-file]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Create a new lazy graded vesting account funded with an allocation of tokens",
+		Long: strings.TrimSpace(`
+Create a new lazy graded vesting account funded with an allocation of tokens. The
+sender becomes the funder of the account and can claw back the unvested tokens
+or extend the vesting schedules. The schedules file contains a JSON list of
+vesting schedules, one for each denom of the amount.
+
+$ terrad tx vesting create-lazy-graded-vesting-account terra1... 1000000uluna schedules.json
+
+Where schedules.json contains:
+
+[
+  {
+    "denom": "uluna",
+    "schedules": [
+      {"start_time": 1700000000, "end_time": 1710000000, "ratio": "0.5"},
+      {"start_time": 1710000000, "end_time": 1720000000, "ratio": "0.5"}
+    ]
+  }
+]
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			toAddress, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			vestingSchedules, err := parseVestingSchedules(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateLazyGradedVestingAccount(clientCtx.GetFromAddress(), toAddress, amount, vestingSchedules)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetClawbackCmd will create and send a MsgClawback
+func GetClawbackCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clawback [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Claw back the unvested tokens of a lazy graded vesting account",
+		Long: strings.TrimSpace(`
+Claw back the unvested tokens of a lazy graded vesting account and stop its
+vesting schedules. Only the funder of the account can sign the clawback. The
+tokens are returned to the funder unless a destination is given.
+
+$ terrad tx vesting clawback terra1... --dest terra1...
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var destAddress sdk.AccAddress
+			if dest, _ := cmd.Flags().GetString(flagDest); dest != "" {
+				destAddress, err = sdk.AccAddressFromBech32(dest)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgClawback(clientCtx.GetFromAddress(), address, destAddress)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagDest, "", "Address receiving the clawed back tokens, defaults to the funder")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetExtendVestingScheduleCmd will create and send a MsgExtendVestingSchedule
+func GetExtendVestingScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "extend-vesting-schedule [address] [schedules-file]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Extend the vesting schedules of a lazy graded vesting account",
+		Long: strings.TrimSpace(`
+Replace the vesting schedules of the tokens of a lazy graded vesting account
+which are still vesting. The tokens vested so far stay vested and the new
+schedules must not end before the current ones. Only the funder of the account
+can sign the extension. The schedules file has the same format as for
+create-lazy-graded-vesting-account.
+
+$ terrad tx vesting extend-vesting-schedule terra1... schedules.json
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			vestingSchedules, err := parseVestingSchedules(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgExtendVestingSchedule(clientCtx.GetFromAddress(), address, vestingSchedules)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func parseVestingSchedules(path string) (types.VestingSchedules, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var vestingSchedules types.VestingSchedules
+	if err := json.Unmarshal(bz, &vestingSchedules); err != nil {
+		return nil, err
+	}
+
+	return vestingSchedules, nil
+}
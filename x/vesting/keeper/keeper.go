@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/classic-terra/core/v3/x/vesting/types"
+)
+
+// Keeper of the vesting module. The module keeps no state of its own, all
+// vesting data lives on the accounts managed by the account keeper.
+type Keeper struct {
+	cdc codec.BinaryCodec
+
+	accountKeeper      types.AccountKeeper
+	bankKeeper         types.BankKeeper
+	distributionKeeper types.DistributionKeeper
+
+	// the address capable of clawing back any lazy graded vesting account.
+	// Typically, this should be the x/gov module account.
+	authority string
+}
+
+// NewKeeper creates a new vesting Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	accountKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper,
+	distributionKeeper types.DistributionKeeper,
+	authority string,
+) Keeper {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(fmt.Errorf("invalid vesting authority address: %w", err))
+	}
+
+	return Keeper{
+		cdc:                cdc,
+		accountKeeper:      accountKeeper,
+		bankKeeper:         bankKeeper,
+		distributionKeeper: distributionKeeper,
+		authority:          authority,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetAuthority returns the x/vesting module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetLazyGradedVestingAccount returns the lazy graded vesting account stored
+// at the given address.
+func (k Keeper) GetLazyGradedVestingAccount(ctx sdk.Context, addr sdk.AccAddress) (*types.LazyGradedVestingAccount, error) {
+	acc := k.accountKeeper.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, types.ErrAccountNotFound.Wrapf("account %s does not exist", addr)
+	}
+
+	vestingAcc, ok := acc.(*types.LazyGradedVestingAccount)
+	if !ok {
+		return nil, types.ErrNotLazyGradedVestingAccount.Wrapf("account %s", addr)
+	}
+
+	return vestingAcc, nil
+}
+
+// isFunderOrAuthority returns true if signer is allowed to modify the given
+// vesting account. Accounts created at genesis have no funder and can only be
+// modified by the authority.
+func (k Keeper) isFunderOrAuthority(signer string, acc *types.LazyGradedVestingAccount) bool {
+	if signer == k.authority {
+		return true
+	}
+
+	return acc.FunderAddress != "" && signer == acc.FunderAddress
+}
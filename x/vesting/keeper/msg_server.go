@@ -0,0 +1,174 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/classic-terra/core/v3/x/vesting/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the vesting MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// CreateLazyGradedVestingAccount creates a new lazy graded vesting account
+// funded by the sender, who becomes the funder of the account.
+func (k msgServer) CreateLazyGradedVestingAccount(goCtx context.Context, msg *types.MsgCreateLazyGradedVestingAccount) (*types.MsgCreateLazyGradedVestingAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, err
+	}
+	to, err := sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.IsSendEnabledCoins(ctx, msg.Amount...); err != nil {
+		return nil, err
+	}
+
+	if k.bankKeeper.BlockedAddr(to) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ToAddress)
+	}
+
+	if acc := k.accountKeeper.GetAccount(ctx, to); acc != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", msg.ToAddress)
+	}
+
+	// coins which are vested right away would be a plain transfer to a new
+	// account, so every coin must still be vesting at block time
+	for _, coin := range msg.Amount {
+		vestingSchedule, exists := msg.VestingSchedules.Get(coin.Denom)
+		if !exists || vestingSchedule.GetEndTime() <= ctx.BlockTime().Unix() {
+			return nil, errorsmod.Wrapf(types.ErrFullyVested, "%s coins", coin.Denom)
+		}
+	}
+
+	baseAccount := authtypes.NewBaseAccountWithAddress(to)
+	baseAccount = k.accountKeeper.NewAccount(ctx, baseAccount).(*authtypes.BaseAccount)
+	vestingAccount := types.NewLazyGradedVestingAccount(baseAccount, msg.Amount, msg.VestingSchedules)
+	vestingAccount.FunderAddress = msg.FromAddress
+	if err := vestingAccount.Validate(); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	k.accountKeeper.SetAccount(ctx, vestingAccount)
+
+	if err := k.bankKeeper.SendCoins(ctx, from, to, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateLazyGradedVestingAccountResponse{}, nil
+}
+
+// Clawback returns the unvested coins of a lazy graded vesting account to the
+// destination and stops its vesting schedules.
+func (k msgServer) Clawback(goCtx context.Context, msg *types.MsgClawback) (*types.MsgClawbackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	vestingAccount, err := k.GetLazyGradedVestingAccount(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	if !k.isFunderOrAuthority(msg.Signer, vestingAccount) {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "signer %s", msg.Signer)
+	}
+
+	clawback := vestingAccount.Clawback(ctx.BlockTime())
+	if clawback.IsZero() {
+		return nil, types.ErrNothingToClawback
+	}
+
+	// the account must be updated first to unlock the clawed back coins
+	k.accountKeeper.SetAccount(ctx, vestingAccount)
+
+	destAddress := msg.DestAddress
+	if destAddress == "" {
+		destAddress = vestingAccount.FunderAddress
+	}
+
+	if destAddress == "" {
+		if err := k.distributionKeeper.FundCommunityPool(ctx, clawback, addr); err != nil {
+			return nil, err
+		}
+	} else {
+		dest, err := sdk.AccAddressFromBech32(destAddress)
+		if err != nil {
+			return nil, err
+		}
+		if k.bankKeeper.BlockedAddr(dest) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", destAddress)
+		}
+		if err := k.bankKeeper.SendCoins(ctx, addr, dest, clawback); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeClawback,
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Address),
+			sdk.NewAttribute(types.AttributeKeyFunder, vestingAccount.FunderAddress),
+			sdk.NewAttribute(types.AttributeKeyDestination, destAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, clawback.String()),
+		),
+	)
+
+	return &types.MsgClawbackResponse{Coins: clawback}, nil
+}
+
+// ExtendVestingSchedule replaces the schedules of the coins which are still
+// vesting with the given schedules.
+func (k msgServer) ExtendVestingSchedule(goCtx context.Context, msg *types.MsgExtendVestingSchedule) (*types.MsgExtendVestingScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	vestingAccount, err := k.GetLazyGradedVestingAccount(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	if !k.isFunderOrAuthority(msg.Signer, vestingAccount) {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "signer %s", msg.Signer)
+	}
+
+	if err := vestingAccount.ExtendVestingSchedules(ctx.BlockTime(), msg.VestingSchedules); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	k.accountKeeper.SetAccount(ctx, vestingAccount)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExtendVestingSchedule,
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Address),
+			sdk.NewAttribute(types.AttributeKeyFunder, vestingAccount.FunderAddress),
+		),
+	)
+
+	return &types.MsgExtendVestingScheduleResponse{}, nil
+}
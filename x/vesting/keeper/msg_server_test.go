@@ -0,0 +1,147 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	apptesting "github.com/classic-terra/core/v3/app/testing"
+	core "github.com/classic-terra/core/v3/types"
+	"github.com/classic-terra/core/v3/x/vesting/keeper"
+	"github.com/classic-terra/core/v3/x/vesting/types"
+)
+
+type KeeperTestSuite struct {
+	apptesting.KeeperTestHelper
+
+	msgServer types.MsgServer
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.Setup(s.T(), apptesting.SimAppChainID)
+	s.msgServer = keeper.NewMsgServerImpl(s.App.VestingKeeper)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) vestingSchedules(duration time.Duration) types.VestingSchedules {
+	now := s.Ctx.BlockTime()
+	return types.VestingSchedules{
+		types.NewVestingSchedule(core.MicroLunaDenom, []types.Schedule{
+			types.NewSchedule(now.Unix(), now.Add(duration).Unix(), sdk.OneDec()),
+		}),
+	}
+}
+
+func (s *KeeperTestSuite) createVestingAccount(funder, addr sdk.AccAddress, amount sdk.Coins) {
+	s.FundAcc(funder, amount)
+	_, err := s.msgServer.CreateLazyGradedVestingAccount(sdk.WrapSDKContext(s.Ctx),
+		types.NewMsgCreateLazyGradedVestingAccount(funder, addr, amount, s.vestingSchedules(100*time.Hour)))
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestCreateLazyGradedVestingAccount() {
+	funder, addr := s.TestAccs[0], s.TestAccs[1]
+	amount := sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 1000))
+	s.createVestingAccount(funder, addr, amount)
+
+	vestingAccount, err := s.App.VestingKeeper.GetLazyGradedVestingAccount(s.Ctx, addr)
+	s.Require().NoError(err)
+	s.Require().Equal(funder.String(), vestingAccount.FunderAddress)
+	s.Require().Equal(amount, vestingAccount.OriginalVesting)
+	s.Require().Equal(amount, s.App.BankKeeper.GetAllBalances(s.Ctx, addr))
+	s.Require().True(s.App.BankKeeper.SpendableCoins(s.Ctx, addr).IsZero())
+
+	// require the account not to exist yet
+	s.FundAcc(funder, amount)
+	_, err = s.msgServer.CreateLazyGradedVestingAccount(sdk.WrapSDKContext(s.Ctx),
+		types.NewMsgCreateLazyGradedVestingAccount(funder, addr, amount, s.vestingSchedules(time.Hour)))
+	s.Require().Error(err)
+
+	// require the coins to be still vesting
+	_, err = s.msgServer.CreateLazyGradedVestingAccount(sdk.WrapSDKContext(s.Ctx),
+		types.NewMsgCreateLazyGradedVestingAccount(funder, s.TestAccs[2], amount, s.vestingSchedules(0)))
+	s.Require().ErrorIs(err, types.ErrFullyVested)
+
+	other := sdk.NewCoins(sdk.NewInt64Coin(core.MicroUSDDenom, 1000))
+	s.FundAcc(funder, other)
+	_, err = s.msgServer.CreateLazyGradedVestingAccount(sdk.WrapSDKContext(s.Ctx),
+		types.NewMsgCreateLazyGradedVestingAccount(funder, s.TestAccs[2], amount.Add(other...), s.vestingSchedules(time.Hour)))
+	s.Require().ErrorIs(err, types.ErrFullyVested)
+}
+
+func (s *KeeperTestSuite) TestClawback() {
+	funder, addr, dest := s.TestAccs[0], s.TestAccs[1], s.TestAccs[2]
+	amount := sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 1000))
+	s.createVestingAccount(funder, addr, amount)
+
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(25 * time.Hour))
+	goCtx := sdk.WrapSDKContext(s.Ctx)
+
+	// require the signer to be the funder or the authority
+	_, err := s.msgServer.Clawback(goCtx, types.NewMsgClawback(addr, addr, nil))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	res, err := s.msgServer.Clawback(goCtx, types.NewMsgClawback(funder, addr, dest))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 750)), res.Coins)
+	s.Require().Equal(res.Coins, s.App.BankKeeper.GetAllBalances(s.Ctx, dest))
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 250)), s.App.BankKeeper.SpendableCoins(s.Ctx, addr))
+
+	// require the schedules to be stopped
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(100 * time.Hour))
+	_, err = s.msgServer.Clawback(sdk.WrapSDKContext(s.Ctx), types.NewMsgClawback(funder, addr, dest))
+	s.Require().ErrorIs(err, types.ErrNothingToClawback)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 250)), s.App.BankKeeper.SpendableCoins(s.Ctx, addr))
+}
+
+func (s *KeeperTestSuite) TestClawbackByAuthority() {
+	addr := s.TestAccs[1]
+	amount := sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 1000))
+	s.FundAcc(addr, amount)
+
+	// genesis accounts have no funder, the clawed back coins go to the community pool
+	baseAccount := s.App.AccountKeeper.GetAccount(s.Ctx, addr).(*authtypes.BaseAccount)
+	s.App.AccountKeeper.SetAccount(s.Ctx, types.NewLazyGradedVestingAccount(baseAccount, amount, s.vestingSchedules(time.Hour)))
+	communityPool := s.App.DistrKeeper.GetFeePoolCommunityCoins(s.Ctx)
+
+	_, err := s.msgServer.Clawback(sdk.WrapSDKContext(s.Ctx), types.NewMsgClawback(s.TestAccs[0], addr, nil))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	res, err := s.msgServer.Clawback(sdk.WrapSDKContext(s.Ctx), types.NewMsgClawback(authority, addr, nil))
+	s.Require().NoError(err)
+	s.Require().Equal(amount, res.Coins)
+	s.Require().Equal(communityPool.Add(sdk.NewDecCoinsFromCoins(amount...)...), s.App.DistrKeeper.GetFeePoolCommunityCoins(s.Ctx))
+	s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, addr).IsZero())
+}
+
+func (s *KeeperTestSuite) TestExtendVestingSchedule() {
+	funder, addr := s.TestAccs[0], s.TestAccs[1]
+	amount := sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 1000))
+	s.createVestingAccount(funder, addr, amount)
+
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(50 * time.Hour))
+	goCtx := sdk.WrapSDKContext(s.Ctx)
+
+	_, err := s.msgServer.ExtendVestingSchedule(goCtx, types.NewMsgExtendVestingSchedule(addr, addr, s.vestingSchedules(100*time.Hour)))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// require the schedules not to end earlier
+	_, err = s.msgServer.ExtendVestingSchedule(goCtx, types.NewMsgExtendVestingSchedule(funder, addr, s.vestingSchedules(time.Hour)))
+	s.Require().Error(err)
+
+	_, err = s.msgServer.ExtendVestingSchedule(goCtx, types.NewMsgExtendVestingSchedule(funder, addr, s.vestingSchedules(100*time.Hour)))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 500)), s.App.BankKeeper.SpendableCoins(s.Ctx, addr))
+
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(50 * time.Hour))
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 750)), s.App.BankKeeper.SpendableCoins(s.Ctx, addr))
+}
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/classic-terra/core/v3/x/vesting/types"
+)
+
+// querier is used as Keeper will have duplicate methods if used directly, and gRPC names take precedence over q
+type querier struct {
+	Keeper
+}
+
+// NewQuerier returns an implementation of the vesting QueryServer interface
+// for the provided Keeper.
+func NewQuerier(keeper Keeper) types.QueryServer {
+	return &querier{Keeper: keeper}
+}
+
+var _ types.QueryServer = querier{}
+
+// VestingSchedule queries the unlock timeline of a lazy graded vesting account
+// and its coins at the requested time.
+func (q querier) VestingSchedule(c context.Context, req *types.QueryVestingScheduleRequest) (*types.QueryVestingScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.Timestamp < 0 {
+		return nil, status.Error(codes.InvalidArgument, "timestamp cannot be negative")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	vestingAccount, err := q.GetLazyGradedVestingAccount(ctx, addr)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	blockTime := ctx.BlockTime()
+	if req.Timestamp != 0 {
+		blockTime = time.Unix(req.Timestamp, 0)
+	}
+
+	// same as the bank keeper, nothing is spendable if the locked coins
+	// exceed the balances
+	spendable, hasNeg := q.bankKeeper.GetAllBalances(ctx, addr).SafeSub(vestingAccount.LockedCoins(blockTime)...)
+	if hasNeg {
+		spendable = sdk.NewCoins()
+	}
+
+	return &types.QueryVestingScheduleResponse{
+		Timestamp:        blockTime.Unix(),
+		OriginalVesting:  vestingAccount.OriginalVesting,
+		Vested:           vestingAccount.GetVestedCoins(blockTime),
+		Vesting:          vestingAccount.GetVestingCoins(blockTime),
+		DelegatedVesting: vestingAccount.DelegatedVesting,
+		Spendable:        spendable,
+		Timelines:        vestingAccount.GetVestingTimelines(),
+	}, nil
+}
@@ -0,0 +1,55 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/classic-terra/core/v3/types"
+	"github.com/classic-terra/core/v3/x/vesting/keeper"
+	"github.com/classic-terra/core/v3/x/vesting/types"
+)
+
+func (s *KeeperTestSuite) TestQueryVestingSchedule() {
+	funder, addr := s.TestAccs[0], s.TestAccs[1]
+	amount := sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 1000))
+	s.createVestingAccount(funder, addr, amount)
+
+	querier := keeper.NewQuerier(s.App.VestingKeeper)
+	goCtx := sdk.WrapSDKContext(s.Ctx)
+	now := s.Ctx.BlockTime()
+
+	// require the current block time by default
+	res, err := querier.VestingSchedule(goCtx, &types.QueryVestingScheduleRequest{Address: addr.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(now.Unix(), res.Timestamp)
+	s.Require().Equal(amount, res.OriginalVesting)
+	s.Require().True(res.Vested.IsZero())
+	s.Require().Equal(amount, res.Vesting)
+	s.Require().True(res.Spendable.IsZero())
+	s.Require().Equal([]types.VestingTimeline{{
+		Denom: core.MicroLunaDenom,
+		Periods: []types.UnlockPeriod{{
+			StartTime: now.Unix(),
+			EndTime:   now.Add(100 * time.Hour).Unix(),
+			Ratio:     sdk.OneDec(),
+			Amount:    sdk.NewInt(1000),
+			Vested:    sdk.NewInt(1000),
+		}},
+	}}, res.Timelines)
+
+	res, err = querier.VestingSchedule(goCtx, &types.QueryVestingScheduleRequest{
+		Address:   addr.String(),
+		Timestamp: now.Add(25 * time.Hour).Unix(),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 250)), res.Vested)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 750)), res.Vesting)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 250)), res.Spendable)
+
+	// require a lazy graded vesting account
+	_, err = querier.VestingSchedule(goCtx, &types.QueryVestingScheduleRequest{Address: funder.String()})
+	s.Require().Error(err)
+	_, err = querier.VestingSchedule(goCtx, &types.QueryVestingScheduleRequest{Address: addr.String(), Timestamp: -1})
+	s.Require().Error(err)
+}
@@ -2,8 +2,11 @@ package types
 
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
@@ -15,6 +18,11 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterInterface((*exported.VestingAccount)(nil), nil)
 	cdc.RegisterConcrete(&vestingtypes.BaseVestingAccount{}, "core/BaseVestingAccount", nil)
 	cdc.RegisterConcrete(&LazyGradedVestingAccount{}, "core/LazyGradedVestingAccount", nil)
+	// amino names are limited to 39 characters, the name is shortened like the
+	// cosmos-sdk/MsgCreatePeriodVestAccount of the sdk vesting module
+	legacy.RegisterAminoMsg(cdc, &MsgCreateLazyGradedVestingAccount{}, "terra/vesting/MsgCreateLazyVestAccount")
+	legacy.RegisterAminoMsg(cdc, &MsgClawback{}, "terra/vesting/MsgClawback")
+	legacy.RegisterAminoMsg(cdc, &MsgExtendVestingSchedule{}, "terra/vesting/MsgExtendVestingSchedule")
 }
 
 // RegisterInterfaces associates protoName with AccountI and VestingAccount
@@ -37,6 +45,15 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&vestingtypes.BaseVestingAccount{},
 		&LazyGradedVestingAccount{},
 	)
+
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgCreateLazyGradedVestingAccount{},
+		&MsgClawback{},
+		&MsgExtendVestingSchedule{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
 
 var (
@@ -0,0 +1,23 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/classic-terra/core/v3/x/vesting/types"
+)
+
+func TestAminoNames(t *testing.T) {
+	for name, msg := range map[string]sdk.Msg{
+		"terra/vesting/MsgCreateLazyVestAccount": &types.MsgCreateLazyGradedVestingAccount{},
+		"terra/vesting/MsgClawback":              &types.MsgClawback{},
+		"terra/vesting/MsgExtendVestingSchedule": &types.MsgExtendVestingSchedule{},
+	} {
+		bz, err := types.ModuleCdc.MarshalJSON(msg)
+		require.NoError(t, err)
+		require.Contains(t, string(bz), `"type":"`+name+`"`)
+	}
+}
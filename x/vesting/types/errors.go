@@ -0,0 +1,14 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// Vesting module sentinel errors
+var (
+	ErrAccountNotFound             = errorsmod.Register(ModuleName, 2, "account not found")
+	ErrNotLazyGradedVestingAccount = errorsmod.Register(ModuleName, 3, "account is not a lazy graded vesting account")
+	ErrUnauthorized                = errorsmod.Register(ModuleName, 4, "signer is neither the funder nor the authority")
+	ErrNothingToClawback           = errorsmod.Register(ModuleName, 5, "no unvested coins to claw back")
+	ErrFullyVested                 = errorsmod.Register(ModuleName, 6, "vesting schedules are already fully vested")
+)
@@ -3,4 +3,17 @@ package types
 const (
 	// ModuleName defines the module's name.
 	ModuleName = "vesting"
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+)
+
+// vesting module event types
+const (
+	EventTypeClawback              = "clawback"
+	EventTypeExtendVestingSchedule = "extend_vesting_schedule"
+
+	AttributeKeyAddress     = "address"
+	AttributeKeyFunder      = "funder"
+	AttributeKeyDestination = "destination"
 )
@@ -0,0 +1,158 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgCreateLazyGradedVestingAccount = "create_lazy_graded_vesting_account"
+	TypeMsgClawback                       = "clawback"
+	TypeMsgExtendVestingSchedule          = "extend_vesting_schedule"
+)
+
+var (
+	_ sdk.Msg = &MsgCreateLazyGradedVestingAccount{}
+	_ sdk.Msg = &MsgClawback{}
+	_ sdk.Msg = &MsgExtendVestingSchedule{}
+)
+
+// NewMsgCreateLazyGradedVestingAccount returns a reference to a new MsgCreateLazyGradedVestingAccount.
+func NewMsgCreateLazyGradedVestingAccount(fromAddr, toAddr sdk.AccAddress, amount sdk.Coins, vestingSchedules VestingSchedules) *MsgCreateLazyGradedVestingAccount {
+	return &MsgCreateLazyGradedVestingAccount{
+		FromAddress:      fromAddr.String(),
+		ToAddress:        toAddr.String(),
+		Amount:           amount,
+		VestingSchedules: vestingSchedules,
+	}
+}
+
+func (msg MsgCreateLazyGradedVestingAccount) Route() string { return RouterKey }
+func (msg MsgCreateLazyGradedVestingAccount) Type() string {
+	return TypeMsgCreateLazyGradedVestingAccount
+}
+func (msg MsgCreateLazyGradedVestingAccount) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.FromAddress); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid from address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.ToAddress); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid to address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsAllPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+
+	for _, coin := range msg.Amount {
+		if _, exists := msg.VestingSchedules.Get(coin.Denom); !exists {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "missing vesting schedule for %s", coin.Denom)
+		}
+	}
+
+	return validateVestingSchedules(msg.VestingSchedules)
+}
+
+func (msg MsgCreateLazyGradedVestingAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCreateLazyGradedVestingAccount) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.FromAddress)
+	return []sdk.AccAddress{from}
+}
+
+// NewMsgClawback returns a reference to a new MsgClawback.
+func NewMsgClawback(signer, addr, destAddr sdk.AccAddress) *MsgClawback {
+	msg := &MsgClawback{
+		Signer:  signer.String(),
+		Address: addr.String(),
+	}
+	if destAddr != nil {
+		msg.DestAddress = destAddr.String()
+	}
+
+	return msg
+}
+
+func (msg MsgClawback) Route() string { return RouterKey }
+func (msg MsgClawback) Type() string  { return TypeMsgClawback }
+func (msg MsgClawback) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address: %s", err)
+	}
+	if msg.DestAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.DestAddress); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid dest address: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func (msg MsgClawback) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgClawback) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(msg.Signer)
+	return []sdk.AccAddress{signer}
+}
+
+// NewMsgExtendVestingSchedule returns a reference to a new MsgExtendVestingSchedule.
+func NewMsgExtendVestingSchedule(signer, addr sdk.AccAddress, vestingSchedules VestingSchedules) *MsgExtendVestingSchedule {
+	return &MsgExtendVestingSchedule{
+		Signer:           signer.String(),
+		Address:          addr.String(),
+		VestingSchedules: vestingSchedules,
+	}
+}
+
+func (msg MsgExtendVestingSchedule) Route() string { return RouterKey }
+func (msg MsgExtendVestingSchedule) Type() string  { return TypeMsgExtendVestingSchedule }
+func (msg MsgExtendVestingSchedule) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address: %s", err)
+	}
+	if len(msg.VestingSchedules) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no vesting schedules")
+	}
+
+	return validateVestingSchedules(msg.VestingSchedules)
+}
+
+func (msg MsgExtendVestingSchedule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgExtendVestingSchedule) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(msg.Signer)
+	return []sdk.AccAddress{signer}
+}
+
+func validateVestingSchedules(vestingSchedules VestingSchedules) error {
+	denoms := make(map[string]bool)
+	for _, vestingSchedule := range vestingSchedules {
+		if err := sdk.ValidateDenom(vestingSchedule.Denom); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		if denoms[vestingSchedule.Denom] {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("cannot have multiple vesting schedules for %s", vestingSchedule.Denom))
+		}
+		if err := vestingSchedule.Validate(); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+
+		denoms[vestingSchedule.Denom] = true
+	}
+
+	return nil
+}
@@ -90,6 +90,18 @@ func (vs VestingSchedule) GetVestedRatio(blockTime int64) sdk.Dec {
 	return sumRatio
 }
 
+// GetEndTime returns the latest end time of the schedules
+func (vs VestingSchedule) GetEndTime() int64 {
+	var endTime int64
+	for _, lazySchedule := range vs.Schedules {
+		if lazySchedule.GetEndTime() > endTime {
+			endTime = lazySchedule.GetEndTime()
+		}
+	}
+
+	return endTime
+}
+
 // GetDenom returns the denom of vesting schedule
 func (vs VestingSchedule) GetDenom() string {
 	return vs.Denom
@@ -118,3 +130,14 @@ func (vs VestingSchedule) Validate() error {
 
 // VestingSchedules stores all vesting schedules passed as part of a LazyGradedVestingAccount
 type VestingSchedules []VestingSchedule
+
+// Get returns the vesting schedule of the given denom
+func (vss VestingSchedules) Get(denom string) (VestingSchedule, bool) {
+	for _, vs := range vss {
+		if vs.Denom == denom {
+			return vs, true
+		}
+	}
+
+	return VestingSchedule{}, false
+}
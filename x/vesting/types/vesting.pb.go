@@ -31,6 +31,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type LazyGradedVestingAccount struct {
 	*types.BaseVestingAccount `protobuf:"bytes,1,opt,name=base_vesting_account,json=baseVestingAccount,proto3,embedded=base_vesting_account" json:"base_vesting_account,omitempty"`
 	VestingSchedules          VestingSchedules `protobuf:"bytes,2,rep,name=vesting_schedules,json=vestingSchedules,proto3,castrepeated=VestingSchedules" json:"vesting_schedules" yaml:"vesting_schedules"`
+	// funder_address is the account that funded the vesting account after
+	// genesis and is allowed to claw back and extend it
+	FunderAddress string `protobuf:"bytes,3,opt,name=funder_address,json=funderAddress,proto3" json:"funder_address,omitempty" yaml:"funder_address"`
 }
 
 func (m *LazyGradedVestingAccount) Reset()      { *m = LazyGradedVestingAccount{} }
@@ -155,39 +158,41 @@ func init() {
 }
 
 var fileDescriptor_c4a9bc06e563192a = []byte{
-	// 498 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x7d, 0x49, 0x0b, 0xf1, 0x15, 0xa9, 0xa9, 0x69, 0x25, 0xd3, 0xc1, 0x17, 0x19, 0xa8,
-	0x22, 0x50, 0x6c, 0xfa, 0x63, 0xca, 0x86, 0x55, 0x09, 0x09, 0x75, 0x32, 0x88, 0x81, 0x25, 0x3a,
-	0x9f, 0x4f, 0xa9, 0x45, 0xec, 0xab, 0x7c, 0x97, 0x88, 0xf0, 0x17, 0xc0, 0xc6, 0xc0, 0xc0, 0xd8,
-	0xb9, 0x33, 0x7f, 0x44, 0xc7, 0x88, 0x09, 0x75, 0x30, 0x28, 0xf9, 0x0f, 0xf2, 0x17, 0xa0, 0xdc,
-	0x9d, 0x9b, 0xe2, 0x92, 0x4e, 0xc9, 0x7b, 0xef, 0xfb, 0x3e, 0x77, 0xef, 0x7d, 0x7d, 0xf0, 0xb1,
-	0xa0, 0x79, 0x8e, 0xfd, 0x11, 0xe5, 0x22, 0xc9, 0xfa, 0xfe, 0x68, 0x3f, 0xa2, 0x02, 0xef, 0x97,
-	0xb1, 0x77, 0x96, 0x33, 0xc1, 0xac, 0x1d, 0x29, 0xf2, 0xca, 0xa4, 0x16, 0xed, 0x3e, 0x21, 0x8c,
-	0xa7, 0x8c, 0xdf, 0xdd, 0xbc, 0xfb, 0x48, 0xa9, 0x7a, 0x32, 0xf2, 0x55, 0xa0, 0x4b, 0xdb, 0x7d,
-	0xd6, 0x67, 0x2a, 0xbf, 0xf8, 0xa7, 0xb2, 0xee, 0xb7, 0x1a, 0xb4, 0x4f, 0xf0, 0xa7, 0xf1, 0xab,
-	0x1c, 0xc7, 0x34, 0x7e, 0xa7, 0x60, 0x2f, 0x09, 0x61, 0xc3, 0x4c, 0x58, 0x11, 0xdc, 0x8e, 0x30,
-	0xa7, 0x3d, 0x7d, 0x46, 0x0f, 0xab, 0xbc, 0x0d, 0x5a, 0xa0, 0xbd, 0x71, 0xf0, 0xcc, 0xd3, 0xfc,
-	0xca, 0x55, 0xbd, 0x00, 0x73, 0xfa, 0x2f, 0x29, 0x58, 0x9b, 0x14, 0x08, 0x84, 0x56, 0x74, 0xab,
-	0x62, 0x7d, 0x01, 0x70, 0xab, 0xe4, 0x73, 0x72, 0x4a, 0xe3, 0xe1, 0x80, 0x72, 0xbb, 0xd6, 0xaa,
-	0xb7, 0x37, 0x0e, 0xf6, 0xbc, 0xff, 0xee, 0xc2, 0xd3, 0x88, 0x37, 0x5a, 0x1e, 0x1c, 0x5d, 0x16,
-	0xc8, 0x98, 0x17, 0xc8, 0x1e, 0xe3, 0x74, 0xd0, 0x75, 0x6f, 0xe1, 0xdc, 0x8b, 0xdf, 0xa8, 0x59,
-	0x69, 0xe2, 0x61, 0x73, 0x54, 0xc9, 0x74, 0x1b, 0x9f, 0xcf, 0x91, 0xf1, 0xfd, 0x1c, 0x19, 0xee,
-	0x15, 0x80, 0x8d, 0x32, 0x6f, 0x1d, 0x41, 0xc8, 0x05, 0xce, 0x45, 0x4f, 0x24, 0x29, 0x95, 0xc3,
-	0xd7, 0x83, 0x9d, 0x79, 0x81, 0xb6, 0xd4, 0x71, 0xcb, 0x9a, 0x1b, 0x9a, 0x32, 0x78, 0x9b, 0xa4,
-	0xd4, 0xf2, 0x60, 0x83, 0x66, 0xb1, 0xea, 0xa9, 0xc9, 0x9e, 0x87, 0xf3, 0x02, 0x6d, 0xaa, 0x9e,
-	0xb2, 0xe2, 0x86, 0xf7, 0x69, 0x16, 0x4b, 0x7d, 0x04, 0xd7, 0x73, 0x2c, 0x12, 0x66, 0xd7, 0x5b,
-	0xa0, 0x6d, 0x06, 0x27, 0x8b, 0x99, 0xae, 0x0a, 0xb4, 0xd7, 0x4f, 0xc4, 0xe9, 0x30, 0xf2, 0x08,
-	0x4b, 0xb5, 0x9f, 0xfa, 0xa7, 0xc3, 0xe3, 0x0f, 0xbe, 0x18, 0x9f, 0x51, 0xee, 0x1d, 0x53, 0x32,
-	0x2f, 0xd0, 0x03, 0x85, 0x96, 0x10, 0xf7, 0xe7, 0x8f, 0x0e, 0xd4, 0xf6, 0x1c, 0x53, 0x12, 0x2a,
-	0x74, 0x77, 0x6d, 0x31, 0xa0, 0x7b, 0x01, 0xe0, 0x66, 0x65, 0x1b, 0xd6, 0x73, 0xb8, 0x1e, 0xd3,
-	0x8c, 0xa5, 0x72, 0x3c, 0x73, 0xd5, 0x78, 0x4a, 0x63, 0xc5, 0xd0, 0xac, 0x5a, 0x85, 0x56, 0x58,
-	0x75, 0xed, 0xd1, 0x53, 0xed, 0x51, 0x53, 0x53, 0x6f, 0x7a, 0x63, 0x2e, 0x4d, 0x59, 0x82, 0xd5,
-	0x65, 0x83, 0xd7, 0x97, 0x53, 0x07, 0x4c, 0xa6, 0x0e, 0xf8, 0x33, 0x75, 0xc0, 0xd7, 0x99, 0x63,
-	0x4c, 0x66, 0x8e, 0xf1, 0x6b, 0xe6, 0x18, 0xef, 0x5f, 0xdc, 0xdc, 0xcc, 0x00, 0x73, 0x9e, 0x90,
-	0x8e, 0x7a, 0x60, 0x84, 0xe5, 0xd4, 0x1f, 0x1d, 0xfa, 0x1f, 0xaf, 0x5f, 0x8b, 0xdc, 0x53, 0x74,
-	0x4f, 0x7e, 0xf3, 0x87, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x94, 0x98, 0x68, 0xcd, 0x88, 0x03,
-	0x00, 0x00,
+	// 535 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xf6, 0x35, 0x2d, 0x24, 0x57, 0xa0, 0xa9, 0x49, 0xa4, 0xd0, 0xc1, 0x17, 0x19, 0xa8, 0x22,
+	0x50, 0x6c, 0xd2, 0x76, 0xca, 0x56, 0xab, 0x12, 0x12, 0xea, 0xe4, 0x22, 0x84, 0x58, 0xa2, 0xb3,
+	0xef, 0x48, 0x2d, 0x62, 0x5f, 0x75, 0x77, 0x89, 0x08, 0xbf, 0x00, 0x36, 0x46, 0xc6, 0xce, 0x9d,
+	0xf9, 0x11, 0x1d, 0x23, 0x26, 0xd4, 0xc1, 0xa0, 0x64, 0x67, 0xc8, 0x2f, 0x40, 0xb9, 0xbb, 0x34,
+	0x6d, 0x4a, 0x99, 0xec, 0xf7, 0xbd, 0xef, 0x7d, 0x77, 0xef, 0x7b, 0xf7, 0xe0, 0x63, 0x49, 0x39,
+	0xc7, 0xfe, 0x80, 0x0a, 0x99, 0x64, 0x5d, 0x7f, 0xd0, 0x8a, 0xa8, 0xc4, 0xad, 0x79, 0xec, 0x9d,
+	0x70, 0x26, 0x99, 0x5d, 0x55, 0x24, 0x6f, 0x0e, 0x1a, 0xd2, 0xd6, 0x93, 0x98, 0x89, 0x94, 0x89,
+	0xff, 0x17, 0x6f, 0x3d, 0xd2, 0xac, 0x8e, 0x8a, 0x7c, 0x1d, 0x98, 0x54, 0xa5, 0xcb, 0xba, 0x4c,
+	0xe3, 0xb3, 0x3f, 0x8d, 0xba, 0x7f, 0x56, 0x60, 0xed, 0x10, 0x7f, 0x1a, 0xbe, 0xe4, 0x98, 0x50,
+	0xf2, 0x46, 0x8b, 0xed, 0xc7, 0x31, 0xeb, 0x67, 0xd2, 0x8e, 0x60, 0x25, 0xc2, 0x82, 0x76, 0xcc,
+	0x19, 0x1d, 0xac, 0xf1, 0x1a, 0xa8, 0x83, 0xc6, 0xfa, 0xce, 0x33, 0xcf, 0xe8, 0x2f, 0x5d, 0xd5,
+	0x0b, 0xb0, 0xa0, 0xd7, 0x95, 0x82, 0xd5, 0x51, 0x8e, 0x40, 0x68, 0x47, 0x37, 0x32, 0xf6, 0x17,
+	0x00, 0x37, 0xe7, 0xfa, 0x22, 0x3e, 0xa6, 0xa4, 0xdf, 0xa3, 0xa2, 0xb6, 0x52, 0x2f, 0x34, 0xd6,
+	0x77, 0xb6, 0xbd, 0x7f, 0x7a, 0xe1, 0x19, 0x89, 0x23, 0x43, 0x0f, 0xf6, 0xce, 0x73, 0x64, 0x4d,
+	0x73, 0x54, 0x1b, 0xe2, 0xb4, 0xd7, 0x76, 0x6f, 0xc8, 0xb9, 0x67, 0xbf, 0x50, 0x79, 0xa9, 0x48,
+	0x84, 0xe5, 0xc1, 0x12, 0x62, 0xbf, 0x85, 0x0f, 0xde, 0xf7, 0x33, 0x42, 0x79, 0x07, 0x13, 0xc2,
+	0xa9, 0x10, 0xb5, 0x42, 0x1d, 0x34, 0x4a, 0x41, 0x6b, 0x9a, 0xa3, 0xaa, 0xd6, 0xbe, 0x9e, 0x77,
+	0x7f, 0x7c, 0x6f, 0x56, 0x8c, 0x0b, 0xfb, 0x1a, 0x3a, 0x92, 0x3c, 0xc9, 0xba, 0xe1, 0x7d, 0x4d,
+	0x34, 0x60, 0xbb, 0xf8, 0xf9, 0x14, 0x59, 0xdf, 0x4e, 0x91, 0xe5, 0x5e, 0x00, 0x58, 0x9c, 0x9f,
+	0x68, 0xef, 0x41, 0x28, 0x24, 0xe6, 0xb2, 0x23, 0x93, 0x94, 0x2a, 0x5b, 0x0b, 0x41, 0x75, 0x9a,
+	0xa3, 0x4d, 0x7d, 0xd8, 0x22, 0xe7, 0x86, 0x25, 0x15, 0xbc, 0x4e, 0x52, 0x6a, 0x7b, 0xb0, 0x48,
+	0x33, 0xa2, 0x6b, 0x56, 0x54, 0xcd, 0xc3, 0x69, 0x8e, 0x36, 0x74, 0xcd, 0x3c, 0xe3, 0x86, 0x77,
+	0x69, 0x46, 0x14, 0x3f, 0x82, 0x6b, 0x1c, 0xcb, 0x84, 0x99, 0x6e, 0x0e, 0x67, 0x6e, 0x5d, 0xe4,
+	0x68, 0xbb, 0x9b, 0xc8, 0xe3, 0x7e, 0xe4, 0xc5, 0x2c, 0x35, 0x2f, 0xc5, 0x7c, 0x9a, 0x82, 0x7c,
+	0xf0, 0xe5, 0xf0, 0x84, 0x0a, 0xef, 0x80, 0xc6, 0xd3, 0x1c, 0xdd, 0xd3, 0xd2, 0x4a, 0x64, 0xd6,
+	0x32, 0x34, 0x2d, 0x1f, 0xd0, 0x38, 0xd4, 0xd2, 0xed, 0xd5, 0x59, 0x83, 0xee, 0x19, 0x80, 0x1b,
+	0x4b, 0x3e, 0xdb, 0xcf, 0xe1, 0x1a, 0xa1, 0x19, 0x4b, 0x55, 0x7b, 0xa5, 0xdb, 0xda, 0xd3, 0x1c,
+	0x9b, 0xc0, 0xd2, 0xf2, 0x23, 0x40, 0xb7, 0x3c, 0x82, 0xcb, 0xe9, 0x3f, 0x35, 0xd3, 0x2f, 0x1b,
+	0xd5, 0xab, 0x53, 0x2f, 0x2d, 0xc6, 0xbd, 0x10, 0xd6, 0x97, 0x0d, 0x5e, 0x9d, 0x8f, 0x1d, 0x30,
+	0x1a, 0x3b, 0xe0, 0xf7, 0xd8, 0x01, 0x5f, 0x27, 0x8e, 0x35, 0x9a, 0x38, 0xd6, 0xcf, 0x89, 0x63,
+	0xbd, 0x7b, 0x71, 0xd5, 0x99, 0x1e, 0x16, 0x22, 0x89, 0x9b, 0x7a, 0x75, 0x63, 0xc6, 0xa9, 0x3f,
+	0xd8, 0xf5, 0x3f, 0x5e, 0xee, 0xa1, 0xf2, 0x29, 0xba, 0xa3, 0xb6, 0x69, 0xf7, 0xef, 0x00, 0x1c,
+	0x1f, 0x2e, 0x58, 0xe2, 0x03, 0x00, 0x00,
 }
 
 func (m *LazyGradedVestingAccount) Marshal() (dAtA []byte, err error) {
@@ -210,6 +215,13 @@ func (m *LazyGradedVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if len(m.FunderAddress) > 0 {
+		i -= len(m.FunderAddress)
+		copy(dAtA[i:], m.FunderAddress)
+		i = encodeVarintVesting(dAtA, i, uint64(len(m.FunderAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.VestingSchedules) > 0 {
 		for iNdEx := len(m.VestingSchedules) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -353,6 +365,10 @@ func (m *LazyGradedVestingAccount) Size() (n int) {
 			n += 1 + l + sovVesting(uint64(l))
 		}
 	}
+	l = len(m.FunderAddress)
+	if l > 0 {
+		n += 1 + l + sovVesting(uint64(l))
+	}
 	return n
 }
 
@@ -497,6 +513,38 @@ func (m *LazyGradedVestingAccount) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVesting(dAtA[iNdEx:])
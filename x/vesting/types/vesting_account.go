@@ -2,6 +2,8 @@ package types
 
 import (
 	fmt "fmt"
+	"math"
+	"sort"
 	"time"
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
@@ -13,6 +15,9 @@ import (
 	"gopkg.in/yaml.v2"
 )
 
+// NeverVestTime is the start and end time of the schedule of coins which never vest
+const NeverVestTime = math.MaxInt64
+
 // for pretty purpose
 type vestingAccountYAML struct {
 	Address          sdk.AccAddress `json:"address" yaml:"address"`
@@ -26,6 +31,7 @@ type vestingAccountYAML struct {
 
 	// custom fields based on concrete vesting type which can be omitted
 	VestingSchedules VestingSchedules `json:"vesting_schedules,omitempty" yaml:"vesting_schedules,omitempty"`
+	FunderAddress    string           `json:"funder_address,omitempty" yaml:"funder_address,omitempty"`
 }
 
 //-----------------------------------------------------------------------------
@@ -56,7 +62,7 @@ func NewLazyGradedVestingAccount(baseAcc *authtypes.BaseAccount, originalVesting
 		EndTime:          0,
 	}
 
-	return &LazyGradedVestingAccount{baseVestingAcc, lazyVestingSchedules}
+	return &LazyGradedVestingAccount{BaseVestingAccount: baseVestingAcc, VestingSchedules: lazyVestingSchedules}
 }
 
 // GetVestingSchedules returns the VestingSchedules of the graded lazy vesting account
@@ -116,6 +122,144 @@ func (lgva *LazyGradedVestingAccount) TrackDelegation(blockTime time.Time, balan
 	lgva.BaseVestingAccount.TrackDelegation(balance, lgva.GetVestingCoins(blockTime), amount)
 }
 
+// Clawback stops the vesting schedules at blockTime and removes the unvested
+// coins which are not delegated from the original vesting coins. It returns
+// the removed coins. Unvested coins which are delegated never vest and can be
+// clawed back once they are undelegated.
+func (lgva *LazyGradedVestingAccount) Clawback(blockTime time.Time) sdk.Coins {
+	vested := lgva.GetVestedCoins(blockTime)
+	unvested := lgva.OriginalVesting.Sub(vested...)
+	delegated := unvested.Min(lgva.DelegatedVesting)
+	clawback := unvested.Sub(delegated...)
+
+	lgva.OriginalVesting = lgva.OriginalVesting.Sub(clawback...)
+	schedules := VestingSchedules{}
+	for _, coin := range lgva.OriginalVesting {
+		if _, exists := lgva.GetVestingSchedule(coin.Denom); !exists {
+			continue
+		}
+
+		// vested coins stay vested, the delegated ones never vest
+		vestedRatio := getVestedRatio(vested.AmountOf(coin.Denom), coin.Amount)
+		schedules = append(schedules, NewVestingSchedule(coin.Denom, stoppedSchedules(blockTime, vestedRatio, nil)))
+	}
+	lgva.VestingSchedules = schedules
+
+	return clawback
+}
+
+// ExtendVestingSchedules replaces the schedules of the coins which are still
+// vesting at blockTime with vestingSchedules, the vested coins stay vested.
+// The schedules of a denom must not end before its current schedules.
+func (lgva *LazyGradedVestingAccount) ExtendVestingSchedules(blockTime time.Time, vestingSchedules VestingSchedules) error {
+	vested := lgva.GetVestedCoins(blockTime)
+	unvested := lgva.OriginalVesting.Sub(vested...)
+
+	schedules := VestingSchedules{}
+	for _, vestingSchedule := range vestingSchedules {
+		if err := vestingSchedule.Validate(); err != nil {
+			return err
+		}
+
+		current, exists := lgva.GetVestingSchedule(vestingSchedule.Denom)
+		if !exists || unvested.AmountOf(vestingSchedule.Denom).IsZero() {
+			return fmt.Errorf("no %s coins are vesting", vestingSchedule.Denom)
+		}
+		if vestingSchedule.GetEndTime() < current.GetEndTime() {
+			return fmt.Errorf("vesting schedule of %s cannot end before %d", vestingSchedule.Denom, current.GetEndTime())
+		}
+
+		vestedRatio := getVestedRatio(vested.AmountOf(vestingSchedule.Denom), lgva.OriginalVesting.AmountOf(vestingSchedule.Denom))
+		schedules = append(schedules, NewVestingSchedule(vestingSchedule.Denom, stoppedSchedules(blockTime, vestedRatio, vestingSchedule.Schedules)))
+	}
+
+	for _, vestingSchedule := range lgva.VestingSchedules {
+		if _, exists := vestingSchedules.Get(vestingSchedule.Denom); !exists {
+			schedules = append(schedules, vestingSchedule)
+		}
+	}
+
+	extended := *lgva
+	extended.VestingSchedules = schedules
+	if err := extended.Validate(); err != nil {
+		return err
+	}
+
+	lgva.VestingSchedules = schedules
+	return nil
+}
+
+// getVestedRatio returns the smallest ratio of original which vests at least
+// vested, a truncated ratio could vest less and lock the rest forever
+func getVestedRatio(vested, original sdk.Int) sdk.Dec {
+	vestedRatio := sdk.NewDecFromInt(vested).QuoInt(original)
+	for sdk.NewDecFromInt(original).Mul(vestedRatio).RoundInt().LT(vested) {
+		vestedRatio = vestedRatio.Add(sdk.SmallestDec())
+	}
+
+	return vestedRatio
+}
+
+// stoppedSchedules returns schedules which vest vestedRatio at blockTime and
+// the rest following remaining, or never if remaining is empty
+func stoppedSchedules(blockTime time.Time, vestedRatio sdk.Dec, remaining Schedules) Schedules {
+	schedules := Schedules{}
+	if vestedRatio.IsPositive() {
+		schedules = append(schedules, NewSchedule(blockTime.Unix(), blockTime.Unix(), vestedRatio))
+	}
+
+	unvestedRatio := sdk.OneDec().Sub(vestedRatio)
+	if !unvestedRatio.IsPositive() {
+		return schedules
+	}
+
+	if len(remaining) == 0 {
+		return append(schedules, NewSchedule(NeverVestTime, NeverVestTime, unvestedRatio))
+	}
+
+	for _, schedule := range remaining {
+		schedules = append(schedules, NewSchedule(schedule.StartTime, schedule.EndTime, schedule.Ratio.Mul(unvestedRatio)))
+	}
+
+	return schedules
+}
+
+// GetVestingTimelines returns the unlock timeline of each vesting denom with
+// the periods sorted by end time.
+func (lgva LazyGradedVestingAccount) GetVestingTimelines() []VestingTimeline {
+	timelines := []VestingTimeline{}
+	for _, ovc := range lgva.OriginalVesting {
+		vestingSchedule, exists := lgva.GetVestingSchedule(ovc.Denom)
+		if !exists {
+			continue
+		}
+
+		schedules := make(Schedules, len(vestingSchedule.Schedules))
+		copy(schedules, vestingSchedule.Schedules)
+		sort.SliceStable(schedules, func(i, j int) bool {
+			if schedules[i].EndTime != schedules[j].EndTime {
+				return schedules[i].EndTime < schedules[j].EndTime
+			}
+			return schedules[i].StartTime < schedules[j].StartTime
+		})
+
+		timeline := VestingTimeline{Denom: ovc.Denom}
+		for _, schedule := range schedules {
+			timeline.Periods = append(timeline.Periods, UnlockPeriod{
+				StartTime: schedule.StartTime,
+				EndTime:   schedule.EndTime,
+				Ratio:     schedule.Ratio,
+				Amount:    sdk.NewDecFromInt(ovc.Amount).Mul(schedule.Ratio).RoundInt(),
+				Vested:    sdk.NewDecFromInt(ovc.Amount).Mul(vestingSchedule.GetVestedRatio(schedule.EndTime)).RoundInt(),
+			})
+		}
+
+		timelines = append(timelines, timeline)
+	}
+
+	return timelines
+}
+
 // GetStartTime returns zero since a lazy graded vesting account has no start time.
 func (lgva LazyGradedVestingAccount) GetStartTime() int64 {
 	return 0
@@ -167,6 +311,7 @@ func (lgva LazyGradedVestingAccount) MarshalYAML() (interface{}, error) {
 		DelegatedVesting: lgva.DelegatedVesting,
 		EndTime:          lgva.EndTime,
 		VestingSchedules: lgva.VestingSchedules,
+		FunderAddress:    lgva.FunderAddress,
 	}
 
 	return marshalYaml(out)
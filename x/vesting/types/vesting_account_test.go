@@ -384,3 +384,154 @@ func TestLazyGradedVestingAccountMarshal(t *testing.T) {
 	require.IsType(t, &types.LazyGradedVestingAccount{}, acc2)
 	require.Equal(t, acc.String(), acc2.String())
 }
+
+func TestClawbackLazyVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	endTime := now.Add(24 * time.Hour)
+
+	_, _, addr := KeyTestPubAddr()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+	lgva := types.NewLazyGradedVestingAccount(bacc, origCoins, types.VestingSchedules{
+		types.NewVestingSchedule(feeDenom, []types.Schedule{
+			types.NewSchedule(now.Unix(), endTime.Unix(), sdk.NewDec(1)),
+		}),
+		types.NewVestingSchedule(stakeDenom, []types.Schedule{
+			types.NewSchedule(now.Unix(), endTime.Unix(), sdk.NewDec(1)),
+		}),
+	})
+
+	// delegate 20stake of the vesting coins
+	lgva.TrackDelegation(now, origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 20)})
+
+	// require the unvested coins which are not delegated to be clawed back
+	clawbackTime := now.Add(12 * time.Hour)
+	clawback := lgva.Clawback(clawbackTime)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 30)}, clawback)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 70)}, lgva.OriginalVesting)
+	require.NoError(t, lgva.Validate())
+
+	// require the vested coins to stay vested and the delegated ones to never vest
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, lgva.GetVestedCoins(clawbackTime))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, lgva.GetVestedCoins(endTime.Add(24*time.Hour)))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 20)}, lgva.GetVestingCoins(endTime))
+	require.Equal(t, sdk.NewCoins(), lgva.LockedCoins(endTime))
+
+	// require the delegated coins to be clawed back once undelegated
+	lgva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 20)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 20)}, lgva.LockedCoins(endTime))
+	clawback = lgva.Clawback(endTime)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 20)}, clawback)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, lgva.OriginalVesting)
+	require.Equal(t, lgva.OriginalVesting, lgva.GetVestedCoins(endTime))
+
+	// require nothing to claw back afterwards
+	require.True(t, lgva.Clawback(endTime).IsZero())
+}
+
+func TestExtendVestingSchedulesLazyVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	endTime := now.Add(24 * time.Hour)
+	newEndTime := now.Add(48 * time.Hour)
+
+	_, _, addr := KeyTestPubAddr()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+	lgva := types.NewLazyGradedVestingAccount(bacc, origCoins, types.VestingSchedules{
+		types.NewVestingSchedule(feeDenom, []types.Schedule{
+			types.NewSchedule(now.Unix(), endTime.Unix(), sdk.NewDec(1)),
+		}),
+		types.NewVestingSchedule(stakeDenom, []types.Schedule{
+			types.NewSchedule(now.Unix(), endTime.Unix(), sdk.NewDec(1)),
+		}),
+	})
+
+	extendTime := now.Add(12 * time.Hour)
+
+	// require the schedule not to end earlier
+	err := lgva.ExtendVestingSchedules(extendTime, types.VestingSchedules{
+		types.NewVestingSchedule(stakeDenom, []types.Schedule{
+			types.NewSchedule(extendTime.Unix(), extendTime.Add(time.Hour).Unix(), sdk.NewDec(1)),
+		}),
+	})
+	require.Error(t, err)
+
+	// require the denom to be vesting
+	err = lgva.ExtendVestingSchedules(extendTime, types.VestingSchedules{
+		types.NewVestingSchedule("foo", []types.Schedule{
+			types.NewSchedule(extendTime.Unix(), newEndTime.Unix(), sdk.NewDec(1)),
+		}),
+	})
+	require.Error(t, err)
+
+	err = lgva.ExtendVestingSchedules(extendTime, types.VestingSchedules{
+		types.NewVestingSchedule(stakeDenom, []types.Schedule{
+			types.NewSchedule(extendTime.Unix(), newEndTime.Unix(), sdk.NewDec(1)),
+		}),
+	})
+	require.NoError(t, err)
+	require.NoError(t, lgva.Validate())
+	require.Equal(t, origCoins, lgva.OriginalVesting)
+
+	// require the vested coins to stay vested and the fee schedule unchanged
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, lgva.GetVestedCoins(extendTime))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 75)}, lgva.GetVestedCoins(endTime.Add(6*time.Hour)))
+	require.Equal(t, origCoins, lgva.GetVestedCoins(newEndTime))
+}
+
+func TestVestedRatioRoundingLazyVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	endTime := now.Add(3 * time.Second)
+	stopTime := now.Add(time.Second)
+
+	// an amount the vested ratio does not divide evenly
+	amount, ok := sdk.NewIntFromString("3000000000000000001")
+	require.True(t, ok)
+	origCoins := sdk.Coins{sdk.NewCoin(feeDenom, amount)}
+	newAccount := func() *types.LazyGradedVestingAccount {
+		_, _, addr := KeyTestPubAddr()
+		return types.NewLazyGradedVestingAccount(authtypes.NewBaseAccountWithAddress(addr), origCoins, types.VestingSchedules{
+			types.NewVestingSchedule(feeDenom, []types.Schedule{
+				types.NewSchedule(now.Unix(), endTime.Unix(), sdk.NewDec(1)),
+			}),
+		})
+	}
+
+	// require the vested coins to stay vested once the schedules are stopped
+	lgva := newAccount()
+	vested := lgva.GetVestedCoins(stopTime)
+	require.Equal(t, origCoins.Sub(vested...), lgva.Clawback(stopTime))
+	require.Equal(t, vested, lgva.GetVestedCoins(stopTime))
+	require.Equal(t, vested, lgva.GetVestedCoins(endTime))
+
+	lgva = newAccount()
+	err := lgva.ExtendVestingSchedules(stopTime, types.VestingSchedules{
+		types.NewVestingSchedule(feeDenom, []types.Schedule{
+			types.NewSchedule(endTime.Unix(), endTime.Add(time.Hour).Unix(), sdk.NewDec(1)),
+		}),
+	})
+	require.NoError(t, err)
+	require.Equal(t, vested, lgva.GetVestedCoins(stopTime))
+	require.Equal(t, origCoins, lgva.GetVestedCoins(endTime.Add(time.Hour)))
+}
+
+func TestGetVestingTimelinesLazyVestingAcc(t *testing.T) {
+	_, _, addr := KeyTestPubAddr()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+	lgva := types.NewLazyGradedVestingAccount(bacc, origCoins, types.VestingSchedules{
+		types.NewVestingSchedule(feeDenom, []types.Schedule{
+			types.NewSchedule(200, 300, sdk.NewDecWithPrec(3, 1)),
+			types.NewSchedule(100, 200, sdk.NewDecWithPrec(7, 1)),
+		}),
+	})
+
+	// require the periods sorted by end time and the denoms without schedule skipped
+	require.Equal(t, []types.VestingTimeline{{
+		Denom: feeDenom,
+		Periods: []types.UnlockPeriod{
+			{StartTime: 100, EndTime: 200, Ratio: sdk.NewDecWithPrec(7, 1), Amount: sdk.NewInt(700), Vested: sdk.NewInt(700)},
+			{StartTime: 200, EndTime: 300, Ratio: sdk.NewDecWithPrec(3, 1), Amount: sdk.NewInt(300), Vested: sdk.NewInt(1000)},
+		},
+	}}, lgva.GetVestingTimelines())
+}